@@ -6,6 +6,7 @@ toolchain go1.21.1
 
 require (
 	cosmossdk.io/errors v1.0.0
+	cosmossdk.io/math v1.0.1
 	github.com/circlefin/noble-cctp v0.0.0-20230925160209-fba5dffdac25
 	github.com/cosmos/cosmos-sdk v0.45.16
 	github.com/cosmos/ibc-go/v3 v3.4.0
@@ -29,7 +30,6 @@ require (
 	cosmossdk.io/api v0.2.6 // indirect
 	cosmossdk.io/core v0.5.1 // indirect
 	cosmossdk.io/depinject v1.0.0-alpha.3 // indirect
-	cosmossdk.io/math v1.0.1 // indirect
 	filippo.io/edwards25519 v1.0.0 // indirect
 	github.com/99designs/go-keychain v0.0.0-20191008050251-8e49817e8af4 // indirect
 	github.com/99designs/keyring v1.2.1 // indirect
@@ -0,0 +1,331 @@
+package ibctest
+
+import (
+	"encoding/json"
+
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/capability"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer"
+	transferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibc "github.com/cosmos/ibc-go/v3/modules/core"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibchost "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcsimapp "github.com/cosmos/ibc-go/v3/testing/simapp"
+	simappparams "github.com/cosmos/ibc-go/v3/testing/simapp/params"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router"
+	routerkeeper "github.com/strangelove-ventures/noble-router/x/router/keeper"
+	routertypes "github.com/strangelove-ventures/noble-router/x/router/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+var (
+	_ ibctesting.TestingApp = (*NobleApp)(nil)
+
+	// ModuleBasics are the modules of the Noble test chain
+	ModuleBasics = module.NewBasicManager(
+		auth.AppModuleBasic{},
+		bank.AppModuleBasic{},
+		capability.AppModuleBasic{},
+		staking.AppModuleBasic{},
+		params.AppModuleBasic{},
+		upgrade.AppModuleBasic{},
+		ibc.AppModuleBasic{},
+		transfer.AppModuleBasic{},
+		router.AppModuleBasic{},
+	)
+
+	// the cctp module account mints the funds of a CCTP transfer before the router records the mint
+	maccPerms = map[string][]string{
+		authtypes.FeeCollectorName:     nil,
+		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		transfertypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
+		cctptypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
+	}
+)
+
+// NobleApp is a minimal Noble chain for the IBC test harness. Its transfer stack is wrapped by the router
+// middleware, and the router keeper sends its IBC forwards through the real transfer keeper.
+type NobleApp struct {
+	*baseapp.BaseApp
+
+	appCodec       codec.Codec
+	encodingConfig simappparams.EncodingConfig
+
+	AccountKeeper    authkeeper.AccountKeeper
+	BankKeeper       bankkeeper.BaseKeeper
+	CapabilityKeeper *capabilitykeeper.Keeper
+	StakingKeeper    stakingkeeper.Keeper
+	ParamsKeeper     paramskeeper.Keeper
+	UpgradeKeeper    upgradekeeper.Keeper
+	IBCKeeper        *ibckeeper.Keeper
+	TransferKeeper   transferkeeper.Keeper
+	RouterKeeper     *routerkeeper.Keeper
+
+	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
+
+	mm          *module.Manager
+	anteHandler sdk.AnteHandler
+
+	// packets sent by the transfer module, by their in flight packet key
+	sentPackets map[string]channeltypes.Packet
+}
+
+// MakeEncodingConfig creates the encoding config of the Noble test chain.
+func MakeEncodingConfig() simappparams.EncodingConfig {
+	encodingConfig := simappparams.MakeTestEncodingConfig()
+	std.RegisterLegacyAminoCodec(encodingConfig.Amino)
+	std.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	ModuleBasics.RegisterLegacyAminoCodec(encodingConfig.Amino)
+	ModuleBasics.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	return encodingConfig
+}
+
+// NewNobleApp returns a Noble test chain backed by an in-memory database.
+func NewNobleApp() *NobleApp {
+	encodingConfig := MakeEncodingConfig()
+	appCodec := encodingConfig.Marshaler
+
+	bApp := baseapp.NewBaseApp("noble", log.NewNopLogger(), dbm.NewMemDB(), encodingConfig.TxConfig.TxDecoder())
+	bApp.SetInterfaceRegistry(encodingConfig.InterfaceRegistry)
+
+	keys := sdk.NewKVStoreKeys(
+		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey,
+		capabilitytypes.StoreKey, ibchost.StoreKey, transfertypes.StoreKey, routertypes.StoreKey,
+	)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
+
+	app := &NobleApp{
+		BaseApp:        bApp,
+		appCodec:       appCodec,
+		encodingConfig: encodingConfig,
+		sentPackets:    make(map[string]channeltypes.Packet),
+	}
+
+	app.ParamsKeeper = paramskeeper.NewKeeper(appCodec, encodingConfig.Amino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
+	for _, subspace := range []string{
+		authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName, transfertypes.ModuleName, routertypes.ModuleName,
+	} {
+		app.ParamsKeeper.Subspace(subspace)
+	}
+	bApp.SetParamStore(app.ParamsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(paramskeeper.ConsensusParamsKeyTable()))
+
+	app.CapabilityKeeper = capabilitykeeper.NewKeeper(appCodec, keys[capabilitytypes.StoreKey], memKeys[capabilitytypes.MemStoreKey])
+	app.ScopedIBCKeeper = app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
+	app.ScopedTransferKeeper = app.CapabilityKeeper.ScopeToModule(transfertypes.ModuleName)
+	app.CapabilityKeeper.Seal()
+
+	app.AccountKeeper = authkeeper.NewAccountKeeper(
+		appCodec, keys[authtypes.StoreKey], app.subspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms,
+	)
+	app.BankKeeper = bankkeeper.NewBaseKeeper(
+		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.subspace(banktypes.ModuleName), map[string]bool{},
+	)
+	app.StakingKeeper = stakingkeeper.NewKeeper(
+		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.subspace(stakingtypes.ModuleName),
+	)
+	app.UpgradeKeeper = upgradekeeper.NewKeeper(map[int64]bool{}, keys[upgradetypes.StoreKey], appCodec, "", app.BaseApp)
+
+	app.IBCKeeper = ibckeeper.NewKeeper(
+		appCodec, keys[ibchost.StoreKey], app.subspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, app.ScopedIBCKeeper,
+	)
+	app.TransferKeeper = transferkeeper.NewKeeper(
+		appCodec, keys[transfertypes.StoreKey], app.subspace(transfertypes.ModuleName),
+		packetRecorder{app.IBCKeeper.ChannelKeeper, app.sentPackets}, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, app.BankKeeper, app.ScopedTransferKeeper,
+	)
+	app.RouterKeeper = routerkeeper.NewKeeper(
+		appCodec, keys[routertypes.StoreKey], app.subspace(routertypes.ModuleName),
+		keepertest.MockCctpKeeper{}, app.TransferKeeper, app.IBCKeeper.ChannelKeeper, app.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	// the cctp module is not part of the test chain
+	app.RouterKeeper.SetCctpMsgServer(&keepertest.MockCctpMsgServer{})
+	if err := app.RouterKeeper.ValidateWiring(); err != nil {
+		panic(err)
+	}
+
+	ibcRouter := porttypes.NewRouter()
+	ibcRouter.AddRoute(transfertypes.ModuleName, router.NewIBCMiddleware(transfer.NewIBCModule(app.TransferKeeper), app.RouterKeeper))
+	app.IBCKeeper.SetRouter(ibcRouter)
+
+	app.mm = module.NewManager(
+		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
+		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
+		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
+		params.NewAppModule(app.ParamsKeeper),
+		upgrade.NewAppModule(app.UpgradeKeeper),
+		ibc.NewAppModule(app.IBCKeeper),
+		transfer.NewAppModule(app.TransferKeeper),
+		router.NewAppModule(appCodec, app.RouterKeeper),
+	)
+
+	app.mm.SetOrderBeginBlockers(
+		upgradetypes.ModuleName, capabilitytypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName, transfertypes.ModuleName,
+		authtypes.ModuleName, banktypes.ModuleName, paramstypes.ModuleName, routertypes.ModuleName,
+	)
+	app.mm.SetOrderEndBlockers(
+		stakingtypes.ModuleName, ibchost.ModuleName, transfertypes.ModuleName, capabilitytypes.ModuleName, authtypes.ModuleName,
+		banktypes.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName, routertypes.ModuleName,
+	)
+	app.mm.SetOrderInitGenesis(
+		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName,
+		transfertypes.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName, routertypes.ModuleName,
+	)
+
+	app.mm.RegisterServices(module.NewConfigurator(appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter()))
+
+	app.MountKVStores(keys)
+	app.MountTransientStores(tkeys)
+	app.MountMemoryStores(memKeys)
+
+	// the default ante handler of the SDK, followed by the RelayerDecorator the router depends on
+	app.anteHandler = sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(),
+		ante.NewRejectExtensionOptionsDecorator(),
+		ante.NewMempoolFeeDecorator(),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(app.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(app.AccountKeeper),
+		ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, nil),
+		ante.NewSetPubKeyDecorator(app.AccountKeeper),
+		ante.NewValidateSigCountDecorator(app.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(app.AccountKeeper, ante.DefaultSigVerificationGasConsumer),
+		ante.NewSigVerificationDecorator(app.AccountKeeper, encodingConfig.TxConfig.SignModeHandler()),
+		ante.NewIncrementSequenceDecorator(app.AccountKeeper),
+		router.NewRelayerDecorator(),
+	)
+
+	app.SetInitChainer(app.InitChainer)
+	app.SetBeginBlocker(app.BeginBlocker)
+	app.SetEndBlocker(app.EndBlocker)
+	app.SetAnteHandler(app.anteHandler)
+
+	if err := app.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	return app
+}
+
+// SetupNobleApp initializes a Noble test chain, it can be used as the ibctesting.DefaultTestingAppInit.
+func SetupNobleApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	app := NewNobleApp()
+	return app, ModuleBasics.DefaultGenesis(app.appCodec)
+}
+
+func (app *NobleApp) subspace(moduleName string) paramstypes.Subspace {
+	subspace, _ := app.ParamsKeeper.GetSubspace(moduleName)
+	return subspace
+}
+
+// InitChainer initializes the state of the chain from its genesis.
+func (app *NobleApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+	var genesisState ibcsimapp.GenesisState
+	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
+		panic(err)
+	}
+	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+}
+
+// BeginBlocker runs the begin blockers of the modules, including the forward retries of the router.
+func (app *NobleApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	return app.mm.BeginBlock(ctx, req)
+}
+
+// EndBlocker runs the end blockers of the modules.
+func (app *NobleApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	return app.mm.EndBlock(ctx, req)
+}
+
+// AppCodec implements the TestingApp interface.
+func (app *NobleApp) AppCodec() codec.Codec {
+	return app.appCodec
+}
+
+// GetBaseApp implements the TestingApp interface.
+func (app *NobleApp) GetBaseApp() *baseapp.BaseApp {
+	return app.BaseApp
+}
+
+// GetStakingKeeper implements the TestingApp interface.
+func (app *NobleApp) GetStakingKeeper() stakingkeeper.Keeper {
+	return app.StakingKeeper
+}
+
+// GetIBCKeeper implements the TestingApp interface.
+func (app *NobleApp) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetScopedIBCKeeper implements the TestingApp interface.
+func (app *NobleApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig implements the TestingApp interface.
+func (app *NobleApp) GetTxConfig() client.TxConfig {
+	return app.encodingConfig.TxConfig
+}
+
+// AnteHandler returns the ante handler of the app.
+func (app *NobleApp) AnteHandler() sdk.AnteHandler {
+	return app.anteHandler
+}
+
+// packetRecorder records the packets sent by the transfer module, so that the harness can relay them. It
+// also sees the retries that are sent by the begin blocker of the router.
+type packetRecorder struct {
+	porttypes.ICS4Wrapper
+	packets map[string]channeltypes.Packet
+}
+
+func (r packetRecorder) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	if err := r.ICS4Wrapper.SendPacket(ctx, chanCap, packet); err != nil {
+		return err
+	}
+
+	r.packets[string(routertypes.InFlightPacketKey(packet.GetSourceChannel(), packet.GetSourcePort(), packet.GetSequence()))] = channeltypes.NewPacket(
+		packet.GetData(), packet.GetSequence(), packet.GetSourcePort(), packet.GetSourceChannel(),
+		packet.GetDestPort(), packet.GetDestChannel(), packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp(),
+	)
+	return nil
+}
@@ -0,0 +1,263 @@
+package ibctest
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/simapp/helpers"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+// SourceDomain is the CCTP domain the harness receives messages from.
+const SourceDomain uint32 = 0
+
+// SourceDomainSender is the allowed source domain sender of the messages received by the harness.
+var SourceDomainSender = make32Bytes(0xab)
+
+// Harness runs a Noble chain with the router and a counterparty chain in process, connected by a transfer
+// channel. The router sends its IBC forwards as real packets, which are relayed, acknowledged with an error
+// or timed out by the tests.
+type Harness struct {
+	t *testing.T
+
+	Coordinator  *ibctesting.Coordinator
+	Noble        *ibctesting.TestChain
+	Counterparty *ibctesting.TestChain
+	// Path connects the Noble chain (EndpointA) with the counterparty chain (EndpointB) over the transfer port.
+	Path *ibctesting.Path
+}
+
+// NewHarness creates the Noble and counterparty chains, opens a transfer channel between them, and allows
+// the channel and SourceDomainSender on the router.
+func NewHarness(t *testing.T) *Harness {
+	coordinator := ibctesting.NewCoordinator(t, 0)
+
+	// the testing app of a chain is selected by a package variable of ibctesting when the chain is created
+	ibctesting.DefaultTestingAppInit = SetupNobleApp
+	noble := ibctesting.NewTestChain(t, coordinator, ibctesting.GetChainID(1))
+	ibctesting.DefaultTestingAppInit = ibctesting.SetupTestingApp
+	counterparty := ibctesting.NewTestChain(t, coordinator, ibctesting.GetChainID(2))
+
+	coordinator.Chains = map[string]*ibctesting.TestChain{
+		noble.ChainID:        noble,
+		counterparty.ChainID: counterparty,
+	}
+
+	path := ibctesting.NewPath(noble, counterparty)
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = ibctesting.TransferPort
+		endpoint.ChannelConfig.Version = transfertypes.Version
+	}
+	coordinator.Setup(path)
+
+	h := &Harness{
+		t:            t,
+		Coordinator:  coordinator,
+		Noble:        noble,
+		Counterparty: counterparty,
+		Path:         path,
+	}
+
+	k := h.App().RouterKeeper
+
+	ctx := h.Context()
+	k.SetAllowedChannel(ctx, types.AllowedChannel{Channel: path.EndpointA.ChannelID, ChainLabel: counterparty.ChainID})
+	k.AddAllowedSourceDomainSender(ctx, SourceDomain, SourceDomainSender)
+	coordinator.CommitBlock(noble)
+
+	return h
+}
+
+// App returns the app of the Noble chain.
+func (h *Harness) App() *NobleApp {
+	return h.Noble.App.(*NobleApp)
+}
+
+// Context returns the context of the current block of the Noble chain.
+func (h *Harness) Context() sdk.Context {
+	return h.Noble.GetContext()
+}
+
+// Channel returns the channel of the Noble chain that IBC forwards are sent over.
+func (h *Harness) Channel() string {
+	return h.Path.EndpointA.ChannelID
+}
+
+// ReceiveMint mints the funds of a CCTP transfer to the recipient, as the cctp module does, and passes its
+// burn message to the router.
+func (h *Harness) ReceiveMint(nonce uint64, recipient sdk.AccAddress, amount math.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewIntFromBigInt(amount.BigInt())))
+	ctx := h.Context()
+	require.NoError(h.t, h.App().BankKeeper.MintCoins(ctx, cctptypes.ModuleName, coins))
+	require.NoError(h.t, h.App().BankKeeper.SendCoinsFromModuleToAccount(ctx, cctptypes.ModuleName, recipient, coins))
+
+	mintRecipient := make([]byte, 32)
+	copy(mintRecipient[12:], recipient)
+
+	burnMessage := cctptypes.BurnMessage{
+		BurnToken:     make32Bytes(0x01),
+		MintRecipient: mintRecipient,
+		Amount:        amount,
+		MessageSender: make32Bytes(0x02),
+	}
+	body, err := burnMessage.Bytes()
+	require.NoError(h.t, err)
+
+	return h.handleMessage(h.Context(), nonce, body)
+}
+
+// ReceiveForward passes the IBC forward metadata of a CCTP transfer to the router.
+func (h *Harness) ReceiveForward(metadata types.IBCForwardMetadata) error {
+	body, err := metadata.Bytes(types.LatestIBCForwardMetadataVersion, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	require.NoError(h.t, err)
+
+	return h.handleMessage(h.Context(), metadata.Nonce, body)
+}
+
+// ReceiveForwardAsRelayer passes the IBC forward metadata of a CCTP transfer to the router, in the context of
+// a transaction of the sender account of the Noble chain that went through the ante handler of the app. The
+// sender account is recorded as the relayer of the transaction by the RelayerDecorator.
+func (h *Harness) ReceiveForwardAsRelayer(metadata types.IBCForwardMetadata) error {
+	body, err := metadata.Bytes(types.LatestIBCForwardMetadataVersion, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	require.NoError(h.t, err)
+
+	return h.handleMessage(h.relayerContext(), metadata.Nonce, body)
+}
+
+// Relayer returns the account that submits the transactions of ReceiveForwardAsRelayer.
+func (h *Harness) Relayer() sdk.AccAddress {
+	return h.Noble.SenderAccount.GetAddress()
+}
+
+// relayerContext runs a transaction signed by the relayer through the ante handler of the app, and returns
+// the context it produced.
+func (h *Harness) relayerContext() sdk.Context {
+	ctx := h.Context()
+	relayer := h.App().AccountKeeper.GetAccount(ctx, h.Relayer())
+
+	// the cctp module is not part of the test chain, any message of the relayer goes through the same ante handler
+	msg := banktypes.NewMsgSend(relayer.GetAddress(), relayer.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	tx, err := helpers.GenTx(
+		h.App().GetTxConfig(), []sdk.Msg{msg}, sdk.NewCoins(), 10_000_000, h.Noble.ChainID,
+		[]uint64{relayer.GetAccountNumber()}, []uint64{relayer.GetSequence()}, h.Noble.SenderPrivKey,
+	)
+	require.NoError(h.t, err)
+
+	ctx, err = h.App().AnteHandler()(ctx, tx, false)
+	require.NoError(h.t, err)
+
+	// the test chain tracks the sequence of its sender account for the transactions it sends itself
+	require.NoError(h.t, h.Noble.SenderAccount.SetSequence(relayer.GetSequence()+1))
+	return ctx
+}
+
+// handleMessage passes a CCTP message of SourceDomainSender to the router, and commits the block if the
+// router accepted it.
+func (h *Harness) handleMessage(ctx sdk.Context, nonce uint64, body []byte) error {
+	message := cctptypes.Message{
+		SourceDomain:      SourceDomain,
+		DestinationDomain: cctptypes.NobleDomainId,
+		Nonce:             nonce,
+		Sender:            SourceDomainSender,
+		Recipient:         make32Bytes(0x03),
+		DestinationCaller: make([]byte, 32),
+		MessageBody:       body,
+	}
+	bz, err := message.Bytes()
+	require.NoError(h.t, err)
+
+	ctx, writeCache := ctx.CacheContext()
+	if err := h.App().RouterKeeper.HandleMessage(ctx, bz); err != nil {
+		return err
+	}
+	writeCache()
+
+	h.Coordinator.CommitBlock(h.Noble)
+	return nil
+}
+
+// ClaimFailedForward claims the funds of a failed IBC forward for its fallback recipient, and commits the
+// block if the router accepted the claim.
+func (h *Harness) ClaimFailedForward(nonce uint64, from sdk.AccAddress) error {
+	ctx, writeCache := h.Context().CacheContext()
+	_, err := keeper.NewMsgServerImpl(h.App().RouterKeeper).ClaimFailedForward(sdk.WrapSDKContext(ctx), &types.MsgClaimFailedForward{
+		From:         from.String(),
+		SourceDomain: SourceDomain,
+		Nonce:        nonce,
+	})
+	if err != nil {
+		return err
+	}
+	writeCache()
+
+	h.Coordinator.CommitBlock(h.Noble)
+	return nil
+}
+
+// InFlightPacket returns the packet of the IBC forward of a nonce that is currently in flight.
+func (h *Harness) InFlightPacket(nonce uint64) channeltypes.Packet {
+	inFlightPacket, found := h.App().RouterKeeper.GetInFlightPacketByNonce(h.Context(), SourceDomain, nonce)
+	require.True(h.t, found, "no packet in flight for nonce %d", nonce)
+
+	packet, found := h.App().sentPackets[string(types.InFlightPacketKey(inFlightPacket.Channel, inFlightPacket.Port, inFlightPacket.Sequence))]
+	require.True(h.t, found, "packet %d on %s was not sent by the router", inFlightPacket.Sequence, inFlightPacket.Channel)
+
+	return packet
+}
+
+// RelayPacket receives a packet of the Noble chain on the counterparty chain and relays its acknowledgement back.
+func (h *Harness) RelayPacket(packet channeltypes.Packet) {
+	require.NoError(h.t, h.Path.RelayPacket(packet))
+}
+
+// AcknowledgeWithError writes an error acknowledgement for a packet on the counterparty chain, without
+// receiving it, and relays the acknowledgement back to the Noble chain.
+func (h *Harness) AcknowledgeWithError(packet channeltypes.Packet, reason string) {
+	ack := channeltypes.NewErrorAcknowledgement(reason)
+	require.NoError(h.t, h.Path.EndpointB.WriteAcknowledgement(ack, packet))
+	require.NoError(h.t, h.Path.EndpointA.AcknowledgePacket(packet, ack.Acknowledgement()))
+}
+
+// TimeoutPacket advances the counterparty chain past the timeout of a packet and times it out on the Noble chain.
+func (h *Harness) TimeoutPacket(packet channeltypes.Packet) {
+	if remaining := time.Unix(0, int64(packet.TimeoutTimestamp)).Sub(h.Coordinator.CurrentTime); remaining >= 0 {
+		h.Coordinator.IncrementTimeBy(remaining + time.Second)
+	}
+	h.Coordinator.CommitBlock(h.Counterparty)
+
+	require.NoError(h.t, h.Path.EndpointA.UpdateClient())
+	require.NoError(h.t, h.Path.EndpointA.TimeoutPacket(packet))
+}
+
+// CommitBlocks commits blocks on the Noble chain, running the begin blockers of the router.
+func (h *Harness) CommitBlocks(n int) {
+	for i := 0; i < n; i++ {
+		h.Coordinator.CommitBlock(h.Noble)
+	}
+}
+
+// CounterpartyBalance returns the balance of an account on the counterparty chain in the voucher denom of a
+// denom sent over the channel of the harness.
+func (h *Harness) CounterpartyBalance(address sdk.AccAddress, denom string) sdk.Coin {
+	voucher := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(h.Path.EndpointB.ChannelConfig.PortID, h.Path.EndpointB.ChannelID, denom),
+	).IBCDenom()
+	return h.Counterparty.GetSimApp().BankKeeper.GetBalance(h.Counterparty.GetContext(), address, voucher)
+}
+
+func make32Bytes(b byte) []byte {
+	bz := make([]byte, 32)
+	for i := range bz {
+		bz[i] = b
+	}
+	return bz
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"testing"
 
 	"github.com/circlefin/noble-cctp/x/cctp/keeper"
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
@@ -60,6 +62,28 @@ func (MockCctpKeeper) GetAuthority(ctx sdk.Context) (val string, found bool) {
 	return "", true
 }
 
+// MockCctpMsgServer records the burns it receives, and only burns uusdc
+type MockCctpMsgServer struct {
+	DepositsForBurn           []types.MsgDepositForBurn
+	DepositsForBurnWithCaller []types.MsgDepositForBurnWithCaller
+}
+
+func (s *MockCctpMsgServer) DepositForBurn(_ context.Context, msg *types.MsgDepositForBurn) (*types.MsgDepositForBurnResponse, error) {
+	if msg.BurnToken != "uusdc" {
+		return nil, sdkerrors.Wrap(types.ErrDepositForBurn, "burning denom not supported")
+	}
+	s.DepositsForBurn = append(s.DepositsForBurn, *msg)
+	return &types.MsgDepositForBurnResponse{Nonce: uint64(len(s.DepositsForBurn))}, nil
+}
+
+func (s *MockCctpMsgServer) DepositForBurnWithCaller(_ context.Context, msg *types.MsgDepositForBurnWithCaller) (*types.MsgDepositForBurnWithCallerResponse, error) {
+	if msg.BurnToken != "uusdc" {
+		return nil, sdkerrors.Wrap(types.ErrDepositForBurn, "burning denom not supported")
+	}
+	s.DepositsForBurnWithCaller = append(s.DepositsForBurnWithCaller, *msg)
+	return &types.MsgDepositForBurnWithCallerResponse{Nonce: uint64(len(s.DepositsForBurnWithCaller))}, nil
+}
+
 // ErrCctpKeeper is used for wrapping a MockErrFiatTokenfactoryKeeper, which fails on mint/burn
 func ErrCctpKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
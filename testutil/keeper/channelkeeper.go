@@ -0,0 +1,14 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+type MockChannelKeeper struct {
+	Channels []channeltypes.IdentifiedChannel
+}
+
+func (k MockChannelKeeper) GetAllChannels(sdk.Context) []channeltypes.IdentifiedChannel {
+	return k.Channels
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/strangelove-ventures/noble-router/x/router/keeper"
 	"github.com/strangelove-ventures/noble-router/x/router/types"
@@ -18,6 +20,9 @@ import (
 	tmdb "github.com/tendermint/tm-db"
 )
 
+// RouterAuthority is the authority of the keepers created by RouterKeeper, the gov module account.
+var RouterAuthority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
 func RouterKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 
@@ -46,6 +51,9 @@ func RouterKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		paramsSubspace,
 		MockCctpKeeper{},
 		MockTransferKeeper{},
+		MockChannelKeeper{},
+		MockBankKeeper{},
+		RouterAuthority,
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
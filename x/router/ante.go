@@ -0,0 +1,28 @@
+package router
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+// RelayerDecorator records the first signer of a transaction as its relayer, so that the router
+// can pay the relayer tip of the IBC forwards whose pairing is completed by the transaction.
+//
+// The decorator must be part of the ante handler of the app. Without it the relayer of a transaction
+// is never known: relayer tips are not paid, and forwards with a required submitter are never paired.
+type RelayerDecorator struct{}
+
+func NewRelayerDecorator() RelayerDecorator {
+	return RelayerDecorator{}
+}
+
+func (RelayerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+		if signers := sigTx.GetSigners(); len(signers) > 0 {
+			ctx = types.ContextWithRelayer(ctx, signers[0])
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -24,10 +24,23 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowIBCForward())
 	cmd.AddCommand(CmdListInFlightPackets())
 	cmd.AddCommand(CmdShowInFlightPacket())
+	cmd.AddCommand(CmdShowInFlightPacketByNonce())
 	cmd.AddCommand(CmdListMints())
 	cmd.AddCommand(CmdShowMint())
 	cmd.AddCommand(CmdListAllowedSourceDomainSenders())
 	cmd.AddCommand(CmdShowAllowedSourceDomainSender())
+	cmd.AddCommand(CmdListAllowedChannels())
+	cmd.AddCommand(CmdShowAllowedChannel())
+	cmd.AddCommand(CmdShowForwardStatus())
+	cmd.AddCommand(CmdShowOwner())
+	cmd.AddCommand(CmdShowPendingOwner())
+	cmd.AddCommand(CmdShowPauser())
+	cmd.AddCommand(CmdShowRoutingPauseState())
+	cmd.AddCommand(CmdListHeldForwards())
+	cmd.AddCommand(CmdShowSourceDomainRateLimit())
+	cmd.AddCommand(CmdShowChannelRateLimit())
+	cmd.AddCommand(CmdListRateLimits())
+	cmd.AddCommand(CmdListRateLimitedForwards())
 
 	return cmd
 }
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+func CmdShowForwardStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-forward-status [source-domain] [nonce]",
+		Short: "shows the status of a forwarded transfer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sourceDomain, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryForwardStatusRequest{
+				SourceDomain: uint32(sourceDomain),
+				Nonce:        nonce,
+			}
+
+			res, err := queryClient.ForwardStatus(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -80,3 +80,41 @@ func CmdShowInFlightPacket() *cobra.Command {
 
 	return cmd
 }
+
+func CmdShowInFlightPacketByNonce() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-in-flight-packet-by-nonce [source-domain] [nonce]",
+		Short: "shows the in flight packet of a forwarded mint",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sourceDomain, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryInFlightPacketByNonceRequest{
+				SourceDomain: uint32(sourceDomain),
+				Nonce:        nonce,
+			}
+
+			res, err := queryClient.InFlightPacketByNonce(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -20,8 +20,21 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdUpdateOwner())
 	cmd.AddCommand(CmdAcceptOwner())
+	cmd.AddCommand(CmdSetOwner())
+	cmd.AddCommand(CmdUpdateParams())
 	cmd.AddCommand(CmdAddAllowedSourceDomainSender())
 	cmd.AddCommand(CmdRemoveAllowedSourceDomainSender())
+	cmd.AddCommand(CmdAddAllowedChannel())
+	cmd.AddCommand(CmdRemoveAllowedChannel())
+	cmd.AddCommand(CmdSetSourceDomainRateLimit())
+	cmd.AddCommand(CmdRemoveSourceDomainRateLimit())
+	cmd.AddCommand(CmdSetChannelRateLimit())
+	cmd.AddCommand(CmdRemoveChannelRateLimit())
+	cmd.AddCommand(CmdClaimFailedForward())
+	cmd.AddCommand(CmdPairForward())
+	cmd.AddCommand(CmdUpdatePauser())
+	cmd.AddCommand(CmdPauseRouting())
+	cmd.AddCommand(CmdUnpauseRouting())
 
 	return cmd
 }
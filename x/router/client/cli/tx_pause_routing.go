@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+const FlagGlobal = "global"
+
+func CmdPauseRouting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-routing [source-domain]",
+		Short: "Broadcast message pause-routing, pausing a single source domain or, with --global, all routing",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			global, sourceDomain, err := parsePauseArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPauseRouting(
+				clientCtx.GetFromAddress().String(),
+				global,
+				sourceDomain,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagGlobal, false, "Pause routing for all source domains")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnpauseRouting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause-routing [source-domain]",
+		Short: "Broadcast message unpause-routing, unpausing a single source domain or, with --global, all routing",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			global, sourceDomain, err := parsePauseArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnpauseRouting(
+				clientCtx.GetFromAddress().String(),
+				global,
+				sourceDomain,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagGlobal, false, "Unpause routing for all source domains")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parsePauseArgs returns either the global flag or the source domain argument, exactly one of which must be set.
+func parsePauseArgs(cmd *cobra.Command, args []string) (global bool, sourceDomain uint32, err error) {
+	global, err = cmd.Flags().GetBool(FlagGlobal)
+	if err != nil {
+		return false, 0, err
+	}
+
+	if global == (len(args) == 1) {
+		return false, 0, fmt.Errorf("either a source domain or the --%s flag must be provided", FlagGlobal)
+	}
+	if global {
+		return true, 0, nil
+	}
+
+	domain, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil {
+		return false, 0, err
+	}
+
+	return false, uint32(domain), nil
+}
@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+func CmdSetSourceDomainRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-source-domain-rate-limit [source-domain] [window-blocks] [max-amount]",
+		Short: "Broadcast message set-source-domain-rate-limit",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sourceDomain, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			windowBlocks, maxAmount, err := parseRateLimitArgs(args[1], args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSourceDomainRateLimit(
+				clientCtx.GetFromAddress().String(),
+				uint32(sourceDomain),
+				windowBlocks,
+				maxAmount,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveSourceDomainRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-source-domain-rate-limit [source-domain]",
+		Short: "Broadcast message remove-source-domain-rate-limit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sourceDomain, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveSourceDomainRateLimit(
+				clientCtx.GetFromAddress().String(),
+				uint32(sourceDomain),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetChannelRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-channel-rate-limit [channel] [window-blocks] [max-amount]",
+		Short: "Broadcast message set-channel-rate-limit",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			windowBlocks, maxAmount, err := parseRateLimitArgs(args[1], args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetChannelRateLimit(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				windowBlocks,
+				maxAmount,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveChannelRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-channel-rate-limit [channel]",
+		Short: "Broadcast message remove-channel-rate-limit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveChannelRateLimit(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseRateLimitArgs(windowBlocksArg string, maxAmountArg string) (uint64, sdk.Int, error) {
+	windowBlocks, err := strconv.ParseUint(windowBlocksArg, 10, 64)
+	if err != nil {
+		return 0, sdk.Int{}, err
+	}
+
+	maxAmount, ok := sdk.NewIntFromString(maxAmountArg)
+	if !ok {
+		return 0, sdk.Int{}, fmt.Errorf("invalid max amount %s", maxAmountArg)
+	}
+
+	return windowBlocks, maxAmount, nil
+}
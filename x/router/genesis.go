@@ -14,6 +14,7 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 		k.SetInFlightPacket(ctx, elem)
 	}
 
+	// SetMint also rebuilds the mint height index used for pruning
 	for _, elem := range genState.Mints {
 		k.SetMint(ctx, elem)
 	}
@@ -28,7 +29,42 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 		k.AddAllowedSourceDomainSender(ctx, elem.DomainId, elem.Address)
 	}
 
+	for _, elem := range genState.AllowedChannels {
+		k.SetAllowedChannel(ctx, elem)
+	}
+
+	for _, elem := range genState.SourceDomainRateLimits {
+		k.SetSourceDomainRateLimit(ctx, elem)
+	}
+
+	for _, elem := range genState.ChannelRateLimits {
+		k.SetChannelRateLimit(ctx, elem)
+	}
+
 	k.SetOwner(ctx, genState.Owner)
+
+	for _, elem := range genState.ForwardReceipts {
+		k.SetForwardReceipt(ctx, elem)
+	}
+
+	if genState.Pauser != "" {
+		k.SetPauser(ctx, genState.Pauser)
+	}
+	k.SetRoutingPausedGlobally(ctx, genState.RoutingPaused)
+
+	for _, elem := range genState.PausedSourceDomains {
+		k.SetSourceDomainPaused(ctx, elem, true)
+	}
+
+	for _, elem := range genState.HeldForwards {
+		if err := k.HoldForward(ctx, elem.SourceDomain, elem.Nonce); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, elem := range genState.RateLimitedForwards {
+		k.SetRateLimitedForward(ctx, elem.SourceDomain, elem.Nonce)
+	}
 }
 
 // ExportGenesis returns the module's exported GenesisState
@@ -41,6 +77,15 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	genesis.IbcForwards = k.GetAllIBCForwards(ctx)
 	genesis.Owner = k.GetOwner(ctx)
 	genesis.AllowedSourceDomainSenders = k.GetAllowedSourceDomainSenders(ctx)
+	genesis.ForwardReceipts = k.GetAllForwardReceipts(ctx)
+	genesis.Pauser = k.GetPauser(ctx)
+	genesis.RoutingPaused = k.IsRoutingPausedGlobally(ctx)
+	genesis.PausedSourceDomains = k.GetPausedSourceDomains(ctx)
+	genesis.HeldForwards = k.GetAllHeldForwards(ctx)
+	genesis.AllowedChannels = k.GetAllowedChannels(ctx)
+	genesis.SourceDomainRateLimits = k.GetAllSourceDomainRateLimits(ctx)
+	genesis.ChannelRateLimits = k.GetAllChannelRateLimits(ctx)
+	genesis.RateLimitedForwards = k.GetAllRateLimitedForwards(ctx)
 
 	return genesis
 }
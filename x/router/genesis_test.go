@@ -3,6 +3,8 @@ package router_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
 	"github.com/strangelove-ventures/noble-router/x/router"
 	"github.com/strangelove-ventures/noble-router/x/router/types"
@@ -49,6 +51,59 @@ func TestGenesis(t *testing.T) {
 				Address:  []byte{0x02},
 			},
 		},
+		ForwardReceipts: []types.ForwardReceipt{
+			{
+				SourceDomain: 9,
+				Status:       types.FORWARD_STATUS_COMPLETED,
+			},
+			{
+				SourceDomain: 10,
+				Status:       types.FORWARD_STATUS_PRUNED,
+			},
+		},
+		Pauser:              "cosmos1x8rynykqla7cnc0tf2f3xn0wa822ztt788yd5a",
+		RoutingPaused:       true,
+		PausedSourceDomains: []uint32{11, 12},
+		HeldForwards: []types.HeldForward{
+			{
+				SourceDomain: 13,
+				Nonce:        1,
+			},
+			{
+				SourceDomain: 14,
+				Nonce:        2,
+			},
+		},
+		AllowedChannels: []types.AllowedChannel{
+			{
+				Channel:    "channel-0",
+				ChainLabel: "osmosis",
+			},
+			{
+				Channel:    "channel-1",
+				ChainLabel: "dydx",
+			},
+		},
+		SourceDomainRateLimits: []types.SourceDomainRateLimit{
+			{
+				SourceDomain: 0,
+				WindowBlocks: 100,
+				MaxAmount:    sdk.NewInt(1000000),
+			},
+		},
+		ChannelRateLimits: []types.ChannelRateLimit{
+			{
+				Channel:      "channel-0",
+				WindowBlocks: 100,
+				MaxAmount:    sdk.NewInt(1000000),
+			},
+		},
+		RateLimitedForwards: []types.RateLimitedForward{
+			{
+				SourceDomain: 15,
+				Nonce:        3,
+			},
+		},
 	}
 
 	k, ctx := keepertest.RouterKeeper(t)
@@ -63,4 +118,13 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.Mints, got.Mints)
 	require.ElementsMatch(t, genesisState.IbcForwards, got.IbcForwards)
 	require.ElementsMatch(t, genesisState.AllowedSourceDomainSenders, got.AllowedSourceDomainSenders)
+	require.ElementsMatch(t, genesisState.ForwardReceipts, got.ForwardReceipts)
+	require.Equal(t, genesisState.Pauser, got.Pauser)
+	require.Equal(t, genesisState.RoutingPaused, got.RoutingPaused)
+	require.ElementsMatch(t, genesisState.PausedSourceDomains, got.PausedSourceDomains)
+	require.ElementsMatch(t, genesisState.HeldForwards, got.HeldForwards)
+	require.ElementsMatch(t, genesisState.AllowedChannels, got.AllowedChannels)
+	require.ElementsMatch(t, genesisState.SourceDomainRateLimits, got.SourceDomainRateLimits)
+	require.ElementsMatch(t, genesisState.ChannelRateLimits, got.ChannelRateLimits)
+	require.ElementsMatch(t, genesisState.RateLimitedForwards, got.RateLimitedForwards)
 }
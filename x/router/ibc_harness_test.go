@@ -0,0 +1,197 @@
+package router_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/noble-router/testutil/ibctest"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/strangelove-ventures/noble/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func harnessForward(h *ibctest.Harness, nonce uint64, receiver string) types.IBCForwardMetadata {
+	return types.IBCForwardMetadata{
+		Nonce:               nonce,
+		Port:                "transfer",
+		Channel:             h.Channel(),
+		DestinationReceiver: receiver,
+	}
+}
+
+func forwardStatus(t *testing.T, h *ibctest.Harness, nonce uint64) types.ForwardStatus {
+	q := keeper.NewQueryServer(h.App().RouterKeeper)
+	res, err := q.ForwardStatus(sdk.WrapSDKContext(h.Context()), &types.QueryForwardStatusRequest{
+		SourceDomain: ibctest.SourceDomain,
+		Nonce:        nonce,
+	})
+	require.NoError(t, err)
+	return res.Status
+}
+
+func TestHarnessForwardAcknowledged(t *testing.T) {
+	h := ibctest.NewHarness(t)
+
+	mintRecipient := sdk.AccAddress([]byte("mint-recipient------"))
+	receiver := sample.AccAddress()
+
+	require.NoError(t, h.ReceiveMint(1, mintRecipient, math.NewInt(1_000_000)))
+	require.NoError(t, h.ReceiveForward(harnessForward(h, 1, receiver)))
+	require.Equal(t, types.FORWARD_STATUS_IN_FLIGHT, forwardStatus(t, h, 1))
+
+	h.RelayPacket(h.InFlightPacket(1))
+
+	require.Equal(t, types.FORWARD_STATUS_COMPLETED, forwardStatus(t, h, 1))
+	require.Equal(t, int64(1_000_000), h.CounterpartyBalance(sdk.MustAccAddressFromBech32(receiver), "uusdc").Amount.Int64())
+	require.True(t, h.App().BankKeeper.GetBalance(h.Context(), mintRecipient, "uusdc").IsZero())
+}
+
+func TestHarnessForwardAckError(t *testing.T) {
+	h := ibctest.NewHarness(t)
+
+	mintRecipient := sdk.AccAddress([]byte("mint-recipient------"))
+	receiver := sample.AccAddress()
+
+	require.NoError(t, h.ReceiveMint(1, mintRecipient, math.NewInt(1_000_000)))
+	require.NoError(t, h.ReceiveForward(harnessForward(h, 1, receiver)))
+
+	h.AcknowledgeWithError(h.InFlightPacket(1), "receiver is blocked")
+
+	require.Equal(t, types.FORWARD_STATUS_ACK_ERROR, forwardStatus(t, h, 1))
+	forward, found := h.App().RouterKeeper.GetIBCForward(h.Context(), ibctest.SourceDomain, 1)
+	require.True(t, found)
+	require.True(t, forward.AckError)
+
+	// the transfer is refunded to the mint recipient, nothing arrives on the counterparty
+	require.Equal(t, int64(1_000_000), h.App().BankKeeper.GetBalance(h.Context(), mintRecipient, "uusdc").Amount.Int64())
+	require.True(t, h.CounterpartyBalance(sdk.MustAccAddressFromBech32(receiver), "uusdc").IsZero())
+}
+
+func TestHarnessForwardTimeoutRetried(t *testing.T) {
+	h := ibctest.NewHarness(t)
+
+	mintRecipient := sdk.AccAddress([]byte("mint-recipient------"))
+	receiver := sample.AccAddress()
+
+	require.NoError(t, h.ReceiveMint(1, mintRecipient, math.NewInt(1_000_000)))
+	require.NoError(t, h.ReceiveForward(harnessForward(h, 1, receiver)))
+
+	timedOut := h.InFlightPacket(1)
+	h.TimeoutPacket(timedOut)
+
+	require.Equal(t, types.FORWARD_STATUS_RETRY_PENDING, forwardStatus(t, h, 1))
+	require.Equal(t, int64(1_000_000), h.App().BankKeeper.GetBalance(h.Context(), mintRecipient, "uusdc").Amount.Int64())
+
+	// the retry is sent by the begin blocker once the backoff has passed
+	h.CommitBlocks(int(keeper.ForwardRetryBackoffBlocks) + 1)
+	require.Equal(t, types.FORWARD_STATUS_IN_FLIGHT, forwardStatus(t, h, 1))
+
+	retry := h.InFlightPacket(1)
+	require.Greater(t, retry.Sequence, timedOut.Sequence)
+
+	h.RelayPacket(retry)
+
+	require.Equal(t, types.FORWARD_STATUS_COMPLETED, forwardStatus(t, h, 1))
+	require.Equal(t, int64(1_000_000), h.CounterpartyBalance(sdk.MustAccAddressFromBech32(receiver), "uusdc").Amount.Int64())
+}
+
+func TestHarnessConcurrentForwards(t *testing.T) {
+	h := ibctest.NewHarness(t)
+
+	mintRecipient := sdk.AccAddress([]byte("mint-recipient------"))
+	receiver := sample.AccAddress()
+
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		require.NoError(t, h.ReceiveMint(nonce, mintRecipient, math.NewInt(1_000_000)))
+		require.NoError(t, h.ReceiveForward(harnessForward(h, nonce, receiver)))
+	}
+
+	first, second := h.InFlightPacket(1), h.InFlightPacket(2)
+	require.NotEqual(t, first.Sequence, second.Sequence)
+
+	h.RelayPacket(second)
+	require.Equal(t, types.FORWARD_STATUS_IN_FLIGHT, forwardStatus(t, h, 1))
+	require.Equal(t, types.FORWARD_STATUS_COMPLETED, forwardStatus(t, h, 2))
+
+	h.AcknowledgeWithError(first, "receiver is blocked")
+	require.Equal(t, types.FORWARD_STATUS_ACK_ERROR, forwardStatus(t, h, 1))
+
+	require.Equal(t, int64(1_000_000), h.CounterpartyBalance(sdk.MustAccAddressFromBech32(receiver), "uusdc").Amount.Int64())
+	require.Equal(t, int64(1_000_000), h.App().BankKeeper.GetBalance(h.Context(), mintRecipient, "uusdc").Amount.Int64())
+}
+
+func TestHarnessRelayerTipPaidThroughAnteHandler(t *testing.T) {
+	h := ibctest.NewHarness(t)
+
+	mintRecipient := sdk.AccAddress([]byte("mint-recipient------"))
+	receiver := sample.AccAddress()
+
+	require.NoError(t, h.ReceiveMint(1, mintRecipient, math.NewInt(1_000_000)))
+
+	// the RelayerDecorator of the ante handler records the signer of the transaction completing the pairing
+	forward := harnessForward(h, 1, receiver)
+	forward.RelayerTip = 1_000
+	require.NoError(t, h.ReceiveForwardAsRelayer(forward))
+
+	require.Equal(t, int64(1_000), h.App().BankKeeper.GetBalance(h.Context(), h.Relayer(), "uusdc").Amount.Int64())
+
+	h.RelayPacket(h.InFlightPacket(1))
+	require.Equal(t, int64(999_000), h.CounterpartyBalance(sdk.MustAccAddressFromBech32(receiver), "uusdc").Amount.Int64())
+}
+
+func TestHarnessClaimFailedForward(t *testing.T) {
+	h := ibctest.NewHarness(t)
+
+	mintRecipient := sdk.AccAddress([]byte("mint-recipient------"))
+	fallbackRecipient := sdk.AccAddress([]byte("fallback-recipient--"))
+	receiver := sample.AccAddress()
+
+	require.NoError(t, h.ReceiveMint(1, mintRecipient, math.NewInt(1_000_000)))
+
+	// the fallback recipient is committed in the forward metadata
+	forward := harnessForward(h, 1, receiver)
+	forward.FallbackRecipient = fallbackRecipient.String()
+	require.NoError(t, h.ReceiveForward(forward))
+
+	// the forward has not failed yet
+	require.ErrorIs(t, h.ClaimFailedForward(1, fallbackRecipient), types.ErrClaimFailedForward)
+
+	h.AcknowledgeWithError(h.InFlightPacket(1), "receiver is blocked")
+	require.Equal(t, int64(1_000_000), h.App().BankKeeper.GetBalance(h.Context(), mintRecipient, "uusdc").Amount.Int64())
+
+	require.ErrorIs(t, h.ClaimFailedForward(1, sdk.AccAddress([]byte("someone-else--------"))), types.ErrUnauthorized)
+	require.NoError(t, h.ClaimFailedForward(1, fallbackRecipient))
+
+	require.Equal(t, types.FORWARD_STATUS_CLAIMED, forwardStatus(t, h, 1))
+	require.True(t, h.App().BankKeeper.GetBalance(h.Context(), mintRecipient, "uusdc").IsZero())
+	require.Equal(t, int64(1_000_000), h.App().BankKeeper.GetBalance(h.Context(), fallbackRecipient, "uusdc").Amount.Int64())
+	require.True(t, h.CounterpartyBalance(sdk.MustAccAddressFromBech32(receiver), "uusdc").IsZero())
+
+	// the funds can only be claimed once
+	require.ErrorIs(t, h.ClaimFailedForward(1, fallbackRecipient), types.ErrClaimFailedForward)
+}
+
+func TestHarnessClaimFailedForwardAfterRelayerTip(t *testing.T) {
+	h := ibctest.NewHarness(t)
+
+	mintRecipient := sdk.AccAddress([]byte("mint-recipient------"))
+	fallbackRecipient := sdk.AccAddress([]byte("fallback-recipient--"))
+	receiver := sample.AccAddress()
+
+	require.NoError(t, h.ReceiveMint(1, mintRecipient, math.NewInt(1_000_000)))
+
+	forward := harnessForward(h, 1, receiver)
+	forward.FallbackRecipient = fallbackRecipient.String()
+	forward.RelayerTip = 1_000
+	require.NoError(t, h.ReceiveForwardAsRelayer(forward))
+
+	h.AcknowledgeWithError(h.InFlightPacket(1), "receiver is blocked")
+	require.NoError(t, h.ClaimFailedForward(1, fallbackRecipient))
+
+	// the relayer tip was paid when the forward was sent, the rest is released to the fallback recipient
+	require.Equal(t, int64(1_000), h.App().BankKeeper.GetBalance(h.Context(), h.Relayer(), "uusdc").Amount.Int64())
+	require.Equal(t, int64(999_000), h.App().BankKeeper.GetBalance(h.Context(), fallbackRecipient, "uusdc").Amount.Int64())
+	require.True(t, h.App().BankKeeper.GetBalance(h.Context(), mintRecipient, "uusdc").IsZero())
+}
@@ -10,6 +10,7 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	routertypes "github.com/strangelove-ventures/noble-router/x/router/types"
 )
 
 var _ porttypes.IBCModule = IBCMiddleware{}
@@ -40,19 +41,59 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
 		}
 
+		amount, fee, receiver := im.forwardDetails(ctx, inFlightPacket)
+
 		if ack.Success() {
 			im.keeper.DeleteMint(ctx, inFlightPacket.SourceDomain, inFlightPacket.Nonce)
 			im.keeper.DeleteIBCForward(ctx, inFlightPacket.SourceDomain, inFlightPacket.Nonce)
 			im.keeper.DeleteInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+			im.keeper.SetForwardReceipt(ctx, routertypes.ForwardReceipt{
+				SourceDomain: inFlightPacket.SourceDomain,
+				Nonce:        inFlightPacket.Nonce,
+				Status:       routertypes.FORWARD_STATUS_COMPLETED,
+				Height:       uint64(ctx.BlockHeight()),
+				Port:         packet.SourcePort,
+				Channel:      packet.SourceChannel,
+				Sequence:     packet.Sequence,
+			})
+
+			if err := ctx.EventManager().EmitTypedEvent(&routertypes.ForwardPacketAcknowledged{
+				SourceDomain: inFlightPacket.SourceDomain,
+				Nonce:        inFlightPacket.Nonce,
+				Port:         packet.SourcePort,
+				Channel:      packet.SourceChannel,
+				Sequence:     packet.Sequence,
+				Amount:       amount,
+				Receiver:     receiver,
+				Fee:          fee,
+			}); err != nil {
+				return err
+			}
 
+			im.keeper.AfterForwardAcked(ctx, inFlightPacket)
 		} else { // error on destination
 			im.keeper.DeleteInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
 
+			if err := ctx.EventManager().EmitTypedEvent(&routertypes.ForwardPacketAckError{
+				SourceDomain: inFlightPacket.SourceDomain,
+				Nonce:        inFlightPacket.Nonce,
+				Port:         packet.SourcePort,
+				Channel:      packet.SourceChannel,
+				Sequence:     packet.Sequence,
+				Amount:       amount,
+				Receiver:     receiver,
+				Error:        ack.GetError(),
+			}); err != nil {
+				return err
+			}
+
 			// keep mint and mark IBCForward to indicate ack error for retry for future replaceDepositForBurnWithMetadata
 			if existingIBCForward, found := im.keeper.GetIBCForward(ctx, inFlightPacket.SourceDomain, inFlightPacket.Nonce); found {
 				existingIBCForward.AckError = true
 				im.keeper.SetIBCForward(ctx, existingIBCForward)
 			}
+
+			im.keeper.AfterForwardFailed(ctx, inFlightPacket.SourceDomain, inFlightPacket.Nonce, ack.GetError())
 		}
 	}
 
@@ -74,7 +115,7 @@ func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Pac
 
 	if inFlightPacket, found := im.keeper.GetInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence); found {
 		im.keeper.DeleteInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
-		// timeout should be retried. In order to do that, we need to handle this timeout to refund on this chain first.
+		// timeout may be retried. In order to do that, we need to handle this timeout to refund on this chain first.
 		if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
 			return err
 		}
@@ -84,16 +125,49 @@ func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Pac
 			panic("no existing ibc forward metadata in store for in flight packet")
 		}
 
-		existingMint, found := im.keeper.GetMint(ctx, inFlightPacket.SourceDomain, inFlightPacket.Nonce)
-		if !found {
+		if _, found := im.keeper.GetMint(ctx, inFlightPacket.SourceDomain, inFlightPacket.Nonce); !found {
 			panic("no existing mint in store for in flight packet")
 		}
-		return im.keeper.ForwardPacket(ctx, existingIBCForward.Metadata, existingMint)
+
+		amount, _, receiver := im.forwardDetails(ctx, inFlightPacket)
+		if err := ctx.EventManager().EmitTypedEvent(&routertypes.ForwardPacketTimedOut{
+			SourceDomain: inFlightPacket.SourceDomain,
+			Nonce:        inFlightPacket.Nonce,
+			Port:         packet.SourcePort,
+			Channel:      packet.SourceChannel,
+			Sequence:     packet.Sequence,
+			Amount:       amount,
+			Receiver:     receiver,
+		}); err != nil {
+			return err
+		}
+
+		// the retry is sent after a backoff, or the forward is marked as failed once out of retries
+		return im.keeper.HandleForwardTimeout(ctx, existingIBCForward)
 	}
 
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
 
+// forwardDetails returns the forwarded amount and destination receiver of an in flight packet.
+func (im IBCMiddleware) forwardDetails(ctx sdk.Context, inFlightPacket routertypes.InFlightPacket) (amount sdk.Coin, fee sdk.Coin, receiver string) {
+	if mint, found := im.keeper.GetMint(ctx, inFlightPacket.SourceDomain, inFlightPacket.Nonce); found && mint.Amount != nil {
+		amount = *mint.Amount
+		fee = sdk.NewCoin(amount.Denom, sdk.ZeroInt())
+	}
+	if forward, found := im.keeper.GetIBCForward(ctx, inFlightPacket.SourceDomain, inFlightPacket.Nonce); found {
+		receiver = forward.Metadata.DestinationReceiver
+		if forward.RelayerTip != nil && amount.Denom == forward.RelayerTip.Denom {
+			amount = amount.Sub(*forward.RelayerTip)
+		}
+		if forward.Fee != nil && amount.Denom == forward.Fee.Denom {
+			fee = *forward.Fee
+			amount = amount.Sub(fee)
+		}
+	}
+	return amount, fee, receiver
+}
+
 // OnChanOpenInit implements the IBCModule interface.
 func (im IBCMiddleware) OnChanOpenInit(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID string, channelID string, channelCap *types.Capability, counterparty channeltypes.Counterparty, version string) error {
 	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, version)
@@ -124,7 +198,84 @@ func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID st
 	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
 }
 
-// OnRecvPacket implements the IBCModule interface.
+// OnRecvPacket implements the IBCModule interface. Inbound ICS-20 transfers whose memo carries a
+// router instruction are received by the inbound burner and burned through CCTP, any failure
+// returns an error acknowledgement so that the transfer is refunded on the sending chain.
 func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
-	return im.app.OnRecvPacket(ctx, packet, relayer)
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	instruction, ok, err := routertypes.ParseInboundMemo(data.Memo)
+	if !ok {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(routertypes.ErrInboundBurn, err.Error()).Error())
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(routertypes.ErrInboundBurn, "invalid amount %s", data.Amount).Error())
+	}
+
+	// the transfer is received by the inbound burner, so that it can be burned through CCTP
+	data.Receiver = routertypes.InboundBurnerAddress.String()
+	packet.Data = data.GetBytes()
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	ack := im.app.OnRecvPacket(cacheCtx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	burned := sdk.NewCoin(receivedDenom(packet, data.Denom), amount)
+	nonce, err := im.keeper.BurnInbound(cacheCtx, burned, *instruction)
+	if err != nil {
+		im.keeper.Logger(ctx).Error("error burning inbound transfer",
+			"sequence", packet.Sequence,
+			"dst-channel", packet.DestinationChannel, "dst-port", packet.DestinationPort,
+			"error", err,
+		)
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	// the instruction was validated when parsing the memo
+	mintRecipient, _ := instruction.MintRecipientBytes()
+	destinationCaller, _ := instruction.DestinationCallerBytes()
+	if err := ctx.EventManager().EmitTypedEvent(&routertypes.InboundTransferBurned{
+		Port:              packet.DestinationPort,
+		Channel:           packet.DestinationChannel,
+		Sequence:          packet.Sequence,
+		DestinationDomain: instruction.DestinationDomain,
+		MintRecipient:     mintRecipient,
+		DestinationCaller: destinationCaller,
+		Amount:            burned,
+		Nonce:             nonce,
+	}); err != nil {
+		im.keeper.Logger(ctx).Error("error emitting inbound transfer burned event", "error", err)
+	}
+
+	return ack
+}
+
+// receivedDenom returns the denom of an ICS-20 transfer on this chain, following the ICS-20
+// receive logic of the transfer module.
+func receivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		unprefixedDenom := denom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+
+		denomTrace := transfertypes.ParseDenomTrace(unprefixedDenom)
+		if denomTrace.Path != "" {
+			return denomTrace.IBCDenom()
+		}
+		return unprefixedDenom
+	}
+
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
 }
@@ -0,0 +1,261 @@
+package router_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/strangelove-ventures/noble/testutil/sample"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// mockTransferApp acknowledges every packet it receives successfully.
+type mockTransferApp struct {
+	porttypes.IBCModule
+	received []transfertypes.FungibleTokenPacketData
+}
+
+func (a *mockTransferApp) OnRecvPacket(_ sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	transfertypes.ModuleCdc.MustUnmarshalJSON(packet.GetData(), &data)
+	a.received = append(a.received, data)
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func (a *mockTransferApp) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress) error {
+	return nil
+}
+
+// ackHooks records the acknowledgement hooks called by the router.
+type ackHooks struct {
+	types.MultiRouterHooks
+	acked  []uint64
+	failed []string
+	err    error
+}
+
+func (h *ackHooks) AfterForwardAcked(_ sdk.Context, inFlightPacket types.InFlightPacket) error {
+	h.acked = append(h.acked, inFlightPacket.Nonce)
+	return h.err
+}
+
+func (h *ackHooks) AfterForwardFailed(_ sdk.Context, _ uint32, _ uint64, reason string) error {
+	h.failed = append(h.failed, reason)
+	return h.err
+}
+
+func inboundPacket(denom string, memo string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, "10000", sample.AccAddress(), sample.AccAddress())
+	data.Memo = memo
+
+	return channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-5",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-10",
+		Data:               data.GetBytes(),
+	}
+}
+
+func TestOnRecvPacketBurnsInboundTransfer(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	cctpMsgServer := &keepertest.MockCctpMsgServer{}
+	routerKeeper.SetCctpMsgServer(cctpMsgServer)
+
+	app := &mockTransferApp{}
+	middleware := router.NewIBCMiddleware(app, routerKeeper)
+
+	memo := `{"router":{"destination_domain":0,"mint_recipient":"0x000000000000000000000000000000000000dEaD"}}`
+	ack := middleware.OnRecvPacket(ctx, inboundPacket("transfer/channel-5/uusdc", memo), sdk.AccAddress{})
+	require.True(t, ack.Success())
+
+	require.Len(t, app.received, 1)
+	require.Equal(t, types.InboundBurnerAddress.String(), app.received[0].Receiver)
+
+	require.Len(t, cctpMsgServer.DepositsForBurn, 1)
+	deposit := cctpMsgServer.DepositsForBurn[0]
+	require.Equal(t, types.InboundBurnerAddress.String(), deposit.From)
+	require.Equal(t, "uusdc", deposit.BurnToken)
+	require.Equal(t, int64(10000), deposit.Amount.Int64())
+	require.Len(t, deposit.MintRecipient, 32)
+}
+
+func TestOnRecvPacketBurnsInboundTransferWithCaller(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	cctpMsgServer := &keepertest.MockCctpMsgServer{}
+	routerKeeper.SetCctpMsgServer(cctpMsgServer)
+
+	middleware := router.NewIBCMiddleware(&mockTransferApp{}, routerKeeper)
+
+	memo := `{"router":{"destination_domain":0,"mint_recipient":"0x000000000000000000000000000000000000dEaD","destination_caller":"0x000000000000000000000000000000000000bEEF"}}`
+	ack := middleware.OnRecvPacket(ctx, inboundPacket("transfer/channel-5/uusdc", memo), sdk.AccAddress{})
+	require.True(t, ack.Success())
+
+	require.Empty(t, cctpMsgServer.DepositsForBurn)
+	require.Len(t, cctpMsgServer.DepositsForBurnWithCaller, 1)
+}
+
+func TestOnRecvPacketPassesThroughWithoutInstruction(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	cctpMsgServer := &keepertest.MockCctpMsgServer{}
+	routerKeeper.SetCctpMsgServer(cctpMsgServer)
+
+	app := &mockTransferApp{}
+	middleware := router.NewIBCMiddleware(app, routerKeeper)
+
+	packet := inboundPacket("transfer/channel-5/uusdc", `{"wasm":{}}`)
+	ack := middleware.OnRecvPacket(ctx, packet, sdk.AccAddress{})
+	require.True(t, ack.Success())
+
+	require.Len(t, app.received, 1)
+	require.NotEqual(t, types.InboundBurnerAddress.String(), app.received[0].Receiver)
+	require.Empty(t, cctpMsgServer.DepositsForBurn)
+}
+
+func TestOnRecvPacketErrorAcknowledgement(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		denom string
+		memo  string
+	}{
+		{
+			desc:  "malformed instruction",
+			denom: "transfer/channel-5/uusdc",
+			memo:  `{"router":{"destination_domain":"zero"}}`,
+		},
+		{
+			desc:  "invalid mint recipient",
+			denom: "transfer/channel-5/uusdc",
+			memo:  `{"router":{"destination_domain":0,"mint_recipient":"0x1234"}}`,
+		},
+		{
+			desc:  "denom that cannot be burned",
+			denom: "uatom",
+			memo:  `{"router":{"destination_domain":0,"mint_recipient":"0x000000000000000000000000000000000000dEaD"}}`,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			routerKeeper, ctx := keepertest.RouterKeeper(t)
+			routerKeeper.SetCctpMsgServer(&keepertest.MockCctpMsgServer{})
+
+			middleware := router.NewIBCMiddleware(&mockTransferApp{}, routerKeeper)
+
+			ack := middleware.OnRecvPacket(ctx, inboundPacket(tc.denom, tc.memo), sdk.AccAddress{})
+			require.False(t, ack.Success())
+		})
+	}
+}
+
+func TestOnAcknowledgementPacketCallsHooks(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	hooks := &ackHooks{}
+	routerKeeper.SetHooks(hooks)
+
+	middleware := router.NewIBCMiddleware(&mockTransferApp{}, routerKeeper)
+
+	for sequence := uint64(1); sequence <= 2; sequence++ {
+		routerKeeper.SetInFlightPacket(ctx, types.InFlightPacket{
+			SourceDomain: 1,
+			Nonce:        sequence,
+			Channel:      "channel-10",
+			Port:         "transfer",
+			Sequence:     sequence,
+		})
+	}
+
+	packet := channeltypes.Packet{Sequence: 1, SourcePort: "transfer", SourceChannel: "channel-10"}
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), sdk.AccAddress{}))
+	require.Equal(t, []uint64{1}, hooks.acked)
+
+	packet.Sequence = 2
+	ack = channeltypes.NewErrorAcknowledgement("failed on destination")
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), sdk.AccAddress{}))
+	require.Equal(t, []string{"failed on destination"}, hooks.failed)
+}
+
+func TestOnAcknowledgementPacketIgnoresHookErrors(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	hooks := &ackHooks{err: fmt.Errorf("hook error")}
+	routerKeeper.SetHooks(hooks)
+
+	middleware := router.NewIBCMiddleware(&mockTransferApp{}, routerKeeper)
+
+	for sequence := uint64(1); sequence <= 2; sequence++ {
+		routerKeeper.SetInFlightPacket(ctx, types.InFlightPacket{
+			SourceDomain: 1,
+			Nonce:        sequence,
+			Channel:      "channel-10",
+			Port:         "transfer",
+			Sequence:     sequence,
+		})
+	}
+
+	// a failing hook does not fail the acknowledgement of the packet
+	packet := channeltypes.Packet{Sequence: 1, SourcePort: "transfer", SourceChannel: "channel-10"}
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), sdk.AccAddress{}))
+	require.Equal(t, []uint64{1}, hooks.acked)
+
+	packet.Sequence = 2
+	ack = channeltypes.NewErrorAcknowledgement("failed on destination")
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), sdk.AccAddress{}))
+	require.Equal(t, []string{"failed on destination"}, hooks.failed)
+
+	_, found := routerKeeper.GetInFlightPacket(ctx, "channel-10", "transfer", 2)
+	require.False(t, found)
+}
+
+func TestOnAcknowledgementPacketReportsNetAmountAndFee(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	middleware := router.NewIBCMiddleware(&mockTransferApp{}, routerKeeper)
+
+	routerKeeper.SetMint(ctx, types.Mint{
+		SourceDomain:  1,
+		Nonce:         2,
+		Amount:        &sdk.Coin{Denom: "uusdc", Amount: sdk.NewInt(10000)},
+		MintRecipient: sample.AccAddress(),
+	})
+	fee := sdk.NewInt64Coin("uusdc", 100)
+	relayerTip := sdk.NewInt64Coin("uusdc", 50)
+	routerKeeper.SetIBCForward(ctx, types.StoreIBCForwardMetadata{
+		SourceDomain: 1,
+		Metadata:     &types.IBCForwardMetadata{Nonce: 2, DestinationReceiver: "receiver"},
+		Fee:          &fee,
+		RelayerTip:   &relayerTip,
+	})
+	routerKeeper.SetInFlightPacket(ctx, types.InFlightPacket{
+		SourceDomain: 1,
+		Nonce:        2,
+		Channel:      "channel-10",
+		Port:         "transfer",
+		Sequence:     1,
+	})
+
+	packet := channeltypes.Packet{Sequence: 1, SourcePort: "transfer", SourceChannel: "channel-10"}
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), sdk.AccAddress{}))
+
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "noble.router.ForwardPacketAcknowledged" {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		acknowledged := msg.(*types.ForwardPacketAcknowledged)
+		require.Equal(t, sdk.NewInt64Coin("uusdc", 9850), acknowledged.Amount)
+		require.Equal(t, fee, acknowledged.Fee)
+		require.Equal(t, "receiver", acknowledged.Receiver)
+		return
+	}
+	require.FailNow(t, "no forward packet acknowledged event")
+}
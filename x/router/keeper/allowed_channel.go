@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetAllowedChannel returns an allowed channel from its channel identifier
+func (k *Keeper) GetAllowedChannel(ctx sdk.Context, channel string) (val types.AllowedChannel, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AllowedChannelKeyPrefix)
+
+	b := store.Get([]byte(channel))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// IsAllowedChannel returns true if IBC forwards may be sent over the channel
+func (k *Keeper) IsAllowedChannel(ctx sdk.Context, channel string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AllowedChannelKeyPrefix)
+	return store.Has([]byte(channel))
+}
+
+// SetAllowedChannel adds or updates an allowed channel
+func (k *Keeper) SetAllowedChannel(ctx sdk.Context, allowedChannel types.AllowedChannel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AllowedChannelKeyPrefix)
+	b := k.cdc.MustMarshal(&allowedChannel)
+	store.Set([]byte(allowedChannel.Channel), b)
+}
+
+// DeleteAllowedChannel removes an allowed channel
+func (k *Keeper) DeleteAllowedChannel(ctx sdk.Context, channel string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AllowedChannelKeyPrefix)
+	store.Delete([]byte(channel))
+}
+
+// GetAllowedChannels returns all allowed channels
+func (k *Keeper) GetAllowedChannels(ctx sdk.Context) (list []types.AllowedChannel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AllowedChannelKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AllowedChannel
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k *Keeper) GetAllAllowedChannelsPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]types.AllowedChannel, *query.PageResponse, error) {
+	var allowedChannels []types.AllowedChannel
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AllowedChannelKeyPrefix)
+
+	pageRes, err := query.Paginate(store, pagination, func(_ []byte, value []byte) error {
+		var val types.AllowedChannel
+		if err := k.cdc.Unmarshal(value, &val); err != nil {
+			return err
+		}
+		allowedChannels = append(allowedChannels, val)
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return allowedChannels, pageRes, nil
+}
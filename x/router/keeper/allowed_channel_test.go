@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/strangelove-ventures/noble/testutil/nullify"
+	"github.com/stretchr/testify/require"
+)
+
+func createNAllowedChannel(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.AllowedChannel {
+	items := make([]types.AllowedChannel, n)
+	for i := range items {
+		items[i].Channel = channeltypes.FormatChannelIdentifier(uint64(i))
+		items[i].ChainLabel = "chain-" + strconv.Itoa(i)
+
+		keeper.SetAllowedChannel(ctx, items[i])
+	}
+	return items
+}
+
+func TestAllowedChannelGet(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	items := createNAllowedChannel(routerKeeper, ctx, 10)
+	for _, item := range items {
+		rst, found := routerKeeper.GetAllowedChannel(ctx, item.Channel)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+		require.True(t, routerKeeper.IsAllowedChannel(ctx, item.Channel))
+	}
+}
+
+func TestAllowedChannelRemove(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	items := createNAllowedChannel(routerKeeper, ctx, 10)
+	for _, item := range items {
+		routerKeeper.DeleteAllowedChannel(ctx, item.Channel)
+		_, found := routerKeeper.GetAllowedChannel(ctx, item.Channel)
+		require.False(t, found)
+		require.False(t, routerKeeper.IsAllowedChannel(ctx, item.Channel))
+	}
+}
+
+func TestAllowedChannelGetAll(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	items := createNAllowedChannel(routerKeeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(routerKeeper.GetAllowedChannels(ctx)),
+	)
+}
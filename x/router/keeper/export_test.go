@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+// SetAllowedSourceDomainSenderKey writes a raw allowed source domain sender key, so that tests can corrupt the store.
+func (k *Keeper) SetAllowedSourceDomainSenderKey(ctx sdk.Context, key []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AllowedSourceDomainSenderKeyPrefix)
+	store.Set(key, []byte{})
+}
+
+// HasMintHeightIndex reports whether a mint is indexed by its height, so that tests can check what Prune visits.
+func (k *Keeper) HasMintHeightIndex(ctx sdk.Context, mint types.Mint) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintByHeightPrefix)
+	return store.Has(types.MintByHeightKey(mint.Height, mint.SourceDomain, mint.Nonce))
+}
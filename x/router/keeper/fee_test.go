@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/strangelove-ventures/noble/testutil/sample"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+/*
+* Fee charged from the source domain
+* Channel fee takes precedence
+* Amount below the minimum fee
+* Fee not charged twice on retry
+ */
+
+func setupFeeForward(ctx sdk.Context, routerKeeper *keeper.Keeper, amount int64) (*types.IBCForwardMetadata, types.Mint) {
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+
+	params := types.DefaultParams()
+	params.FeeCollector = sample.AccAddress()
+	params.SourceDomainFees = []types.SourceDomainFee{{
+		SourceDomain: 1,
+		Fee:          types.Fee{Bps: 100, MinFees: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 50))},
+	}}
+	routerKeeper.SetParams(ctx, params)
+
+	forward, mint := setupForward(ctx, routerKeeper, 1, 2, amount)
+	return forward.Metadata, mint
+}
+
+func forwardPacketSent(t *testing.T, ctx sdk.Context) *types.ForwardPacketSent {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "noble.router.ForwardPacketSent" {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		return msg.(*types.ForwardPacketSent)
+	}
+	require.FailNow(t, "no forward packet sent event")
+	return nil
+}
+
+func TestForwardPacketChargesFee(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	metadata, mint := setupFeeForward(ctx, routerKeeper, 10000)
+
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, metadata, mint))
+
+	forward, found := routerKeeper.GetIBCForward(ctx, 1, 2)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 100), *forward.Fee)
+
+	event := forwardPacketSent(t, ctx)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 9900), event.Amount)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 100), event.Fee)
+}
+
+func TestForwardPacketChargesMinimumFee(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	metadata, mint := setupFeeForward(ctx, routerKeeper, 1000)
+
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, metadata, mint))
+
+	event := forwardPacketSent(t, ctx)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 950), event.Amount)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 50), event.Fee)
+}
+
+func TestForwardPacketChannelFeePrecedence(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	metadata, mint := setupFeeForward(ctx, routerKeeper, 10000)
+
+	params := routerKeeper.GetParams(ctx)
+	params.ChannelFees = []types.ChannelFee{{Channel: "channel-10", Fee: types.Fee{Bps: 10}}}
+	routerKeeper.SetParams(ctx, params)
+
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, metadata, mint))
+
+	event := forwardPacketSent(t, ctx)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 9990), event.Amount)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 10), event.Fee)
+}
+
+func TestForwardPacketBelowMinimumFee(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	metadata, mint := setupFeeForward(ctx, routerKeeper, 50)
+
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, metadata, mint))
+
+	forward, found := routerKeeper.GetIBCForward(ctx, 1, 2)
+	require.True(t, found)
+	require.True(t, forward.Failed)
+	require.Nil(t, forward.Fee)
+
+	_, found = routerKeeper.GetInFlightPacketByNonce(ctx, 1, 2)
+	require.False(t, found)
+
+	// the whole mint stays claimable
+	_, found = routerKeeper.GetMint(ctx, 1, 2)
+	require.True(t, found)
+}
+
+func TestForwardPacketChargesFeeOnce(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	metadata, mint := setupFeeForward(ctx, routerKeeper, 10000)
+
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, metadata, mint))
+
+	// a retry after a timeout sends the same net amount without charging the fee again
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, metadata, mint))
+
+	event := forwardPacketSent(t, ctx)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 9900), event.Amount)
+
+	forward, found := routerKeeper.GetIBCForward(ctx, 1, 2)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 100), *forward.Fee)
+}
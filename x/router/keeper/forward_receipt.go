@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetForwardReceipt sets a ForwardReceipt in the store and keeps the height index up to date
+func (k *Keeper) SetForwardReceipt(ctx sdk.Context, receipt types.ForwardReceipt) {
+	if existing, found := k.GetForwardReceipt(ctx, receipt.SourceDomain, receipt.Nonce); found && existing.Height != receipt.Height {
+		k.deleteForwardReceiptHeightIndex(ctx, existing)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ForwardReceiptPrefix)
+	b := k.cdc.MustMarshal(&receipt)
+	store.Set(types.LookupKey(receipt.SourceDomain, receipt.Nonce), b)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ForwardReceiptByHeightPrefix)
+	indexStore.Set(types.ForwardReceiptByHeightKey(receipt.Height, receipt.SourceDomain, receipt.Nonce), []byte{})
+}
+
+// GetForwardReceipt returns ForwardReceipt
+func (k *Keeper) GetForwardReceipt(ctx sdk.Context, sourceDomain uint32, nonce uint64) (val types.ForwardReceipt, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ForwardReceiptPrefix)
+
+	b := store.Get(types.LookupKey(sourceDomain, nonce))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// DeleteForwardReceipt removes a ForwardReceipt and its height index entry from the store
+func (k *Keeper) DeleteForwardReceipt(ctx sdk.Context, sourceDomain uint32, nonce uint64) {
+	if existing, found := k.GetForwardReceipt(ctx, sourceDomain, nonce); found {
+		k.deleteForwardReceiptHeightIndex(ctx, existing)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ForwardReceiptPrefix)
+	store.Delete(types.LookupKey(sourceDomain, nonce))
+}
+
+// deleteForwardReceiptHeightIndex removes the height index entry of a ForwardReceipt
+func (k *Keeper) deleteForwardReceiptHeightIndex(ctx sdk.Context, receipt types.ForwardReceipt) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ForwardReceiptByHeightPrefix)
+	store.Delete(types.ForwardReceiptByHeightKey(receipt.Height, receipt.SourceDomain, receipt.Nonce))
+}
+
+// GetAllForwardReceipts returns all ForwardReceipts
+func (k *Keeper) GetAllForwardReceipts(ctx sdk.Context) (list []types.ForwardReceipt) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ForwardReceiptPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ForwardReceipt
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -12,6 +12,8 @@ type queryServerRouterKeeper interface {
 
 	IsAllowedSourceDomainSender(ctx sdk.Context, domainID uint32, address []byte) bool
 	GetAllAllowedSourceDomainSendersPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]types.AllowedSourceDomainSender, *query.PageResponse, error)
+	GetAllowedChannel(ctx sdk.Context, channel string) (val types.AllowedChannel, found bool)
+	GetAllAllowedChannelsPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]types.AllowedChannel, *query.PageResponse, error)
 
 	GetIBCForward(ctx sdk.Context, sourceDomain uint32, nonce uint64) (types.StoreIBCForwardMetadata, bool)
 	GetAllIBCForwardsPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]types.StoreIBCForwardMetadata, *query.PageResponse, error)
@@ -21,6 +23,27 @@ type queryServerRouterKeeper interface {
 
 	GetMint(ctx sdk.Context, sourceDomain uint32, nonce uint64) (types.Mint, bool)
 	GetAllMintsPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]types.Mint, *query.PageResponse, error)
+
+	GetForwardReceipt(ctx sdk.Context, sourceDomain uint32, nonce uint64) (types.ForwardReceipt, bool)
+	GetInFlightPacketByNonce(ctx sdk.Context, sourceDomain uint32, nonce uint64) (types.InFlightPacket, bool)
+
+	GetOwner(ctx sdk.Context) string
+	GetPendingOwner(ctx sdk.Context) (string, bool)
+
+	GetPauser(ctx sdk.Context) string
+	IsRoutingPausedGlobally(ctx sdk.Context) bool
+	GetPausedSourceDomains(ctx sdk.Context) []uint32
+	IsForwardHeld(ctx sdk.Context, sourceDomain uint32, nonce uint64) bool
+	GetAllHeldForwardsPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]types.HeldForward, *query.PageResponse, error)
+
+	GetSourceDomainRateLimit(ctx sdk.Context, sourceDomain uint32) (val types.SourceDomainRateLimit, found bool)
+	GetSourceDomainRateLimitUsage(ctx sdk.Context, sourceDomain uint32) types.RateLimitUsage
+	GetAllSourceDomainRateLimits(ctx sdk.Context) []types.SourceDomainRateLimit
+	GetChannelRateLimit(ctx sdk.Context, channel string) (val types.ChannelRateLimit, found bool)
+	GetChannelRateLimitUsage(ctx sdk.Context, channel string) types.RateLimitUsage
+	GetAllChannelRateLimits(ctx sdk.Context) []types.ChannelRateLimit
+	IsForwardRateLimited(ctx sdk.Context, sourceDomain uint32, nonce uint64) bool
+	GetAllRateLimitedForwardsPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]types.RateLimitedForward, *query.PageResponse, error)
 }
 
 var _ queryServerRouterKeeper = &Keeper{}
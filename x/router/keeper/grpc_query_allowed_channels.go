@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q QueryServer) AllowedChannel(c context.Context, req *types.QueryAllowedChannelRequest) (*types.QueryAllowedChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := q.keeper.GetAllowedChannel(ctx, req.Channel)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryAllowedChannelResponse{AllowedChannel: val}, nil
+}
+
+func (q QueryServer) AllowedChannels(c context.Context, req *types.QueryAllowedChannelsRequest) (*types.QueryAllowedChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	allowedChannels, pageRes, err := q.keeper.GetAllAllowedChannelsPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllowedChannelsResponse{AllowedChannels: allowedChannels, Pagination: pageRes}, nil
+}
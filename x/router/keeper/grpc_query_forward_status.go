@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q QueryServer) ForwardStatus(c context.Context, req *types.QueryForwardStatusRequest) (*types.QueryForwardStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if receipt, found := q.keeper.GetForwardReceipt(ctx, req.SourceDomain, req.Nonce); found {
+		return &types.QueryForwardStatusResponse{Status: receipt.Status, Receipt: &receipt}, nil
+	}
+
+	forward, forwardFound := q.keeper.GetIBCForward(ctx, req.SourceDomain, req.Nonce)
+	_, mintFound := q.keeper.GetMint(ctx, req.SourceDomain, req.Nonce)
+
+	switch {
+	case !forwardFound && !mintFound:
+		return nil, status.Error(codes.NotFound, "not found")
+	case !forwardFound:
+		return &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_AWAITING_METADATA}, nil
+	case forward.Failed:
+		return &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_FAILED}, nil
+	case forward.AckError:
+		return &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_ACK_ERROR}, nil
+	case forward.Unpaired:
+		return &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_UNPAIRED}, nil
+	case q.keeper.IsForwardHeld(ctx, req.SourceDomain, req.Nonce):
+		return &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_HELD}, nil
+	case q.keeper.IsForwardRateLimited(ctx, req.SourceDomain, req.Nonce):
+		return &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_RATE_LIMITED}, nil
+	case forward.NextRetryHeight != 0:
+		return &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_RETRY_PENDING}, nil
+	case !mintFound:
+		return &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_AWAITING_MINT}, nil
+	}
+
+	// a matched forward that is neither queued nor retrying has sent its packet
+	if inFlightPacket, found := q.keeper.GetInFlightPacketByNonce(ctx, req.SourceDomain, req.Nonce); found {
+		return &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_IN_FLIGHT, InFlightPacket: &inFlightPacket}, nil
+	}
+
+	// the timed out packet of the forward is about to be retried
+	return &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_RETRY_PENDING}, nil
+}
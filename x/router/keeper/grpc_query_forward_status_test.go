@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	routerkeeper "github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+func TestForwardStatusQuery(t *testing.T) {
+	keeper, ctx := keepertest.RouterKeeper(t)
+	queryServer := routerkeeper.NewQueryServer(keeper)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	mint := func(nonce uint64) {
+		keeper.SetMint(ctx, types.Mint{SourceDomain: 0, Nonce: nonce, Amount: &sdk.Coin{Denom: "uusdc", Amount: sdk.NewInt(1)}})
+	}
+	forward := func(nonce uint64, modify func(*types.StoreIBCForwardMetadata)) {
+		f := createRetryForward(0, nonce)
+		modify(&f)
+		keeper.SetIBCForward(ctx, f)
+	}
+
+	// awaiting mint
+	forward(1, func(*types.StoreIBCForwardMetadata) {})
+	// awaiting metadata
+	mint(2)
+	// in flight
+	mint(3)
+	forward(3, func(*types.StoreIBCForwardMetadata) {})
+	inFlightPacket := types.InFlightPacket{SourceDomain: 0, Nonce: 3, Port: "transfer", Channel: "channel-10", Sequence: 7}
+	keeper.SetInFlightPacket(ctx, inFlightPacket)
+	// ack error
+	mint(4)
+	forward(4, func(f *types.StoreIBCForwardMetadata) { f.AckError = true })
+	// retry pending
+	mint(5)
+	forward(5, func(f *types.StoreIBCForwardMetadata) { f.Retries, f.NextRetryHeight = 1, 10 })
+	// failed
+	mint(6)
+	forward(6, func(f *types.StoreIBCForwardMetadata) { f.Failed = true })
+	// held
+	mint(8)
+	forward(8, func(*types.StoreIBCForwardMetadata) {})
+	require.NoError(t, keeper.HoldForward(ctx, 0, 8))
+	// rate limited
+	mint(9)
+	forward(9, func(*types.StoreIBCForwardMetadata) {})
+	keeper.SetRateLimitedForward(ctx, 0, 9)
+	// held retry
+	mint(10)
+	forward(10, func(f *types.StoreIBCForwardMetadata) { f.Retries = 1 })
+	require.NoError(t, keeper.HoldForward(ctx, 0, 10))
+	// matched without an in flight packet
+	mint(11)
+	forward(11, func(f *types.StoreIBCForwardMetadata) { f.Retries = 1 })
+	// completed
+	receipt := types.ForwardReceipt{SourceDomain: 0, Nonce: 7, Status: types.FORWARD_STATUS_COMPLETED, Port: "transfer", Channel: "channel-10", Sequence: 8}
+	keeper.SetForwardReceipt(ctx, receipt)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryForwardStatusRequest
+		response *types.QueryForwardStatusResponse
+		err      error
+	}{
+		{
+			desc:     "AwaitingMint",
+			request:  &types.QueryForwardStatusRequest{Nonce: 1},
+			response: &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_AWAITING_MINT},
+		},
+		{
+			desc:     "AwaitingMetadata",
+			request:  &types.QueryForwardStatusRequest{Nonce: 2},
+			response: &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_AWAITING_METADATA},
+		},
+		{
+			desc:     "InFlight",
+			request:  &types.QueryForwardStatusRequest{Nonce: 3},
+			response: &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_IN_FLIGHT, InFlightPacket: &inFlightPacket},
+		},
+		{
+			desc:     "AckError",
+			request:  &types.QueryForwardStatusRequest{Nonce: 4},
+			response: &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_ACK_ERROR},
+		},
+		{
+			desc:     "RetryPending",
+			request:  &types.QueryForwardStatusRequest{Nonce: 5},
+			response: &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_RETRY_PENDING},
+		},
+		{
+			desc:     "Failed",
+			request:  &types.QueryForwardStatusRequest{Nonce: 6},
+			response: &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_FAILED},
+		},
+		{
+			desc:     "Completed",
+			request:  &types.QueryForwardStatusRequest{Nonce: 7},
+			response: &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_COMPLETED, Receipt: &receipt},
+		},
+		{
+			desc:     "Held",
+			request:  &types.QueryForwardStatusRequest{Nonce: 8},
+			response: &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_HELD},
+		},
+		{
+			desc:     "RateLimited",
+			request:  &types.QueryForwardStatusRequest{Nonce: 9},
+			response: &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_RATE_LIMITED},
+		},
+		{
+			desc:     "HeldRetry",
+			request:  &types.QueryForwardStatusRequest{Nonce: 10},
+			response: &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_HELD},
+		},
+		{
+			desc:     "NoInFlightPacket",
+			request:  &types.QueryForwardStatusRequest{Nonce: 11},
+			response: &types.QueryForwardStatusResponse{Status: types.FORWARD_STATUS_RETRY_PENDING},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryForwardStatusRequest{Nonce: 100},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := queryServer.ForwardStatus(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -23,6 +23,20 @@ func (q QueryServer) InFlightPacket(c context.Context, req *types.QueryGetInFlig
 	return &types.QueryGetInFlightPacketResponse{InFlightPacket: val}, nil
 }
 
+func (q QueryServer) InFlightPacketByNonce(c context.Context, req *types.QueryInFlightPacketByNonceRequest) (*types.QueryInFlightPacketByNonceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := q.keeper.GetInFlightPacketByNonce(ctx, req.SourceDomain, req.Nonce)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryInFlightPacketByNonceResponse{InFlightPacket: val}, nil
+}
+
 func (q QueryServer) InFlightPackets(c context.Context, req *types.QueryAllInFlightPacketsRequest) (*types.QueryAllInFlightPacketsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
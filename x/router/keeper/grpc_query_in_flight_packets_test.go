@@ -77,6 +77,61 @@ func TestInFlightPacketQuerySingle(t *testing.T) {
 	}
 }
 
+func TestInFlightPacketByNonceQuery(t *testing.T) {
+	keeper, ctx := keepertest.RouterKeeper(t)
+	queryServer := routerkeeper.NewQueryServer(keeper)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNInFlightPacket(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryInFlightPacketByNonceRequest
+		response *types.QueryInFlightPacketByNonceResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryInFlightPacketByNonceRequest{
+				SourceDomain: msgs[0].SourceDomain,
+				Nonce:        msgs[0].Nonce,
+			},
+			response: &types.QueryInFlightPacketByNonceResponse{InFlightPacket: msgs[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryInFlightPacketByNonceRequest{
+				SourceDomain: msgs[1].SourceDomain,
+				Nonce:        msgs[1].Nonce,
+			},
+			response: &types.QueryInFlightPacketByNonceResponse{InFlightPacket: msgs[1]},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryInFlightPacketByNonceRequest{
+				SourceDomain: 4,
+				Nonce:        1,
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := queryServer.InFlightPacketByNonce(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
 func TestInFlightPacketQueryPaginated(t *testing.T) {
 	keeper, ctx := keepertest.RouterKeeper(t)
 	queryServer := routerkeeper.NewQueryServer(keeper)
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q QueryServer) Pauser(c context.Context, req *types.QueryPauserRequest) (*types.QueryPauserResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPauserResponse{Pauser: q.keeper.GetPauser(ctx)}, nil
+}
+
+func (q QueryServer) RoutingPauseState(c context.Context, req *types.QueryRoutingPauseStateRequest) (*types.QueryRoutingPauseStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryRoutingPauseStateResponse{
+		Global:              q.keeper.IsRoutingPausedGlobally(ctx),
+		PausedSourceDomains: q.keeper.GetPausedSourceDomains(ctx),
+	}, nil
+}
+
+func (q QueryServer) HeldForwards(c context.Context, req *types.QueryAllHeldForwardsRequest) (*types.QueryAllHeldForwardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	heldForwards, pageRes, err := q.keeper.GetAllHeldForwardsPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllHeldForwardsResponse{HeldForwards: heldForwards, Pagination: pageRes}, nil
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q QueryServer) SourceDomainRateLimit(c context.Context, req *types.QuerySourceDomainRateLimitRequest) (*types.QuerySourceDomainRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimit, found := q.keeper.GetSourceDomainRateLimit(ctx, req.SourceDomain)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QuerySourceDomainRateLimitResponse{
+		RateLimit: rateLimit,
+		Usage:     q.keeper.GetSourceDomainRateLimitUsage(ctx, req.SourceDomain),
+	}, nil
+}
+
+func (q QueryServer) ChannelRateLimit(c context.Context, req *types.QueryChannelRateLimitRequest) (*types.QueryChannelRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimit, found := q.keeper.GetChannelRateLimit(ctx, req.Channel)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryChannelRateLimitResponse{
+		RateLimit: rateLimit,
+		Usage:     q.keeper.GetChannelRateLimitUsage(ctx, req.Channel),
+	}, nil
+}
+
+func (q QueryServer) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryRateLimitsResponse{
+		SourceDomainRateLimits: q.keeper.GetAllSourceDomainRateLimits(ctx),
+		ChannelRateLimits:      q.keeper.GetAllChannelRateLimits(ctx),
+	}, nil
+}
+
+func (q QueryServer) RateLimitedForwards(c context.Context, req *types.QueryAllRateLimitedForwardsRequest) (*types.QueryAllRateLimitedForwardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimitedForwards, pageRes, err := q.keeper.GetAllRateLimitedForwardsPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRateLimitedForwardsResponse{RateLimitedForwards: rateLimitedForwards, Pagination: pageRes}, nil
+}
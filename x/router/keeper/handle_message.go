@@ -1,7 +1,8 @@
 package keeper
 
 import (
-	"time"
+	"bytes"
+	"fmt"
 
 	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,12 +12,6 @@ import (
 	"github.com/strangelove-ventures/noble-router/x/router/types"
 )
 
-// MinimumRelativePacketTimeoutTimestamp is the default minimum packet timeout timestamp (in nanoseconds)
-// relative to the current block timestamp of the counterparty chain provided by the client state. Incoming packets
-// with IBCForwardMetadata cannot have a timeout smaller than this value in order to prevent DoS styled attacks
-// on relayer resources.
-var MinimumRelativePacketTimeoutTimestamp = uint64((time.Duration(30) * time.Second).Nanoseconds())
-
 func (k *Keeper) HandleMessage(ctx sdk.Context, msg []byte) error {
 
 	// parse outer message
@@ -48,21 +43,51 @@ func (k *Keeper) HandleMessage(ctx sdk.Context, msg []byte) error {
 			DestinationDomain:  outerMessage.DestinationDomain,
 			MintRecipient:      addr,
 			Height:             uint64(ctx.BlockHeight()),
+			Recipient:          outerMessage.Recipient,
+			DestinationCaller:  destinationCaller(outerMessage),
 		}
 		k.SetMint(ctx, mint)
+		if err := ctx.EventManager().EmitTypedEvent(&types.MintStored{
+			SourceDomain:  mint.SourceDomain,
+			Nonce:         mint.Nonce,
+			Amount:        coin,
+			MintRecipient: mint.MintRecipient,
+		}); err != nil {
+			return err
+		}
+		k.AfterMintRecorded(ctx, mint)
+
 		if existingIBCForward, found := k.GetIBCForward(ctx, outerMessage.SourceDomain, outerMessage.Nonce); found {
-			return k.ForwardPacket(ctx, existingIBCForward.Metadata, mint)
+			return k.matchForward(ctx, existingIBCForward.Metadata, mint)
 		}
 
 		return nil
 	}
 
-	// parse internal message into IBCForward
+	// parse internal message into IBCForward, the envelope version selects the payload layout
 	if ibcForward, err := new(types.IBCForwardMetadata).Parse(outerMessage.MessageBody); err == nil {
+		if err := ibcForward.Validate(); err != nil {
+			return sdkerrors.Wrapf(types.ErrHandleMessage, "invalid ibc forward metadata: %s", err)
+		}
+
+		if err := k.ValidateRelativePacketTimeout(ctx, ibcForward.TimeoutInNanoseconds); err != nil {
+			return err
+		}
+
 		if storedForward, ok := k.GetIBCForward(ctx, outerMessage.SourceDomain, ibcForward.Nonce); ok {
-			if storedForward.AckError {
+			if storedForward.AckError || storedForward.Failed {
 				if existingMint, ok := k.GetMint(ctx, outerMessage.SourceDomain, ibcForward.Nonce); ok {
-					return k.ForwardPacket(ctx, ibcForward, existingMint)
+					// replace the previous forward so that its error state and retries are reset,
+					// a fee or relayer tip that was already paid is not paid again
+					k.SetIBCForward(ctx, types.StoreIBCForwardMetadata{
+						SourceDomain:      outerMessage.SourceDomain,
+						Metadata:          ibcForward,
+						Fee:               storedForward.Fee,
+						RelayerTip:        storedForward.RelayerTip,
+						Recipient:         outerMessage.Recipient,
+						DestinationCaller: destinationCaller(outerMessage),
+					})
+					return k.matchForward(ctx, ibcForward, existingMint)
 				}
 				panic("unexpected state")
 			}
@@ -77,11 +102,13 @@ func (k *Keeper) HandleMessage(ctx sdk.Context, msg []byte) error {
 
 		// this is the first time we are seeing this forward info -> store it.
 		k.SetIBCForward(ctx, types.StoreIBCForwardMetadata{
-			SourceDomain: outerMessage.SourceDomain,
-			Metadata:     ibcForward,
+			SourceDomain:      outerMessage.SourceDomain,
+			Metadata:          ibcForward,
+			Recipient:         outerMessage.Recipient,
+			DestinationCaller: destinationCaller(outerMessage),
 		})
 		if existingMint, ok := k.GetMint(ctx, outerMessage.SourceDomain, ibcForward.Nonce); ok {
-			return k.ForwardPacket(ctx, ibcForward, existingMint)
+			return k.matchForward(ctx, ibcForward, existingMint)
 		}
 
 		return nil
@@ -90,16 +117,118 @@ func (k *Keeper) HandleMessage(ctx sdk.Context, msg []byte) error {
 	return nil
 }
 
+// matchForward pays the relayer tip of a mint that has been matched with its IBC forward, then sends
+// its IBC transfer packet, or holds it back while routing is paused for the source domain.
+//
+// Forwards with a required submitter can only be matched by a transaction of that submitter, which is
+// known from the RelayerDecorator of the ante handler. A transaction of any other submitter marks the
+// forward as unpaired, the CCTP message is still received so that its mint is not reverted. The required
+// submitter can pair the forward later with MsgPairForward, or the fallback recipient can claim its mint.
+func (k *Keeper) matchForward(ctx sdk.Context, ibcForward *types.IBCForwardMetadata, mint types.Mint) error {
+	// only the required submitter can complete the pairing of the mint and the forward
+	if ibcForward.RequiredSubmitter != "" {
+		if relayer, ok := types.RelayerFromContext(ctx); !ok || relayer.String() != ibcForward.RequiredSubmitter {
+			return k.unpairForwardOfMint(ctx, mint, ibcForward.RequiredSubmitter)
+		}
+	}
+
+	// IBC forwards can only be sent over channels vetted by the owner. The forward is failed instead of
+	// returning an error, which would revert the CCTP message and with it the mint.
+	if !k.IsAllowedChannel(ctx, ibcForward.Channel) {
+		return k.failForwardOfMint(ctx, mint, fmt.Sprintf("channel %s is not allowed", ibcForward.Channel))
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.ForwardMatched{
+		SourceDomain: mint.SourceDomain,
+		Nonce:        mint.Nonce,
+		Port:         ibcForward.Port,
+		Channel:      ibcForward.Channel,
+		Amount:       *mint.Amount,
+		Receiver:     ibcForward.DestinationReceiver,
+	}); err != nil {
+		return err
+	}
+
+	if ok, err := k.payRelayerTip(ctx, ibcForward, mint); !ok || err != nil {
+		return err
+	}
+
+	if k.IsRoutingPaused(ctx, mint.SourceDomain) {
+		return k.HoldForward(ctx, mint.SourceDomain, mint.Nonce)
+	}
+
+	return k.ForwardPacket(ctx, ibcForward, mint)
+}
+
+// destinationCaller returns the destination caller of an outer CCTP message, or nil if any address can
+// receive the message.
+func destinationCaller(message *cctptypes.Message) []byte {
+	if bytes.Equal(message.DestinationCaller, make([]byte, len(message.DestinationCaller))) {
+		return nil
+	}
+	return message.DestinationCaller
+}
+
+// ValidateRelativePacketTimeout checks that a sender specified packet timeout (in nanoseconds) is within
+// MinimumRelativePacketTimeoutTimestamp and the MaxRelativePacketTimeoutTimestamp param. A timeout of zero
+// selects the default relative packet timeout of the transfer module.
+func (k *Keeper) ValidateRelativePacketTimeout(ctx sdk.Context, timeout uint64) error {
+	if timeout == 0 {
+		return nil
+	}
+
+	if timeout < types.MinimumRelativePacketTimeoutTimestamp {
+		return sdkerrors.Wrapf(types.ErrHandleMessage, "packet timeout %d is below the minimum of %d", timeout, types.MinimumRelativePacketTimeoutTimestamp)
+	}
+
+	if max := k.GetParams(ctx).MaxRelativePacketTimeoutTimestamp; timeout > max {
+		return sdkerrors.Wrapf(types.ErrHandleMessage, "packet timeout %d is above the maximum of %d", timeout, max)
+	}
+
+	return nil
+}
+
 func (k *Keeper) ForwardPacket(ctx sdk.Context, ibcForward *types.IBCForwardMetadata, mint types.Mint) error {
+	// the channel may have been removed from the allowlist after the forward was matched
+	if !k.IsAllowedChannel(ctx, ibcForward.Channel) {
+		return k.failForwardOfMint(ctx, mint, fmt.Sprintf("channel %s is not allowed", ibcForward.Channel))
+	}
+
+	// the memo of a forward with hops is checked to be well formed before sending the packet
+	memo, err := ibcForward.TransferMemo()
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrHandleMessage, "invalid forward memo: %s", err)
+	}
+
+	if !k.HasRateLimitCapacity(ctx, mint.SourceDomain, ibcForward.Channel, mint.Amount.Amount) {
+		return k.RateLimitForward(ctx, ibcForward, mint)
+	}
+
+	// the relayer tip was paid out of the minted funds when the forward was matched with its mint
+	amount := *mint.Amount
+	if forward, found := k.GetIBCForward(ctx, mint.SourceDomain, mint.Nonce); found && forward.RelayerTip != nil {
+		amount = amount.Sub(*forward.RelayerTip)
+	}
+
+	fee, err := k.chargeFee(ctx, ibcForward, mint, amount)
+	if err != nil {
+		return err
+	}
+	if fee == nil {
+		// the forward failed as the remaining amount does not cover the fee
+		return nil
+	}
+	amount = amount.Sub(*fee)
+
 	timeout := ibcForward.TimeoutInNanoseconds
-	if timeout < MinimumRelativePacketTimeoutTimestamp {
+	if timeout == 0 {
 		timeout = transfertypes.DefaultRelativePacketTimeoutTimestamp
 	}
 
 	transfer := &transfertypes.MsgTransfer{
 		SourcePort:    ibcForward.Port,
 		SourceChannel: ibcForward.Channel,
-		Token:         *mint.Amount,
+		Token:         amount,
 		Sender:        mint.MintRecipient,
 		Receiver:      ibcForward.DestinationReceiver,
 		TimeoutHeight: clienttypes.Height{
@@ -107,7 +236,7 @@ func (k *Keeper) ForwardPacket(ctx sdk.Context, ibcForward *types.IBCForwardMeta
 			RevisionHeight: 0,
 		},
 		TimeoutTimestamp: uint64(ctx.BlockTime().UnixNano()) + timeout,
-		Memo:             ibcForward.Memo,
+		Memo:             memo,
 	}
 
 	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), transfer)
@@ -125,6 +254,182 @@ func (k *Keeper) ForwardPacket(ctx sdk.Context, ibcForward *types.IBCForwardMeta
 	}
 
 	k.SetInFlightPacket(ctx, inFlightPacket)
+	k.consumeRateLimits(ctx, mint.SourceDomain, ibcForward.Channel, mint.Amount.Amount)
+	k.AfterForwardSent(ctx, inFlightPacket, amount)
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.ForwardPacketSent{
+		SourceDomain: mint.SourceDomain,
+		Nonce:        mint.Nonce,
+		Port:         ibcForward.Port,
+		Channel:      ibcForward.Channel,
+		Sequence:     res.Sequence,
+		Amount:       amount,
+		Receiver:     ibcForward.DestinationReceiver,
+		Fee:          *fee,
+	})
+}
+
+// chargeFee sends the protocol fee on an amount of an IBC forward from the mint recipient to the fee
+// collector, and records it on the stored forward so that it is only charged once across retries.
+// A nil fee is returned when the amount does not cover the fee, in which case the forward is marked
+// as failed and the amount stays claimable.
+func (k *Keeper) chargeFee(ctx sdk.Context, ibcForward *types.IBCForwardMetadata, mint types.Mint, amount sdk.Coin) (*sdk.Coin, error) {
+	params := k.GetParams(ctx)
+	noFee := sdk.NewCoin(amount.Denom, sdk.ZeroInt())
+
+	feeConfig, ok := params.FeeFor(mint.SourceDomain, ibcForward.Channel)
+	if !ok {
+		return &noFee, nil
+	}
+
+	forward, found := k.GetIBCForward(ctx, mint.SourceDomain, mint.Nonce)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrHandleMessage, "no ibc forward found to charge fee for source domain %d and nonce %d", mint.SourceDomain, mint.Nonce)
+	}
+	if forward.Fee != nil {
+		return forward.Fee, nil
+	}
+
+	fee := feeConfig.Compute(amount)
+	if fee.IsZero() {
+		return &noFee, nil
+	}
+
+	if fee.Amount.GTE(amount.Amount) {
+		return nil, k.failForward(ctx, forward, fmt.Sprintf("amount %s does not cover the fee of %s", amount, fee))
+	}
+
+	mintRecipient, err := sdk.AccAddressFromBech32(mint.MintRecipient)
+	if err != nil {
+		return nil, err
+	}
+	feeCollector, err := sdk.AccAddressFromBech32(params.FeeCollector)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, mintRecipient, feeCollector, sdk.NewCoins(fee)); err != nil {
+		return nil, err
+	}
+
+	forward.Fee = &fee
+	k.SetIBCForward(ctx, forward)
+
+	return &fee, nil
+}
+
+// failForwardOfMint marks the IBC forward matched with a mint as failed, the mint stays claimable.
+func (k *Keeper) failForwardOfMint(ctx sdk.Context, mint types.Mint, reason string) error {
+	forward, found := k.GetIBCForward(ctx, mint.SourceDomain, mint.Nonce)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrHandleMessage, "no ibc forward found to fail for source domain %d and nonce %d", mint.SourceDomain, mint.Nonce)
+	}
+	return k.failForward(ctx, forward, reason)
+}
+
+// unpairForwardOfMint marks the IBC forward matched with a mint as unpaired, until its required submitter
+// pairs it.
+func (k *Keeper) unpairForwardOfMint(ctx sdk.Context, mint types.Mint, requiredSubmitter string) error {
+	forward, found := k.GetIBCForward(ctx, mint.SourceDomain, mint.Nonce)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrHandleMessage, "no ibc forward found to unpair for source domain %d and nonce %d", mint.SourceDomain, mint.Nonce)
+	}
+
+	forward.Unpaired = true
+	k.SetIBCForward(ctx, forward)
+
+	return ctx.EventManager().EmitTypedEvent(&types.ForwardUnpaired{
+		SourceDomain:      mint.SourceDomain,
+		Nonce:             mint.Nonce,
+		RequiredSubmitter: requiredSubmitter,
+	})
+}
+
+// PairForward pairs an unpaired IBC forward with its mint on behalf of its required submitter, who is
+// paid the relayer tip of the forward.
+func (k *Keeper) PairForward(ctx sdk.Context, sourceDomain uint32, nonce uint64, submitter sdk.AccAddress) error {
+	forward, found := k.GetIBCForward(ctx, sourceDomain, nonce)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrPairForward, "ibc forward not found")
+	}
+
+	if !forward.Unpaired {
+		return sdkerrors.Wrapf(types.ErrPairForward, "ibc forward is not awaiting pairing")
+	}
+
+	if forward.Metadata.RequiredSubmitter != submitter.String() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "this message sender is not the required submitter of the ibc forward")
+	}
+
+	mint, found := k.GetMint(ctx, sourceDomain, nonce)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrPairForward, "mint not found")
+	}
+
+	forward.Unpaired = false
+	k.SetIBCForward(ctx, forward)
+
+	return k.matchForward(types.ContextWithRelayer(ctx, submitter), forward.Metadata, mint)
+}
+
+// sendQueuedForward sends the IBC transfer packet of a forward that was queued after being matched.
+// A forward that cannot be sent is marked as failed, its mint stays claimable.
+func (k *Keeper) sendQueuedForward(ctx sdk.Context, forward types.StoreIBCForwardMetadata, mint types.Mint) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.ForwardPacket(cacheCtx, forward.Metadata, mint); err != nil {
+		k.Logger(ctx).Error("error sending queued ibc forward", "source-domain", mint.SourceDomain, "nonce", mint.Nonce, "error", err)
+
+		if err := k.failForward(ctx, forward, err.Error()); err != nil {
+			k.Logger(ctx).Error("error emitting forward failed event", "error", err)
+		}
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// payRelayerTip pays the relayer tip of an IBC forward to the relayer that submitted the transaction
+// completing the pairing of the mint and the forward. The tip is only paid once, and it is not paid
+// when the relayer is unknown, which is always the case when the RelayerDecorator is missing from
+// the ante handler of the app. False is returned when the minted amount does not cover the tip, in
+// which case the forward is marked as failed and the whole mint stays claimable.
+func (k *Keeper) payRelayerTip(ctx sdk.Context, ibcForward *types.IBCForwardMetadata, mint types.Mint) (bool, error) {
+	if ibcForward.RelayerTip == 0 {
+		return true, nil
+	}
+
+	relayer, ok := types.RelayerFromContext(ctx)
+	if !ok {
+		return true, nil
+	}
+
+	forward, found := k.GetIBCForward(ctx, mint.SourceDomain, mint.Nonce)
+	if !found {
+		return false, sdkerrors.Wrapf(types.ErrHandleMessage, "no ibc forward found to pay relayer tip for source domain %d and nonce %d", mint.SourceDomain, mint.Nonce)
+	}
+	if forward.RelayerTip != nil {
+		return true, nil
+	}
+
+	tip := sdk.NewCoin(mint.Amount.Denom, sdk.NewIntFromUint64(ibcForward.RelayerTip))
+	if tip.Amount.GTE(mint.Amount.Amount) {
+		return false, k.failForward(ctx, forward, fmt.Sprintf("amount %s does not cover the relayer tip of %s", mint.Amount, tip))
+	}
+
+	mintRecipient, err := sdk.AccAddressFromBech32(mint.MintRecipient)
+	if err != nil {
+		return false, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, mintRecipient, relayer, sdk.NewCoins(tip)); err != nil {
+		return false, err
+	}
+
+	forward.RelayerTip = &tip
+	k.SetIBCForward(ctx, forward)
+
+	return true, ctx.EventManager().EmitTypedEvent(&types.RelayerTipPaid{
+		SourceDomain: mint.SourceDomain,
+		Nonce:        mint.Nonce,
+		Relayer:      relayer.String(),
+		Amount:       tip,
+	})
 }
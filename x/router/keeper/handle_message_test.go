@@ -47,6 +47,7 @@ func TestInvalidMessageBodyNoOp(t *testing.T) {
 // valid forward, found forward, ack error, existing mint -> forward packet
 func TestForwardOnAckErrWithExistingMint(t *testing.T) {
 	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
 
 	sourceDomain, sourceDomainSender, nonce := uint32(8), string(fillByteArray(0, 32)), uint64(4)
 	port, channel, sequence := "transfer", "channel-10", uint64(0)
@@ -99,6 +100,7 @@ func TestForwardOnAckErrWithExistingMint(t *testing.T) {
 // valid forward, found forward, ack error, no mint -> panic
 func TestForwardOnAckErrWithNoMint(t *testing.T) {
 	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
 
 	sourceDomain, sourceDomainSender, nonce := uint32(8), string(fillByteArray(0, 32)), uint64(4)
 	port, channel, sequence := "transfer", "channel-10", uint64(0)
@@ -139,6 +141,7 @@ func TestForwardOnAckErrWithNoMint(t *testing.T) {
 // valid forward, found forward, no ack error -> ErrHandleMessage
 func TestForwardWithFoundForwardAndNoAckError(t *testing.T) {
 	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
 
 	sourceDomain, sourceDomainSender, nonce := uint32(8), string(fillByteArray(0, 32)), uint64(4)
 	port, channel, sequence := "transfer", "channel-10", uint64(0)
@@ -177,6 +180,7 @@ func TestForwardWithFoundForwardAndNoAckError(t *testing.T) {
 // valid forward, no forward -> set forward, if existing mint -> forward packet
 func TestForwardWithNoForwardFoundAndExistingMint(t *testing.T) {
 	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
 
 	sourceDomain, sourceDomainSender, nonce := uint32(1), fillByteArray(0, 32), uint64(4)
 	port, channel, sequence := "transfer", "channel-10", uint64(0)
@@ -222,6 +226,7 @@ func TestForwardWithNoForwardFoundAndExistingMint(t *testing.T) {
 // valid forward, no forward -> set forward, if no mint -> return nil (mint hasn't come in yet)
 func TestForwardWithNoForwardFoundAndNoMint(t *testing.T) {
 	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
 
 	sourceDomain, sourceDomainSender, nonce := uint32(1), fillByteArray(0, 32), uint64(4)
 	_, channel, _ := "transfer", "channel-10", uint64(0)
@@ -289,6 +294,7 @@ func TestMintWithNoForward(t *testing.T) {
 // valid mint, set mint, existing forward -> forward packet
 func TestMintWithExistingForward(t *testing.T) {
 	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
 
 	sourceDomain, sourceDomainSender, nonce := uint32(1), string(fillByteArray(0, 32)), uint64(4)
 	port, channel, sequence := "transfer", "channel-10", uint64(0)
@@ -339,3 +345,230 @@ func TestMintWithExistingForward(t *testing.T) {
 	require.Equal(t, nonce, mint.Nonce)
 
 }
+
+// valid v1 forward, no forward -> set forward with the sender specified port and timeout
+func TestForwardWithCustomPortAndTimeout(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+
+	sourceDomain, sourceDomainSender, nonce := uint32(1), fillByteArray(0, 32), uint64(4)
+	port, channel, timeout := "custom", "channel-10", 2*types.MinimumRelativePacketTimeoutTimestamp
+
+	routerKeeper.AddAllowedSourceDomainSender(ctx, sourceDomain, sourceDomainSender)
+
+	metadata := types.IBCForwardMetadata{
+		Nonce:                nonce,
+		Port:                 port,
+		Channel:              channel,
+		DestinationReceiver:  sample.AccAddress(),
+		TimeoutInNanoseconds: timeout,
+	}
+	body, err := metadata.Bytes(types.IBCForwardMetadataV1, sdk.Bech32PrefixAccAddr)
+	require.NoError(t, err)
+
+	msg := bytesFromMessage(keeper.Message{
+		Version:           1,
+		SourceDomain:      sourceDomain,
+		DestinationDomain: 3,
+		Nonce:             nonce,
+		Sender:            sourceDomainSender,
+		Recipient:         fillByteArray(32, 32),
+		DestinationCaller: fillByteArray(64, 32),
+		MessageBody:       body,
+	})
+
+	err = routerKeeper.HandleMessage(ctx, msg)
+	require.Nil(t, err)
+
+	forward, found := routerKeeper.GetIBCForward(ctx, sourceDomain, nonce)
+	require.True(t, found)
+	require.Equal(t, port, forward.Metadata.Port)
+	require.Equal(t, timeout, forward.Metadata.TimeoutInNanoseconds)
+}
+
+// valid v1 forward, timeout out of bounds -> error
+func TestForwardWithInvalidTimeout(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+
+	sourceDomain, sourceDomainSender, nonce := uint32(1), fillByteArray(0, 32), uint64(4)
+	maxTimeout := routerKeeper.GetParams(ctx).MaxRelativePacketTimeoutTimestamp
+
+	routerKeeper.AddAllowedSourceDomainSender(ctx, sourceDomain, sourceDomainSender)
+
+	for _, tc := range []struct {
+		desc    string
+		timeout uint64
+		err     string
+	}{
+		{
+			desc:    "below minimum",
+			timeout: types.MinimumRelativePacketTimeoutTimestamp - 1,
+			err:     "below the minimum",
+		},
+		{
+			desc:    "above maximum",
+			timeout: maxTimeout + 1,
+			err:     "above the maximum",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			metadata := types.IBCForwardMetadata{
+				Nonce:                nonce,
+				Channel:              "channel-10",
+				DestinationReceiver:  sample.AccAddress(),
+				TimeoutInNanoseconds: tc.timeout,
+			}
+			body, err := metadata.Bytes(types.IBCForwardMetadataV1, sdk.Bech32PrefixAccAddr)
+			require.NoError(t, err)
+
+			msg := bytesFromMessage(keeper.Message{
+				Version:           1,
+				SourceDomain:      sourceDomain,
+				DestinationDomain: 3,
+				Nonce:             nonce,
+				Sender:            sourceDomainSender,
+				Recipient:         fillByteArray(32, 32),
+				DestinationCaller: fillByteArray(64, 32),
+				MessageBody:       body,
+			})
+
+			err = routerKeeper.HandleMessage(ctx, msg)
+			require.ErrorIs(t, err, types.ErrHandleMessage)
+			require.Contains(t, err.Error(), tc.err)
+
+			_, found := routerKeeper.GetIBCForward(ctx, sourceDomain, nonce)
+			require.False(t, found)
+		})
+	}
+}
+
+// valid forward, then valid mint -> mint stored, forward matched and packet sent events
+func TestForwardLifecycleEvents(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+
+	sourceDomain, sourceDomainSender, nonce := uint32(1), fillByteArray(0, 32), uint64(4)
+	channel := "channel-10"
+
+	routerKeeper.AddAllowedSourceDomainSender(ctx, sourceDomain, sourceDomainSender)
+
+	err := routerKeeper.HandleMessage(ctx, bytesFromMessage(keeper.Message{
+		Version:           1,
+		SourceDomain:      sourceDomain,
+		DestinationDomain: 3,
+		Nonce:             nonce,
+		Sender:            sourceDomainSender,
+		Recipient:         fillByteArray(32, 32),
+		DestinationCaller: fillByteArray(64, 32),
+		MessageBody:       createMockMetadata(nonce, channel, sdk.Bech32PrefixAccAddr, sample.AccAddress(), "12345"),
+	}))
+	require.Nil(t, err)
+	require.Empty(t, ctx.EventManager().Events())
+
+	err = routerKeeper.HandleMessage(ctx, bytesFromMessage(keeper.Message{
+		Version:           1,
+		SourceDomain:      sourceDomain,
+		DestinationDomain: 4,
+		Nonce:             nonce,
+		Sender:            sourceDomainSender,
+		Recipient:         fillByteArray(32, 32),
+		DestinationCaller: fillByteArray(64, 32),
+		MessageBody: bytesFromBurnMessage(keeper.BurnMessage{
+			BurnToken:     fillByteArray(0, 32),
+			MintRecipient: fillByteArray(0, 32),
+			Amount:        *big.NewInt(10000),
+			MessageSender: fillByteArray(0, 32),
+		}),
+	}))
+	require.Nil(t, err)
+
+	var eventTypes []string
+	for _, event := range ctx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	require.Equal(t, []string{
+		"noble.router.MintStored",
+		"noble.router.ForwardMatched",
+		"noble.router.ForwardPacketSent",
+	}, eventTypes)
+}
+
+// valid forward, channel not allowed, then mint -> no error, forward failed and mint kept claimable
+func TestForwardWithChannelNotAllowed(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+
+	sourceDomain, sourceDomainSender, nonce := uint32(1), fillByteArray(0, 32), uint64(4)
+
+	routerKeeper.AddAllowedSourceDomainSender(ctx, sourceDomain, sourceDomainSender)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+
+	err := routerKeeper.HandleMessage(ctx, bytesFromMessage(keeper.Message{
+		Version:           1,
+		SourceDomain:      sourceDomain,
+		DestinationDomain: 3,
+		Nonce:             nonce,
+		Sender:            sourceDomainSender,
+		Recipient:         fillByteArray(32, 32),
+		DestinationCaller: fillByteArray(64, 32),
+		MessageBody:       createMockMetadata(nonce, "channel-11", sdk.Bech32PrefixAccAddr, sample.AccAddress(), "12345"),
+	}))
+	require.Nil(t, err)
+
+	// the mint must not be reverted because its forward cannot be sent
+	err = routerKeeper.HandleMessage(ctx, bytesFromMessage(keeper.Message{
+		Version:           1,
+		SourceDomain:      sourceDomain,
+		DestinationDomain: 4,
+		Nonce:             nonce,
+		Sender:            sourceDomainSender,
+		Recipient:         fillByteArray(32, 32),
+		DestinationCaller: fillByteArray(64, 32),
+		MessageBody: bytesFromBurnMessage(keeper.BurnMessage{
+			BurnToken:     fillByteArray(0, 32),
+			MintRecipient: fillByteArray(0, 32),
+			Amount:        *big.NewInt(10000),
+			MessageSender: fillByteArray(0, 32),
+		}),
+	}))
+	require.Nil(t, err)
+
+	forward, found := routerKeeper.GetIBCForward(ctx, sourceDomain, nonce)
+	require.True(t, found)
+	require.True(t, forward.Failed)
+	_, found = routerKeeper.GetMint(ctx, sourceDomain, nonce)
+	require.True(t, found)
+	_, found = routerKeeper.GetInFlightPacketByNonce(ctx, sourceDomain, nonce)
+	require.False(t, found)
+}
+
+// stored forward, channel removed from the allowlist before the packet is sent -> forward failed
+func TestForwardPacketWithRemovedChannel(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+
+	metadata := &types.IBCForwardMetadata{
+		Nonce:               4,
+		Port:                "transfer",
+		Channel:             "channel-10",
+		DestinationReceiver: "12345",
+	}
+	mint := types.Mint{
+		SourceDomain:  1,
+		Nonce:         4,
+		Amount:        &sdk.Coin{Denom: "uusdc", Amount: sdk.NewInt(10000)},
+		MintRecipient: "12345",
+	}
+	routerKeeper.SetMint(ctx, mint)
+	routerKeeper.SetIBCForward(ctx, types.StoreIBCForwardMetadata{SourceDomain: 1, Metadata: metadata})
+
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+	routerKeeper.DeleteAllowedChannel(ctx, "channel-10")
+
+	err := routerKeeper.ForwardPacket(ctx, metadata, mint)
+	require.Nil(t, err)
+
+	forward, found := routerKeeper.GetIBCForward(ctx, 1, 4)
+	require.True(t, found)
+	require.True(t, forward.Failed)
+	_, found = routerKeeper.GetInFlightPacketByNonce(ctx, 1, 4)
+	require.False(t, found)
+}
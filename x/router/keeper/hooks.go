@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+// SetHooks sets the router hooks, they can only be set once.
+func (k *Keeper) SetHooks(hooks types.RouterHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set router hooks twice")
+	}
+
+	k.hooks = hooks
+	return k
+}
+
+// AfterMintRecorded calls the AfterMintRecorded hook if hooks are set.
+func (k *Keeper) AfterMintRecorded(ctx sdk.Context, mint types.Mint) {
+	k.callHook(ctx, "AfterMintRecorded", func(ctx sdk.Context) error {
+		return k.hooks.AfterMintRecorded(ctx, mint)
+	})
+}
+
+// AfterForwardSent calls the AfterForwardSent hook if hooks are set.
+func (k *Keeper) AfterForwardSent(ctx sdk.Context, inFlightPacket types.InFlightPacket, amount sdk.Coin) {
+	k.callHook(ctx, "AfterForwardSent", func(ctx sdk.Context) error {
+		return k.hooks.AfterForwardSent(ctx, inFlightPacket, amount)
+	})
+}
+
+// AfterForwardAcked calls the AfterForwardAcked hook if hooks are set.
+func (k *Keeper) AfterForwardAcked(ctx sdk.Context, inFlightPacket types.InFlightPacket) {
+	k.callHook(ctx, "AfterForwardAcked", func(ctx sdk.Context) error {
+		return k.hooks.AfterForwardAcked(ctx, inFlightPacket)
+	})
+}
+
+// AfterForwardFailed calls the AfterForwardFailed hook if hooks are set.
+func (k *Keeper) AfterForwardFailed(ctx sdk.Context, sourceDomain uint32, nonce uint64, reason string) {
+	k.callHook(ctx, "AfterForwardFailed", func(ctx sdk.Context) error {
+		return k.hooks.AfterForwardFailed(ctx, sourceDomain, nonce, reason)
+	})
+}
+
+// callHook calls a hook in a cached context. A failing hook is logged and its state changes are
+// discarded, it never aborts the mint or forward that triggered it.
+func (k *Keeper) callHook(ctx sdk.Context, name string, hook func(ctx sdk.Context) error) {
+	if k.hooks == nil {
+		return
+	}
+
+	if err := types.CallHook(ctx, hook); err != nil {
+		k.Logger(ctx).Error("router hook failed", "hook", name, "error", err)
+	}
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+// mockRouterHooks records the hooks called by the router.
+type mockRouterHooks struct {
+	calls []string
+	err   error
+	// write is called on AfterMintRecorded, before the error is returned
+	write func(ctx sdk.Context)
+}
+
+var _ types.RouterHooks = &mockRouterHooks{}
+
+func (h *mockRouterHooks) AfterMintRecorded(ctx sdk.Context, mint types.Mint) error {
+	h.calls = append(h.calls, fmt.Sprintf("AfterMintRecorded %d %d", mint.SourceDomain, mint.Nonce))
+	if h.write != nil {
+		h.write(ctx)
+	}
+	return h.err
+}
+
+func (h *mockRouterHooks) AfterForwardSent(_ sdk.Context, inFlightPacket types.InFlightPacket, amount sdk.Coin) error {
+	h.calls = append(h.calls, fmt.Sprintf("AfterForwardSent %d %d %s", inFlightPacket.SourceDomain, inFlightPacket.Nonce, amount))
+	return h.err
+}
+
+func (h *mockRouterHooks) AfterForwardAcked(_ sdk.Context, inFlightPacket types.InFlightPacket) error {
+	h.calls = append(h.calls, fmt.Sprintf("AfterForwardAcked %d %d", inFlightPacket.SourceDomain, inFlightPacket.Nonce))
+	return h.err
+}
+
+func (h *mockRouterHooks) AfterForwardFailed(_ sdk.Context, sourceDomain uint32, nonce uint64, reason string) error {
+	h.calls = append(h.calls, fmt.Sprintf("AfterForwardFailed %d %d %s", sourceDomain, nonce, reason))
+	return h.err
+}
+
+func TestHooksOnForward(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	hooks := &mockRouterHooks{}
+	routerKeeper.SetHooks(hooks)
+
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+	receiveForward(t, ctx, routerKeeper, 1, 2)
+
+	require.Equal(t, []string{
+		"AfterMintRecorded 1 2",
+		"AfterForwardSent 1 2 10000uusdc",
+	}, hooks.calls)
+}
+
+func TestHooksOnFailedForward(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	hooks := &mockRouterHooks{}
+	routerKeeper.SetHooks(hooks)
+
+	params := routerKeeper.GetParams(ctx)
+	params.MaxForwardRetries = 0
+	routerKeeper.SetParams(ctx, params)
+
+	forward := createRetryForward(1, 2)
+	routerKeeper.SetIBCForward(ctx, forward)
+	require.NoError(t, routerKeeper.HandleForwardTimeout(ctx, forward))
+
+	require.Equal(t, []string{"AfterForwardFailed 1 2 max forward retries reached"}, hooks.calls)
+}
+
+func TestHooksErrorDoesNotAbortHandleMessage(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	hooks := &mockRouterHooks{err: fmt.Errorf("hook error")}
+	routerKeeper.SetHooks(hooks)
+
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+	routerKeeper.AddAllowedSourceDomainSender(ctx, 0, fillByteArray(0, 32))
+
+	require.NoError(t, routerKeeper.HandleMessage(ctx, restrictedMintMessage()))
+	require.Equal(t, []string{"AfterMintRecorded 0 1"}, hooks.calls)
+
+	_, found := routerKeeper.GetMint(ctx, 0, 1)
+	require.True(t, found)
+}
+
+func TestHooksErrorDiscardsState(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	hooks := &mockRouterHooks{}
+	hooks.write = func(ctx sdk.Context) {
+		routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-99", ChainLabel: "hook"})
+		ctx.EventManager().EmitEvent(sdk.NewEvent("hook"))
+	}
+	routerKeeper.SetHooks(hooks)
+
+	routerKeeper.AfterMintRecorded(ctx, types.Mint{SourceDomain: 1, Nonce: 2})
+	_, found := routerKeeper.GetAllowedChannel(ctx, "channel-99")
+	require.True(t, found)
+	require.Len(t, ctx.EventManager().Events(), 1)
+
+	routerKeeper.DeleteAllowedChannel(ctx, "channel-99")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	// the state changes and events of a failing hook are discarded
+	hooks.err = fmt.Errorf("hook error")
+	routerKeeper.AfterMintRecorded(ctx, types.Mint{SourceDomain: 1, Nonce: 2})
+	_, found = routerKeeper.GetAllowedChannel(ctx, "channel-99")
+	require.False(t, found)
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestHooksPanicDiscardsState(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	hooks := &mockRouterHooks{}
+	hooks.write = func(ctx sdk.Context) {
+		routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-99", ChainLabel: "hook"})
+		panic("hook panic")
+	}
+	routerKeeper.SetHooks(hooks)
+
+	// a panicking hook is handled like a failing one
+	require.NotPanics(t, func() {
+		routerKeeper.AfterMintRecorded(ctx, types.Mint{SourceDomain: 1, Nonce: 2})
+	})
+	_, found := routerKeeper.GetAllowedChannel(ctx, "channel-99")
+	require.False(t, found)
+
+	err := types.CallHook(ctx, func(sdk.Context) error { panic("hook panic") })
+	require.ErrorContains(t, err, "hook panicked: hook panic")
+
+	// running out of gas still aborts the transaction
+	require.Panics(t, func() {
+		_ = types.CallHook(ctx, func(sdk.Context) error { panic(sdk.ErrorOutOfGas{Descriptor: "hook"}) })
+	})
+}
+
+func TestSetHooksTwice(t *testing.T) {
+	routerKeeper, _ := keepertest.RouterKeeper(t)
+	routerKeeper.SetHooks(&mockRouterHooks{})
+
+	require.Panics(t, func() {
+		routerKeeper.SetHooks(&mockRouterHooks{})
+	})
+}
+
+func TestMultiRouterHooks(t *testing.T) {
+	_, ctx := keepertest.RouterKeeper(t)
+	first, second := &mockRouterHooks{}, &mockRouterHooks{}
+	hooks := types.NewMultiRouterHooks(first, second)
+
+	require.NoError(t, hooks.AfterForwardAcked(ctx, types.InFlightPacket{SourceDomain: 1, Nonce: 2}))
+	require.Equal(t, []string{"AfterForwardAcked 1 2"}, first.calls)
+	require.Equal(t, []string{"AfterForwardAcked 1 2"}, second.calls)
+
+	// an error does not stop the remaining hooks, all errors are returned
+	first.err = fmt.Errorf("first hook error")
+	second.err = fmt.Errorf("second hook error")
+	err := hooks.AfterMintRecorded(ctx, types.Mint{SourceDomain: 1, Nonce: 2})
+	require.ErrorContains(t, err, "first hook error")
+	require.ErrorContains(t, err, "second hook error")
+	require.Len(t, second.calls, 2)
+}
@@ -8,11 +8,20 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// SetIBCForward sets a IBCForward in the store
+// SetIBCForward sets a IBCForward in the store and keeps the retry queue up to date
 func (k *Keeper) SetIBCForward(ctx sdk.Context, forward types.StoreIBCForwardMetadata) {
+	if existing, found := k.GetIBCForward(ctx, forward.SourceDomain, forward.Metadata.Nonce); found && existing.NextRetryHeight != forward.NextRetryHeight {
+		k.deleteForwardRetry(ctx, existing)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.IBCForwardPrefix)
 	b := k.cdc.MustMarshal(&forward)
 	store.Set(types.LookupKey(forward.SourceDomain, forward.Metadata.Nonce), b)
+
+	if forward.NextRetryHeight != 0 {
+		queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ForwardRetryQueuePrefix)
+		queueStore.Set(types.ForwardRetryKey(forward.NextRetryHeight, forward.SourceDomain, forward.Metadata.Nonce), []byte{})
+	}
 }
 
 // GetIBCForward returns IBCForward
@@ -28,12 +37,30 @@ func (k *Keeper) GetIBCForward(ctx sdk.Context, sourceDomain uint32, nonce uint6
 	return val, true
 }
 
-// DeleteIBCForward removes a IBCForward from the store
+// DeleteIBCForward removes a IBCForward and its pending retry from the store.
+// A mint left behind by the forward is indexed again so that it can be pruned.
 func (k *Keeper) DeleteIBCForward(ctx sdk.Context, sourceDomain uint32, nonce uint64) {
+	if existing, found := k.GetIBCForward(ctx, sourceDomain, nonce); found {
+		k.deleteForwardRetry(ctx, existing)
+	}
+	if mint, found := k.GetMint(ctx, sourceDomain, nonce); found {
+		k.setMintHeightIndex(ctx, mint)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.IBCForwardPrefix)
 	store.Delete(types.LookupKey(sourceDomain, nonce))
 }
 
+// deleteForwardRetry removes the retry queue entry of a IBCForward
+func (k *Keeper) deleteForwardRetry(ctx sdk.Context, forward types.StoreIBCForwardMetadata) {
+	if forward.NextRetryHeight == 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ForwardRetryQueuePrefix)
+	store.Delete(types.ForwardRetryKey(forward.NextRetryHeight, forward.SourceDomain, forward.Metadata.Nonce))
+}
+
 // GetAllIBCForwards returns all IBCForwards
 func (k *Keeper) GetAllIBCForwards(ctx sdk.Context) (list []types.StoreIBCForwardMetadata) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.IBCForwardPrefix)
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/strangelove-ventures/noble-router/x/router/types"
@@ -8,11 +10,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// SetInFlightPacket sets a InFlightPacket in the store
+// SetInFlightPacket sets a InFlightPacket in the store and indexes it by source domain and nonce
 func (k *Keeper) SetInFlightPacket(ctx sdk.Context, ifp types.InFlightPacket) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InFlightPacketPrefix)
 	b := k.cdc.MustMarshal(&ifp)
-	store.Set(types.InFlightPacketKey(ifp.Channel, ifp.Port, ifp.Sequence), b)
+	key := types.InFlightPacketKey(ifp.Channel, ifp.Port, ifp.Sequence)
+	store.Set(key, b)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.InFlightPacketByNoncePrefix)
+	indexStore.Set(types.LookupKey(ifp.SourceDomain, ifp.Nonce), key)
 }
 
 // GetInFlightPacket returns InFlightPacket
@@ -28,10 +34,41 @@ func (k *Keeper) GetInFlightPacket(ctx sdk.Context, channelID string, portID str
 	return val, true
 }
 
-// DeleteInFlightPacket removes a InFlightPacket from the store
+// DeleteInFlightPacket removes a InFlightPacket and its index entry from the store
 func (k *Keeper) DeleteInFlightPacket(ctx sdk.Context, channelID string, portID string, sequence uint64) {
+	key := types.InFlightPacketKey(channelID, portID, sequence)
+	if existing, found := k.GetInFlightPacket(ctx, channelID, portID, sequence); found {
+		indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.InFlightPacketByNoncePrefix)
+		lookupKey := types.LookupKey(existing.SourceDomain, existing.Nonce)
+
+		// only remove the index entry if it has not been replaced by a newer packet
+		if bytes.Equal(indexStore.Get(lookupKey), key) {
+			indexStore.Delete(lookupKey)
+		}
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InFlightPacketPrefix)
-	store.Delete(types.InFlightPacketKey(channelID, portID, sequence))
+	store.Delete(key)
+}
+
+// GetInFlightPacketByNonce returns the InFlightPacket of the IBC forward of a mint
+func (k *Keeper) GetInFlightPacketByNonce(ctx sdk.Context, sourceDomain uint32, nonce uint64) (val types.InFlightPacket, found bool) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.InFlightPacketByNoncePrefix)
+
+	key := indexStore.Get(types.LookupKey(sourceDomain, nonce))
+	if key == nil {
+		return val, false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InFlightPacketPrefix)
+
+	b := store.Get(key)
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
 }
 
 // GetAllInFlightPackets returns all InFlightPackets
@@ -45,6 +45,41 @@ func TestInFlightPacketGet(t *testing.T) {
 	}
 }
 
+func TestInFlightPacketGetByNonce(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	items := createNInFlightPacket(routerKeeper, ctx, 10)
+	for _, item := range items {
+		rst, found := routerKeeper.GetInFlightPacketByNonce(ctx, item.SourceDomain, item.Nonce)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+
+	_, found := routerKeeper.GetInFlightPacketByNonce(ctx, 1, 0)
+	require.False(t, found)
+}
+
+func TestInFlightPacketByNonceReplaced(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+
+	first := types.InFlightPacket{SourceDomain: 1, Nonce: 2, Channel: "channel-0", Port: "transfer", Sequence: 1}
+	second := types.InFlightPacket{SourceDomain: 1, Nonce: 2, Channel: "channel-0", Port: "transfer", Sequence: 2}
+	routerKeeper.SetInFlightPacket(ctx, first)
+	routerKeeper.SetInFlightPacket(ctx, second)
+
+	// removing the replaced packet keeps the index of the newer one
+	routerKeeper.DeleteInFlightPacket(ctx, first.Channel, first.Port, first.Sequence)
+	rst, found := routerKeeper.GetInFlightPacketByNonce(ctx, 1, 2)
+	require.True(t, found)
+	require.Equal(t, second, rst)
+
+	routerKeeper.DeleteInFlightPacket(ctx, second.Channel, second.Port, second.Sequence)
+	_, found = routerKeeper.GetInFlightPacketByNonce(ctx, 1, 2)
+	require.False(t, found)
+}
+
 func TestInFlightPacketRemove(t *testing.T) {
 	routerKeeper, ctx := keepertest.RouterKeeper(t)
 	items := createNInFlightPacket(routerKeeper, ctx, 10)
@@ -60,6 +95,8 @@ func TestInFlightPacketRemove(t *testing.T) {
 			strconv.Itoa(i),
 			uint64(i))
 		require.False(t, found)
+		_, found = routerKeeper.GetInFlightPacketByNonce(ctx, items[i].SourceDomain, items[i].Nonce)
+		require.False(t, found)
 	}
 }
 
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+// BurnInbound burns an amount held by the inbound burner through CCTP, as instructed by the memo
+// of an inbound transfer. It returns the nonce of the CCTP message.
+func (k *Keeper) BurnInbound(ctx sdk.Context, amount sdk.Coin, instruction types.InboundInstruction) (uint64, error) {
+	if k.cctpMsgServer == nil {
+		return 0, sdkerrors.Wrap(types.ErrInboundBurn, "cctp msg server is not set")
+	}
+
+	mintRecipient, err := instruction.MintRecipientBytes()
+	if err != nil {
+		return 0, sdkerrors.Wrapf(types.ErrInboundBurn, "invalid mint recipient: %s", err)
+	}
+	destinationCaller, err := instruction.DestinationCallerBytes()
+	if err != nil {
+		return 0, sdkerrors.Wrapf(types.ErrInboundBurn, "invalid destination caller: %s", err)
+	}
+
+	if len(destinationCaller) == 0 {
+		res, err := k.cctpMsgServer.DepositForBurn(sdk.WrapSDKContext(ctx), &cctptypes.MsgDepositForBurn{
+			From:              types.InboundBurnerAddress.String(),
+			Amount:            math.NewIntFromBigInt(amount.Amount.BigInt()),
+			DestinationDomain: instruction.DestinationDomain,
+			MintRecipient:     mintRecipient,
+			BurnToken:         amount.Denom,
+		})
+		if err != nil {
+			return 0, sdkerrors.Wrapf(types.ErrInboundBurn, "unable to deposit for burn: %s", err)
+		}
+		return res.Nonce, nil
+	}
+
+	res, err := k.cctpMsgServer.DepositForBurnWithCaller(sdk.WrapSDKContext(ctx), &cctptypes.MsgDepositForBurnWithCaller{
+		From:              types.InboundBurnerAddress.String(),
+		Amount:            math.NewIntFromBigInt(amount.Amount.BigInt()),
+		DestinationDomain: instruction.DestinationDomain,
+		MintRecipient:     mintRecipient,
+		BurnToken:         amount.Denom,
+		DestinationCaller: destinationCaller,
+	})
+	if err != nil {
+		return 0, sdkerrors.Wrapf(types.ErrInboundBurn, "unable to deposit for burn with caller: %s", err)
+	}
+	return res.Nonce, nil
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+// RegisterInvariants registers all router invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "in-flight-packets", InFlightPacketsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "in-flight-ack-errors", InFlightAckErrorsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "allowed-sender-addresses", AllowedSenderAddressesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the router module
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			InFlightPacketsInvariant(k),
+			InFlightAckErrorsInvariant(k),
+			AllowedSenderAddressesInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// InFlightPacketsInvariant checks that every in flight packet has a matching mint and IBC forward
+func InFlightPacketsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		for _, packet := range k.GetAllInFlightPackets(ctx) {
+			if _, found := k.GetMint(ctx, packet.SourceDomain, packet.Nonce); !found {
+				broken++
+				msg += fmt.Sprintf("\tno mint for in flight packet %s/%s/%d of source domain %d and nonce %d\n",
+					packet.Channel, packet.Port, packet.Sequence, packet.SourceDomain, packet.Nonce)
+			}
+			if _, found := k.GetIBCForward(ctx, packet.SourceDomain, packet.Nonce); !found {
+				broken++
+				msg += fmt.Sprintf("\tno ibc forward for in flight packet %s/%s/%d of source domain %d and nonce %d\n",
+					packet.Channel, packet.Port, packet.Sequence, packet.SourceDomain, packet.Nonce)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "in-flight-packets",
+			fmt.Sprintf("%d in flight packets without a matching mint or ibc forward\n%s", broken, msg)), broken != 0
+	}
+}
+
+// InFlightAckErrorsInvariant checks that no IBC forward with an in flight packet is marked with an ack error
+func InFlightAckErrorsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		for _, packet := range k.GetAllInFlightPackets(ctx) {
+			if forward, found := k.GetIBCForward(ctx, packet.SourceDomain, packet.Nonce); found && forward.AckError {
+				broken++
+				msg += fmt.Sprintf("\tibc forward of source domain %d and nonce %d has an ack error while in flight\n",
+					packet.SourceDomain, packet.Nonce)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "in-flight-ack-errors",
+			fmt.Sprintf("%d in flight ibc forwards with an ack error\n%s", broken, msg)), broken != 0
+	}
+}
+
+// AllowedSenderAddressesInvariant checks that every allowed source domain sender address is 32 bytes
+func AllowedSenderAddressesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		// the keys are checked directly, as GetAllowedSourceDomainSenders assumes 32 byte addresses
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AllowedSourceDomainSenderKeyPrefix)
+		iterator := sdk.KVStorePrefixIterator(store, []byte{})
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			key := iterator.Key()
+			if len(key) != 4+32 {
+				broken++
+				msg += fmt.Sprintf("\tallowed sender key %x does not hold a 32 byte address\n", key)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "allowed-sender-addresses",
+			fmt.Sprintf("%d allowed sender addresses that are not 32 bytes\n%s", broken, msg)), broken != 0
+	}
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+// setupConsistentState stores an in flight forward whose mint is from an allowed sender.
+func setupConsistentState(ctx sdk.Context, routerKeeper *keeper.Keeper) {
+	sender := fillByteArray(0, 32)
+	routerKeeper.AddAllowedSourceDomainSender(ctx, 1, sender)
+
+	_, mint := setupForward(ctx, routerKeeper, 1, 2, 10000)
+	mint.SourceDomainSender = sender
+	routerKeeper.SetMint(ctx, mint)
+	routerKeeper.SetInFlightPacket(ctx, types.InFlightPacket{
+		SourceDomain: 1,
+		Nonce:        2,
+		Channel:      "channel-10",
+		Port:         "transfer",
+		Sequence:     3,
+	})
+}
+
+func TestInvariantsHold(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	setupConsistentState(ctx, routerKeeper)
+
+	_, broken := keeper.AllInvariants(routerKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestInFlightPacketsInvariant(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	setupConsistentState(ctx, routerKeeper)
+
+	routerKeeper.DeleteMint(ctx, 1, 2)
+	msg, broken := keeper.InFlightPacketsInvariant(routerKeeper)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "no mint for in flight packet")
+
+	routerKeeper, ctx = keepertest.RouterKeeper(t)
+	setupConsistentState(ctx, routerKeeper)
+
+	routerKeeper.DeleteIBCForward(ctx, 1, 2)
+	msg, broken = keeper.InFlightPacketsInvariant(routerKeeper)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "no ibc forward for in flight packet")
+}
+
+func TestInFlightAckErrorsInvariant(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	setupConsistentState(ctx, routerKeeper)
+
+	forward := createRetryForward(1, 2)
+	forward.AckError = true
+	routerKeeper.SetIBCForward(ctx, forward)
+
+	_, broken := keeper.InFlightAckErrorsInvariant(routerKeeper)(ctx)
+	require.True(t, broken)
+
+	_, broken = keeper.AllInvariants(routerKeeper)(ctx)
+	require.True(t, broken)
+}
+
+func TestInvariantsMintOfUnlistedSender(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	setupConsistentState(ctx, routerKeeper)
+
+	// mints are recorded from the TokenMessenger of the source domain, which is not on the allowlist
+	routerKeeper.SetMint(ctx, types.Mint{
+		SourceDomain:       1,
+		SourceDomainSender: fillByteArray(32, 32),
+		Nonce:              4,
+		Amount:             &sdk.Coin{Denom: "uusdc", Amount: sdk.NewInt(10000)},
+	})
+
+	_, broken := keeper.AllInvariants(routerKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestAllowedSenderAddressesInvariant(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	setupConsistentState(ctx, routerKeeper)
+
+	// a 20 byte address stored without the padding of SourceDomainSenderKey
+	routerKeeper.SetAllowedSourceDomainSenderKey(ctx, append([]byte{0, 0, 0, 1}, fillByteArray(0, 20)...))
+
+	_, broken := keeper.AllowedSenderAddressesInvariant(routerKeeper)(ctx)
+	require.True(t, broken)
+}
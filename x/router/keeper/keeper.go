@@ -16,19 +16,36 @@ type (
 	Keeper struct {
 		cdc            codec.BinaryCodec
 		storeKey       storetypes.StoreKey
-		paramstore     paramtypes.Subspace
+		paramstore     paramtypes.Subspace // legacy x/params subspace, only read by the store migrations
 		cctpKeeper     types.CctpKeeper
+		cctpMsgServer  types.CctpMsgServer
 		transferKeeper types.TransferKeeper
+		channelKeeper  types.ChannelKeeper
+		bankKeeper     types.BankKeeper
+		hooks          types.RouterHooks
+
+		// the address that can act in place of the owner, usually the gov module account
+		authority string
 	}
 )
 
+// NewKeeper creates the router keeper. The router learns the relayer of a transaction from the
+// RelayerDecorator, which has to be added to the ante handler of the app for relayer tips to be paid
+// and for forwards with a required submitter to be paired.
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	ps paramtypes.Subspace,
 	cctpKeeper types.CctpKeeper,
 	transferKeeper types.TransferKeeper,
+	channelKeeper types.ChannelKeeper,
+	bankKeeper types.BankKeeper,
+	authority string,
 ) *Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid %s authority address: %s", types.ModuleName, err))
+	}
+
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
 		ps = ps.WithKeyTable(types.ParamKeyTable())
@@ -40,13 +57,37 @@ func NewKeeper(
 		paramstore:     ps,
 		cctpKeeper:     cctpKeeper,
 		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
+		bankKeeper:     bankKeeper,
+		authority:      authority,
 	}
 }
 
+// GetAuthority returns the address that can act in place of the owner of the router module.
+func (k *Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k *Keeper) SetCctpKeeper(cctpKeeper types.CctpKeeper) {
 	k.cctpKeeper = cctpKeeper
 }
 
+// SetCctpMsgServer sets the cctp msg server used to burn inbound transfers carrying a router instruction.
+func (k *Keeper) SetCctpMsgServer(cctpMsgServer types.CctpMsgServer) {
+	k.cctpMsgServer = cctpMsgServer
+}
+
+// ValidateWiring returns an error if a dependency that is set after the keeper is created is missing.
+// Apps call it once their keepers are wired, so that a missing dependency fails the app setup instead
+// of every inbound transfer carrying a router instruction.
+func (k *Keeper) ValidateWiring() error {
+	if k.cctpMsgServer == nil {
+		return fmt.Errorf("x/%s: the cctp msg server is not set, set it with SetCctpMsgServer", types.ModuleName)
+	}
+
+	return nil
+}
+
 func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -0,0 +1,10 @@
+package keeper
+
+import (
+	"github.com/strangelove-ventures/noble-router/x/router/keeper/migrations"
+)
+
+// Migrator returns the store migrator of the module.
+func (k *Keeper) Migrator() migrations.Migrator {
+	return migrations.NewMigrator(k.storeKey, k.cdc, k.paramstore, k.channelKeeper)
+}
@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v2 "github.com/strangelove-ventures/noble-router/x/router/keeper/migrations/v2"
+	v3 "github.com/strangelove-ventures/noble-router/x/router/keeper/migrations/v3"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+//
+// Each migration lives in its own versioned package and works on the raw store with a frozen copy of the key
+// layout of its version, so that later changes to the keeper do not alter what an older migration does.
+type Migrator struct {
+	storeKey   storetypes.StoreKey
+	cdc        codec.BinaryCodec
+	paramstore paramtypes.Subspace
+
+	channelKeeper types.ChannelKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace, channelKeeper types.ChannelKeeper) Migrator {
+	return Migrator{
+		storeKey:      storeKey,
+		cdc:           cdc,
+		paramstore:    paramstore,
+		channelKeeper: channelKeeper,
+	}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.storeKey, m.cdc, m.paramstore, m.channelKeeper)
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.storeKey, m.cdc, m.paramstore)
+}
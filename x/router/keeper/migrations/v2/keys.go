@@ -0,0 +1,49 @@
+package v2
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// Key layout of version 2, frozen so that this migration keeps writing it after the layout changes.
+var (
+	MintPrefix                  = []byte("mint/")
+	MintByHeightPrefix          = []byte("mintbyheight/")
+	InFlightPacketPrefix        = []byte("inflight/")
+	InFlightPacketByNoncePrefix = []byte("inflightbynonce/")
+	AllowedChannelKeyPrefix     = []byte("allowedchannel/")
+)
+
+// Params added in version 2 and their defaults, frozen with the key layout.
+var (
+	KeyMaxForwardRetries                 = []byte("MaxForwardRetries")
+	KeyMaxRelativePacketTimeoutTimestamp = []byte("MaxRelativePacketTimeoutTimestamp")
+	KeyFeeCollector                      = []byte("FeeCollector")
+	KeySourceDomainFees                  = []byte("SourceDomainFees")
+	KeyChannelFees                       = []byte("ChannelFees")
+)
+
+const (
+	DefaultMaxForwardRetries                 = uint64(5)
+	DefaultMaxRelativePacketTimeoutTimestamp = uint64(24 * time.Hour)
+	DefaultFeeCollector                      = ""
+)
+
+func LookupKey(sourceDomain uint32, nonce uint64) []byte {
+	sourceDomainBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(sourceDomainBytes, sourceDomain)
+	nonceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonceBytes, nonce)
+	return append(nonceBytes, sourceDomainBytes...)
+}
+
+func MintByHeightKey(height uint64, sourceDomain uint32, nonce uint64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, height)
+	return append(heightBytes, LookupKey(sourceDomain, nonce)...)
+}
+
+func InFlightPacketKey(channelID, portID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d", channelID, portID, sequence))
+}
@@ -0,0 +1,118 @@
+package v2
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// ChannelKeeper defines the channel keeper used to seed the channel allowlist.
+type ChannelKeeper interface {
+	GetAllChannels(ctx sdk.Context) []channeltypes.IdentifiedChannel
+}
+
+// MigrateStore performs in-place store migrations from version 1 to 2.
+// It builds the mint height index used for pruning from the existing mints,
+// indexes the existing in flight packets by source domain and nonce, allows the open transfer
+// channels for IBC forwards, and sets the params added since version 1 to their defaults.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace, channelKeeper ChannelKeeper) error {
+	store := ctx.KVStore(storeKey)
+
+	if err := migrateMints(store, cdc); err != nil {
+		return err
+	}
+	if err := migrateInFlightPackets(store, cdc); err != nil {
+		return err
+	}
+	migrateAllowedChannels(ctx, store, cdc, channelKeeper)
+
+	return migrateParams(ctx, paramstore)
+}
+
+func migrateMints(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	mintStore := prefix.NewStore(store, MintPrefix)
+	indexStore := prefix.NewStore(store, MintByHeightPrefix)
+
+	iterator := mintStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var mint Mint
+		if err := cdc.Unmarshal(iterator.Value(), &mint); err != nil {
+			return err
+		}
+		indexStore.Set(MintByHeightKey(mint.Height, mint.SourceDomain, mint.Nonce), []byte{})
+	}
+
+	return nil
+}
+
+func migrateInFlightPackets(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	packetStore := prefix.NewStore(store, InFlightPacketPrefix)
+	indexStore := prefix.NewStore(store, InFlightPacketByNoncePrefix)
+
+	iterator := packetStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packet InFlightPacket
+		if err := cdc.Unmarshal(iterator.Value(), &packet); err != nil {
+			return err
+		}
+		indexStore.Set(LookupKey(packet.SourceDomain, packet.Nonce), InFlightPacketKey(packet.Channel, packet.Port, packet.Sequence))
+	}
+
+	return nil
+}
+
+// migrateAllowedChannels seeds the channel allowlist added in version 2 with the open channels of the
+// transfer port, which forwards could be sent over in version 1. Without it, every forward would fail
+// until the owner allowed its channel.
+func migrateAllowedChannels(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec, channelKeeper ChannelKeeper) {
+	allowedChannelStore := prefix.NewStore(store, AllowedChannelKeyPrefix)
+
+	for _, channel := range channelKeeper.GetAllChannels(ctx) {
+		if channel.PortId != transfertypes.PortID || channel.State != channeltypes.OPEN {
+			continue
+		}
+		if allowedChannelStore.Has([]byte(channel.ChannelId)) {
+			continue
+		}
+
+		allowedChannel := AllowedChannel{
+			Channel:    channel.ChannelId,
+			ChainLabel: fmt.Sprintf("%s/%s", channel.Counterparty.PortId, channel.Counterparty.ChannelId),
+		}
+		allowedChannelStore.Set([]byte(allowedChannel.Channel), cdc.MustMarshal(&allowedChannel))
+	}
+}
+
+// migrateParams sets the params added in version 2 to their defaults. The fee lists are written as
+// their JSON encoding, so that they do not depend on the current fee types.
+func migrateParams(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	if !paramstore.Has(ctx, KeyMaxForwardRetries) {
+		paramstore.Set(ctx, KeyMaxForwardRetries, DefaultMaxForwardRetries)
+	}
+	if !paramstore.Has(ctx, KeyMaxRelativePacketTimeoutTimestamp) {
+		paramstore.Set(ctx, KeyMaxRelativePacketTimeoutTimestamp, DefaultMaxRelativePacketTimeoutTimestamp)
+	}
+	if !paramstore.Has(ctx, KeyFeeCollector) {
+		paramstore.Set(ctx, KeyFeeCollector, DefaultFeeCollector)
+	}
+	for _, key := range [][]byte{KeySourceDomainFees, KeyChannelFees} {
+		if paramstore.Has(ctx, key) {
+			continue
+		}
+		if err := paramstore.Update(ctx, key, []byte("null")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	v2 "github.com/strangelove-ventures/noble-router/x/router/keeper/migrations/v2"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+)
+
+// v1State is the layout of the fixtures in testdata.
+type v1State struct {
+	MintPruneBlocks uint64            `json:"mint_prune_blocks,string"`
+	Mints           []json.RawMessage `json:"mints"`
+	InFlightPackets []json.RawMessage `json:"in_flight_packets"`
+}
+
+// loadV1State writes the v1 fixture to a fresh store, without any of the indexes or params added in v2.
+func loadV1State(t *testing.T) (sdk.Context, storetypes.StoreKey, codec.Codec, paramtypes.Subspace, []v2.Mint, []v2.InFlightPacket) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(types.TransientStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramstore := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, "RouterParams").
+		WithKeyTable(types.ParamKeyTable())
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	bz, err := os.ReadFile("testdata/v1_state.json")
+	require.NoError(t, err)
+
+	var state v1State
+	require.NoError(t, json.Unmarshal(bz, &state))
+
+	paramstore.Set(ctx, types.KeyMintPruneBlocks, state.MintPruneBlocks)
+
+	mintStore := prefix.NewStore(ctx.KVStore(storeKey), v2.MintPrefix)
+	mints := make([]v2.Mint, len(state.Mints))
+	for i, raw := range state.Mints {
+		require.NoError(t, cdc.UnmarshalJSON(raw, &mints[i]))
+		mintStore.Set(v2.LookupKey(mints[i].SourceDomain, mints[i].Nonce), cdc.MustMarshal(&mints[i]))
+	}
+
+	packetStore := prefix.NewStore(ctx.KVStore(storeKey), v2.InFlightPacketPrefix)
+	packets := make([]v2.InFlightPacket, len(state.InFlightPackets))
+	for i, raw := range state.InFlightPackets {
+		require.NoError(t, cdc.UnmarshalJSON(raw, &packets[i]))
+		packetStore.Set(v2.InFlightPacketKey(packets[i].Channel, packets[i].Port, packets[i].Sequence), cdc.MustMarshal(&packets[i]))
+	}
+
+	return ctx, storeKey, cdc, paramstore, mints, packets
+}
+
+func TestMigrateStore(t *testing.T) {
+	ctx, storeKey, cdc, paramstore, mints, packets := loadV1State(t)
+	require.Len(t, mints, 2)
+	require.Len(t, packets, 2)
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc, paramstore, keepertest.MockChannelKeeper{}))
+
+	mintIndex := prefix.NewStore(ctx.KVStore(storeKey), v2.MintByHeightPrefix)
+	for _, mint := range mints {
+		require.True(t, mintIndex.Has(v2.MintByHeightKey(mint.Height, mint.SourceDomain, mint.Nonce)))
+	}
+
+	packetIndex := prefix.NewStore(ctx.KVStore(storeKey), v2.InFlightPacketByNoncePrefix)
+	for _, packet := range packets {
+		require.Equal(t,
+			v2.InFlightPacketKey(packet.Channel, packet.Port, packet.Sequence),
+			packetIndex.Get(v2.LookupKey(packet.SourceDomain, packet.Nonce)),
+		)
+	}
+
+	var params types.Params
+	paramstore.GetParamSet(ctx, &params)
+
+	expected := types.DefaultParams()
+	expected.MintPruneBlocks = 100
+	require.Equal(t, expected, params)
+	require.NoError(t, params.Validate())
+}
+
+func TestMigrateStoreKeepsParams(t *testing.T) {
+	ctx, storeKey, cdc, paramstore, _, _ := loadV1State(t)
+
+	paramstore.Set(ctx, types.KeyMaxForwardRetries, uint64(9))
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc, paramstore, keepertest.MockChannelKeeper{}))
+
+	var maxForwardRetries uint64
+	paramstore.Get(ctx, types.KeyMaxForwardRetries, &maxForwardRetries)
+	require.Equal(t, uint64(9), maxForwardRetries)
+}
+
+func TestMigrateStoreAllowsOpenTransferChannels(t *testing.T) {
+	ctx, storeKey, cdc, paramstore, _, _ := loadV1State(t)
+
+	channelKeeper := keepertest.MockChannelKeeper{Channels: []channeltypes.IdentifiedChannel{
+		{
+			State:        channeltypes.OPEN,
+			Counterparty: channeltypes.NewCounterparty(transfertypes.PortID, "channel-4"),
+			PortId:       transfertypes.PortID,
+			ChannelId:    "channel-0",
+		},
+		{
+			State:        channeltypes.CLOSED,
+			Counterparty: channeltypes.NewCounterparty(transfertypes.PortID, "channel-7"),
+			PortId:       transfertypes.PortID,
+			ChannelId:    "channel-1",
+		},
+		{
+			State:        channeltypes.OPEN,
+			Counterparty: channeltypes.NewCounterparty("icahost", "channel-2"),
+			PortId:       "icacontroller-1",
+			ChannelId:    "channel-2",
+		},
+	}}
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc, paramstore, channelKeeper))
+
+	allowedChannelStore := prefix.NewStore(ctx.KVStore(storeKey), v2.AllowedChannelKeyPrefix)
+
+	var allowedChannel types.AllowedChannel
+	cdc.MustUnmarshal(allowedChannelStore.Get([]byte("channel-0")), &allowedChannel)
+	require.Equal(t, types.AllowedChannel{Channel: "channel-0", ChainLabel: "transfer/channel-4"}, allowedChannel)
+	require.NoError(t, allowedChannel.Validate())
+
+	require.False(t, allowedChannelStore.Has([]byte("channel-1")))
+	require.False(t, allowedChannelStore.Has([]byte("channel-2")))
+}
@@ -0,0 +1,6 @@
+package v3
+
+// Key layout of version 3, frozen so that this migration keeps writing it after the layout changes.
+var (
+	ParamsKey = []byte("params")
+)
@@ -0,0 +1,32 @@
+package v3
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+// MigrateStore performs in-place store migrations from version 2 to 3.
+// It moves the params from the legacy x/params subspace into the router store. The params are
+// validated against the bounds added in version 3, a param outside of them fails the migration
+// instead of being changed silently.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace) error {
+	params := types.DefaultParams()
+	paramstore.GetParamSetIfExists(ctx, &params)
+
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid %s params in the legacy subspace: %w", types.ModuleName, err)
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(storeKey).Set(ParamsKey, bz)
+
+	return nil
+}
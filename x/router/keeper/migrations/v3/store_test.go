@@ -0,0 +1,70 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v3 "github.com/strangelove-ventures/noble-router/x/router/keeper/migrations/v3"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/strangelove-ventures/noble/testutil/sample"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+)
+
+// setupV2State returns a store with the params of version 2, kept in the legacy subspace.
+func setupV2State(t *testing.T, params types.Params) (sdk.Context, storetypes.StoreKey, codec.Codec, paramtypes.Subspace) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(types.TransientStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramstore := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, "RouterParams").
+		WithKeyTable(types.ParamKeyTable())
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	// set the pairs one by one, SetParamSet would reject the invalid params of the failure tests
+	for _, pair := range params.ParamSetPairs() {
+		paramstore.Set(ctx, pair.Key, pair.Value)
+	}
+
+	return ctx, storeKey, cdc, paramstore
+}
+
+func TestMigrateStore(t *testing.T) {
+	params := types.DefaultParams()
+	params.MintPruneBlocks = 100
+	params.FeeCollector = sample.AccAddress()
+	params.ChannelFees = []types.ChannelFee{{Channel: "channel-0", Fee: types.Fee{Bps: 10}}}
+
+	ctx, storeKey, cdc, paramstore := setupV2State(t, params)
+	require.Nil(t, ctx.KVStore(storeKey).Get(v3.ParamsKey))
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc, paramstore))
+
+	var migrated types.Params
+	cdc.MustUnmarshal(ctx.KVStore(storeKey).Get(v3.ParamsKey), &migrated)
+	require.Equal(t, params, migrated)
+}
+
+func TestMigrateStoreInvalidParams(t *testing.T) {
+	params := types.DefaultParams()
+	params.MintPruneBlocks = 0
+
+	ctx, storeKey, cdc, paramstore := setupV2State(t, params)
+
+	require.Error(t, v3.MigrateStore(ctx, storeKey, cdc, paramstore))
+	require.Nil(t, ctx.KVStore(storeKey).Get(v3.ParamsKey))
+}
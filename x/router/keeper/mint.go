@@ -8,11 +8,17 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// SetMint sets a mint in the store
+// SetMint sets a mint in the store and keeps the height index up to date
 func (k *Keeper) SetMint(ctx sdk.Context, key types.Mint) {
+	if existing, found := k.GetMint(ctx, key.SourceDomain, key.Nonce); found && existing.Height != key.Height {
+		k.deleteMintHeightIndex(ctx, existing)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintPrefix)
 	b := k.cdc.MustMarshal(&key)
 	store.Set(types.LookupKey(key.SourceDomain, key.Nonce), b)
+
+	k.setMintHeightIndex(ctx, key)
 }
 
 // GetMint returns mint
@@ -28,12 +34,28 @@ func (k *Keeper) GetMint(ctx sdk.Context, sourceDomain uint32, nonce uint64) (va
 	return val, true
 }
 
-// DeleteMint removes a mint from the store
+// DeleteMint removes a mint and its height index entry from the store
 func (k *Keeper) DeleteMint(ctx sdk.Context, sourceDomain uint32, nonce uint64) {
+	if existing, found := k.GetMint(ctx, sourceDomain, nonce); found {
+		k.deleteMintHeightIndex(ctx, existing)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintPrefix)
 	store.Delete(types.LookupKey(sourceDomain, nonce))
 }
 
+// setMintHeightIndex adds the height index entry of a mint
+func (k *Keeper) setMintHeightIndex(ctx sdk.Context, mint types.Mint) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintByHeightPrefix)
+	store.Set(types.MintByHeightKey(mint.Height, mint.SourceDomain, mint.Nonce), []byte{})
+}
+
+// deleteMintHeightIndex removes the height index entry of a mint
+func (k *Keeper) deleteMintHeightIndex(ctx sdk.Context, mint types.Mint) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintByHeightPrefix)
+	store.Delete(types.MintByHeightKey(mint.Height, mint.SourceDomain, mint.Nonce))
+}
+
 // GetAllMints returns all mints
 func (k *Keeper) GetAllMints(ctx sdk.Context) (list []types.Mint) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintPrefix)
@@ -71,3 +93,13 @@ func (k *Keeper) GetAllMintsPaginated(ctx sdk.Context, pagination *query.PageReq
 
 	return mints, pageRes, nil
 }
+
+// ReleaseMint sends an amount of the minted funds from the mint recipient to the given address
+func (k *Keeper) ReleaseMint(ctx sdk.Context, mint types.Mint, amount sdk.Coin, recipient sdk.AccAddress) error {
+	mintRecipient, err := sdk.AccAddressFromBech32(mint.MintRecipient)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoins(ctx, mintRecipient, recipient, sdk.NewCoins(amount))
+}
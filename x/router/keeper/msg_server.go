@@ -7,6 +7,9 @@ import (
 
 // msgServerRouterKeeper defines the router keeper methods required by the message server.
 type msgServerRouterKeeper interface {
+	GetAuthority() string
+	GetParams(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, params types.Params)
 	GetOwner(ctx sdk.Context) (owner string)
 	SetOwner(ctx sdk.Context, owner string)
 	GetPendingOwner(ctx sdk.Context) (pendingOwner string, found bool)
@@ -15,6 +18,27 @@ type msgServerRouterKeeper interface {
 	IsAllowedSourceDomainSender(ctx sdk.Context, domainID uint32, sourceDomainSender []byte) (allowed bool)
 	AddAllowedSourceDomainSender(ctx sdk.Context, domainID uint32, sourceDomainSender []byte)
 	DeleteAllowedSourceDomainSender(ctx sdk.Context, domainID uint32, sourceDomainSender []byte)
+	IsAllowedChannel(ctx sdk.Context, channel string) bool
+	SetAllowedChannel(ctx sdk.Context, allowedChannel types.AllowedChannel)
+	DeleteAllowedChannel(ctx sdk.Context, channel string)
+	GetIBCForward(ctx sdk.Context, sourceDomain uint32, nonce uint64) (val types.StoreIBCForwardMetadata, found bool)
+	DeleteIBCForward(ctx sdk.Context, sourceDomain uint32, nonce uint64)
+	GetMint(ctx sdk.Context, sourceDomain uint32, nonce uint64) (val types.Mint, found bool)
+	DeleteMint(ctx sdk.Context, sourceDomain uint32, nonce uint64)
+	ReleaseMint(ctx sdk.Context, mint types.Mint, amount sdk.Coin, recipient sdk.AccAddress) error
+	SetForwardReceipt(ctx sdk.Context, receipt types.ForwardReceipt)
+	GetPauser(ctx sdk.Context) (pauser string)
+	SetPauser(ctx sdk.Context, pauser string)
+	SetRoutingPausedGlobally(ctx sdk.Context, paused bool)
+	SetSourceDomainPaused(ctx sdk.Context, sourceDomain uint32, paused bool)
+	ReleaseHeldForwards(ctx sdk.Context)
+	GetSourceDomainRateLimit(ctx sdk.Context, sourceDomain uint32) (val types.SourceDomainRateLimit, found bool)
+	SetSourceDomainRateLimit(ctx sdk.Context, rateLimit types.SourceDomainRateLimit)
+	DeleteSourceDomainRateLimit(ctx sdk.Context, sourceDomain uint32)
+	GetChannelRateLimit(ctx sdk.Context, channel string) (val types.ChannelRateLimit, found bool)
+	SetChannelRateLimit(ctx sdk.Context, rateLimit types.ChannelRateLimit)
+	DeleteChannelRateLimit(ctx sdk.Context, channel string)
+	PairForward(ctx sdk.Context, sourceDomain uint32, nonce uint64, submitter sdk.AccAddress) error
 }
 
 type msgServer struct {
@@ -28,3 +52,9 @@ func NewMsgServerImpl(keeper msgServerRouterKeeper) *msgServer {
 }
 
 var _ types.MsgServer = msgServer{}
+
+// isOwnerOrAuthority reports whether an address can act as the owner. The authority is checked first,
+// so that it can act even when the owner is not set.
+func (m msgServer) isOwnerOrAuthority(ctx sdk.Context, address string) bool {
+	return address == m.keeper.GetAuthority() || address == m.keeper.GetOwner(ctx)
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+func (m msgServer) AddAllowedChannel(goCtx context.Context, msg *types.MsgAddAllowedChannel) (*types.MsgAddAllowedChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner := m.keeper.GetOwner(ctx)
+	if owner != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "this message sender cannot add allowed channels")
+	}
+
+	if m.keeper.IsAllowedChannel(ctx, msg.Channel) {
+		return nil, types.ErrAllowedChannelAlreadyFound
+	}
+
+	allowedChannel := msg.AllowedChannel()
+	if err := allowedChannel.Validate(); err != nil {
+		return nil, err
+	}
+
+	m.keeper.SetAllowedChannel(ctx, allowedChannel)
+
+	event := types.AllowedChannelAdded{
+		Channel:    msg.Channel,
+		ChainLabel: msg.ChainLabel,
+	}
+	err := ctx.EventManager().EmitTypedEvent(&event)
+
+	return &types.MsgAddAllowedChannelResponse{}, err
+}
@@ -11,8 +11,7 @@ import (
 func (m msgServer) AddAllowedSourceDomainSender(goCtx context.Context, msg *types.MsgAddAllowedSourceDomainSender) (*types.MsgAddAllowedSourceDomainSenderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner := m.keeper.GetOwner(ctx)
-	if owner != msg.From {
+	if !m.isOwnerOrAuthority(ctx, msg.From) {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "this message sender cannot add allowed source domain senders")
 	}
 
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/strangelove-ventures/noble/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+* Add happy path
+* Add invalid authority
+* Add allowed channel already found
+* Add invalid chain label
+* Remove happy path
+* Remove allowed channel not found
+ */
+
+func TestAddAllowedChannelHappyPath(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+
+	message := types.MsgAddAllowedChannel{
+		From:       owner,
+		Channel:    "channel-1",
+		ChainLabel: "osmosis",
+	}
+
+	_, err := server.AddAllowedChannel(sdk.WrapSDKContext(ctx), &message)
+	require.Nil(t, err)
+
+	allowedChannel, found := testkeeper.GetAllowedChannel(ctx, "channel-1")
+	require.True(t, found)
+	require.Equal(t, "osmosis", allowedChannel.ChainLabel)
+}
+
+func TestAddAllowedChannelInvalidAuthority(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetOwner(ctx, sample.AccAddress())
+
+	message := types.MsgAddAllowedChannel{
+		From:       sample.AccAddress(),
+		Channel:    "channel-1",
+		ChainLabel: "osmosis",
+	}
+
+	_, err := server.AddAllowedChannel(sdk.WrapSDKContext(ctx), &message)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.False(t, testkeeper.IsAllowedChannel(ctx, "channel-1"))
+}
+
+func TestAddAllowedChannelAlreadyFound(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+	testkeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-1", ChainLabel: "osmosis"})
+
+	message := types.MsgAddAllowedChannel{
+		From:       owner,
+		Channel:    "channel-1",
+		ChainLabel: "osmosis",
+	}
+
+	_, err := server.AddAllowedChannel(sdk.WrapSDKContext(ctx), &message)
+	require.ErrorIs(t, err, types.ErrAllowedChannelAlreadyFound)
+}
+
+func TestAddAllowedChannelInvalidChainLabel(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+
+	message := types.MsgAddAllowedChannel{
+		From:    owner,
+		Channel: "channel-1",
+	}
+
+	_, err := server.AddAllowedChannel(sdk.WrapSDKContext(ctx), &message)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestRemoveAllowedChannelHappyPath(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+	testkeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-1", ChainLabel: "osmosis"})
+
+	message := types.MsgRemoveAllowedChannel{
+		From:    owner,
+		Channel: "channel-1",
+	}
+
+	_, err := server.RemoveAllowedChannel(sdk.WrapSDKContext(ctx), &message)
+	require.Nil(t, err)
+	require.False(t, testkeeper.IsAllowedChannel(ctx, "channel-1"))
+}
+
+func TestRemoveAllowedChannelNotFound(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+
+	message := types.MsgRemoveAllowedChannel{
+		From:    owner,
+		Channel: "channel-1",
+	}
+
+	_, err := server.RemoveAllowedChannel(sdk.WrapSDKContext(ctx), &message)
+	require.ErrorIs(t, err, types.ErrAllowedChannelNotFound)
+}
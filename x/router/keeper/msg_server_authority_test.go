@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/strangelove-ventures/noble/testutil/sample"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+/*
+* Authority sets the owner and clears the pending owner
+* Non authority cannot set the owner
+* Authority adds and removes allowed source domain senders
+* Authority updates params
+* Owner updates params
+* Non authority cannot update params
+* Invalid params are rejected
+ */
+
+func TestSetOwner(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	lostOwner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, lostOwner)
+	testkeeper.SetPendingOwner(ctx, sample.AccAddress())
+
+	newOwner := sample.AccAddress()
+	_, err := server.SetOwner(sdk.WrapSDKContext(ctx), &types.MsgSetOwner{Authority: keepertest.RouterAuthority, NewOwner: newOwner})
+	require.Nil(t, err)
+	require.Equal(t, newOwner, testkeeper.GetOwner(ctx))
+	_, found := testkeeper.GetPendingOwner(ctx)
+	require.False(t, found)
+}
+
+func TestSetOwnerUnauthorized(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+
+	// not even the owner can skip the acceptance of a new owner
+	_, err := server.SetOwner(sdk.WrapSDKContext(ctx), &types.MsgSetOwner{Authority: owner, NewOwner: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.Equal(t, owner, testkeeper.GetOwner(ctx))
+}
+
+func TestAuthorityAllowedSourceDomainSenders(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetOwner(ctx, sample.AccAddress())
+	address := fillByteArray(0, 32)
+
+	_, err := server.AddAllowedSourceDomainSender(sdk.WrapSDKContext(ctx), &types.MsgAddAllowedSourceDomainSender{
+		From:     keepertest.RouterAuthority,
+		DomainId: 0,
+		Address:  address,
+	})
+	require.Nil(t, err)
+	require.True(t, testkeeper.IsAllowedSourceDomainSender(ctx, 0, address))
+
+	_, err = server.RemoveAllowedSourceDomainSender(sdk.WrapSDKContext(ctx), &types.MsgRemoveAllowedSourceDomainSender{
+		From:     keepertest.RouterAuthority,
+		DomainId: 0,
+		Address:  address,
+	})
+	require.Nil(t, err)
+	require.False(t, testkeeper.IsAllowedSourceDomainSender(ctx, 0, address))
+}
+
+func TestUpdateParams(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	params := types.DefaultParams()
+	params.MaxForwardRetries = 10
+
+	_, err := server.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: keepertest.RouterAuthority, Params: params})
+	require.Nil(t, err)
+	require.Equal(t, params, testkeeper.GetParams(ctx))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	msg, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err)
+	event := msg.(*types.ParamsUpdated)
+	require.Equal(t, uint64(types.DefaultMaxForwardRetries), event.PreviousParams.MaxForwardRetries)
+	require.Equal(t, uint64(10), event.NewParams.MaxForwardRetries)
+}
+
+func TestUpdateParamsOwner(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+
+	params := types.DefaultParams()
+	params.MaxForwardRetries = 10
+
+	_, err := server.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: owner, Params: params})
+	require.Nil(t, err)
+	require.Equal(t, params, testkeeper.GetParams(ctx))
+}
+
+func TestUpdateParamsUnauthorized(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetOwner(ctx, sample.AccAddress())
+
+	params := types.DefaultParams()
+	params.MaxForwardRetries = 10
+
+	_, err := server.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: sample.AccAddress(), Params: params})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.Equal(t, types.DefaultParams(), testkeeper.GetParams(ctx))
+}
+
+func TestUpdateParamsInvalid(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	// fees without a fee collector
+	params := types.DefaultParams()
+	params.ChannelFees = []types.ChannelFee{{Channel: "channel-10", Fee: types.Fee{Bps: 10}}}
+
+	_, err := server.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: keepertest.RouterAuthority, Params: params})
+	require.Error(t, err)
+	require.Equal(t, types.DefaultParams(), testkeeper.GetParams(ctx))
+
+	// max timeout below the minimum packet timeout
+	params = types.DefaultParams()
+	params.MaxRelativePacketTimeoutTimestamp = types.MinimumRelativePacketTimeoutTimestamp - 1
+
+	_, err = server.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: keepertest.RouterAuthority, Params: params})
+	require.Error(t, err)
+	require.Equal(t, types.DefaultParams(), testkeeper.GetParams(ctx))
+}
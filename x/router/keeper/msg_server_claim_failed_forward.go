@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+func (m msgServer) ClaimFailedForward(goCtx context.Context, msg *types.MsgClaimFailedForward) (*types.MsgClaimFailedForwardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	forward, found := m.keeper.GetIBCForward(ctx, msg.SourceDomain, msg.Nonce)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrClaimFailedForward, "ibc forward not found")
+	}
+
+	// an unpaired forward can be claimed as well, in case its required submitter never pairs it
+	if !forward.AckError && !forward.Failed && !forward.Unpaired {
+		return nil, sdkerrors.Wrapf(types.ErrClaimFailedForward, "ibc forward has not failed")
+	}
+
+	if forward.Metadata.FallbackRecipient == "" || forward.Metadata.FallbackRecipient != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "this message sender is not the fallback recipient of the ibc forward")
+	}
+
+	mint, found := m.keeper.GetMint(ctx, msg.SourceDomain, msg.Nonce)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrClaimFailedForward, "mint not found")
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	// a fee or relayer tip that was already paid is no longer held by the mint recipient
+	amount := *mint.Amount
+	if forward.Fee != nil {
+		amount = amount.Sub(*forward.Fee)
+	}
+	if forward.RelayerTip != nil {
+		amount = amount.Sub(*forward.RelayerTip)
+	}
+
+	if err := m.keeper.ReleaseMint(ctx, mint, amount, recipient); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrClaimFailedForward, "unable to release mint: %s", err)
+	}
+
+	m.keeper.DeleteMint(ctx, msg.SourceDomain, msg.Nonce)
+	m.keeper.DeleteIBCForward(ctx, msg.SourceDomain, msg.Nonce)
+	m.keeper.SetForwardReceipt(ctx, types.ForwardReceipt{
+		SourceDomain: msg.SourceDomain,
+		Nonce:        msg.Nonce,
+		Status:       types.FORWARD_STATUS_CLAIMED,
+		Height:       uint64(ctx.BlockHeight()),
+	})
+
+	event := types.FailedForwardClaimed{
+		SourceDomain: msg.SourceDomain,
+		Nonce:        msg.Nonce,
+		Recipient:    msg.From,
+		Amount:       amount,
+	}
+	err = ctx.EventManager().EmitTypedEvent(&event)
+
+	return &types.MsgClaimFailedForwardResponse{}, err
+}
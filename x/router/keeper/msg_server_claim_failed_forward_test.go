@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/strangelove-ventures/noble/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+* Happy path
+* Forward not found
+* Forward not failed
+* Sender is not the fallback recipient
+* Mint not found
+ */
+
+func setupFailedForward(ctx sdk.Context, testkeeper *keeper.Keeper, fallback string, failed bool) {
+	forward, _ := setupForward(ctx, testkeeper, 1, 2, 10000)
+	forward.Metadata.FallbackRecipient = fallback
+	forward.AckError = failed
+	testkeeper.SetIBCForward(ctx, forward)
+}
+
+func TestClaimFailedForwardHappyPath(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	fallback := sample.AccAddress()
+	setupFailedForward(ctx, testkeeper, fallback, true)
+
+	message := types.MsgClaimFailedForward{
+		From:         fallback,
+		SourceDomain: 1,
+		Nonce:        2,
+	}
+
+	_, err := server.ClaimFailedForward(sdk.WrapSDKContext(ctx), &message)
+	require.Nil(t, err)
+
+	_, found := testkeeper.GetMint(ctx, 1, 2)
+	require.False(t, found)
+	_, found = testkeeper.GetIBCForward(ctx, 1, 2)
+	require.False(t, found)
+
+	receipt, found := testkeeper.GetForwardReceipt(ctx, 1, 2)
+	require.True(t, found)
+	require.Equal(t, types.FORWARD_STATUS_CLAIMED, receipt.Status)
+}
+
+func TestClaimFailedForwardNotFound(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	message := types.MsgClaimFailedForward{
+		From:         sample.AccAddress(),
+		SourceDomain: 1,
+		Nonce:        2,
+	}
+
+	_, err := server.ClaimFailedForward(sdk.WrapSDKContext(ctx), &message)
+	require.ErrorIs(t, err, types.ErrClaimFailedForward)
+	require.Contains(t, err.Error(), "ibc forward not found")
+}
+
+func TestClaimFailedForwardNotFailed(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	fallback := sample.AccAddress()
+	setupFailedForward(ctx, testkeeper, fallback, false)
+
+	message := types.MsgClaimFailedForward{
+		From:         fallback,
+		SourceDomain: 1,
+		Nonce:        2,
+	}
+
+	_, err := server.ClaimFailedForward(sdk.WrapSDKContext(ctx), &message)
+	require.ErrorIs(t, err, types.ErrClaimFailedForward)
+	require.Contains(t, err.Error(), "ibc forward has not failed")
+
+	_, found := testkeeper.GetMint(ctx, 1, 2)
+	require.True(t, found)
+}
+
+func TestClaimFailedForwardInvalidSender(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	setupFailedForward(ctx, testkeeper, sample.AccAddress(), true)
+
+	message := types.MsgClaimFailedForward{
+		From:         sample.AccAddress(),
+		SourceDomain: 1,
+		Nonce:        2,
+	}
+
+	_, err := server.ClaimFailedForward(sdk.WrapSDKContext(ctx), &message)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.Contains(t, err.Error(), "this message sender is not the fallback recipient of the ibc forward")
+}
+
+func TestClaimFailedForwardNoFallbackRecipient(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	setupFailedForward(ctx, testkeeper, "", true)
+
+	message := types.MsgClaimFailedForward{
+		From:         sample.AccAddress(),
+		SourceDomain: 1,
+		Nonce:        2,
+	}
+
+	_, err := server.ClaimFailedForward(sdk.WrapSDKContext(ctx), &message)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestClaimFailedForwardMintNotFound(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	fallback := sample.AccAddress()
+	forward := createRetryForward(1, 2)
+	forward.Metadata.FallbackRecipient = fallback
+	forward.Failed = true
+	testkeeper.SetIBCForward(ctx, forward)
+
+	message := types.MsgClaimFailedForward{
+		From:         fallback,
+		SourceDomain: 1,
+		Nonce:        2,
+	}
+
+	_, err := server.ClaimFailedForward(sdk.WrapSDKContext(ctx), &message)
+	require.ErrorIs(t, err, types.ErrClaimFailedForward)
+	require.Contains(t, err.Error(), "mint not found")
+}
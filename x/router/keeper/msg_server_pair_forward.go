@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+func (m msgServer) PairForward(goCtx context.Context, msg *types.MsgPairForward) (*types.MsgPairForwardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	submitter, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if err := m.keeper.PairForward(ctx, msg.SourceDomain, msg.Nonce, submitter); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPairForwardResponse{}, nil
+}
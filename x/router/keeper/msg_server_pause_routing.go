@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+func (m msgServer) PauseRouting(goCtx context.Context, msg *types.MsgPauseRouting) (*types.MsgPauseRoutingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !m.isOwnerOrPauser(ctx, msg.From) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "this message sender cannot pause routing")
+	}
+
+	if msg.Global {
+		m.keeper.SetRoutingPausedGlobally(ctx, true)
+	} else {
+		m.keeper.SetSourceDomainPaused(ctx, msg.SourceDomain, true)
+	}
+
+	event := types.RoutingPaused{
+		Global:       msg.Global,
+		SourceDomain: msg.SourceDomain,
+	}
+	err := ctx.EventManager().EmitTypedEvent(&event)
+
+	return &types.MsgPauseRoutingResponse{}, err
+}
+
+// isOwnerOrPauser returns whether an address is allowed to pause and unpause routing.
+func (m msgServer) isOwnerOrPauser(ctx sdk.Context, address string) bool {
+	if pauser := m.keeper.GetPauser(ctx); pauser != "" && pauser == address {
+		return true
+	}
+	return m.keeper.GetOwner(ctx) == address
+}
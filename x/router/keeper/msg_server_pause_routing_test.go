@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/strangelove-ventures/noble/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+* Owner updates pauser
+* Non owner cannot update pauser
+* Pauser pauses and unpauses routing
+* Unauthorized sender cannot pause routing
+* Forward is held while its source domain is paused and released on unpause
+* Forward of another source domain is not held
+ */
+
+// receiveForward handles the metadata and mint messages of an IBC forward from the given source domain.
+func receiveForward(t *testing.T, ctx sdk.Context, routerKeeper *keeper.Keeper, sourceDomain uint32, nonce uint64) {
+	sourceDomainSender := fillByteArray(0, 32)
+	routerKeeper.AddAllowedSourceDomainSender(ctx, sourceDomain, sourceDomainSender)
+
+	err := routerKeeper.HandleMessage(ctx, bytesFromMessage(keeper.Message{
+		Version:           1,
+		SourceDomain:      sourceDomain,
+		DestinationDomain: 3,
+		Nonce:             nonce,
+		Sender:            sourceDomainSender,
+		Recipient:         fillByteArray(32, 32),
+		DestinationCaller: fillByteArray(64, 32),
+		MessageBody:       createMockMetadata(nonce, "channel-10", sdk.Bech32PrefixAccAddr, sample.AccAddress(), "12345"),
+	}))
+	require.Nil(t, err)
+
+	err = routerKeeper.HandleMessage(ctx, bytesFromMessage(keeper.Message{
+		Version:           1,
+		SourceDomain:      sourceDomain,
+		DestinationDomain: 4,
+		Nonce:             nonce,
+		Sender:            sourceDomainSender,
+		Recipient:         fillByteArray(32, 32),
+		DestinationCaller: fillByteArray(64, 32),
+		MessageBody: bytesFromBurnMessage(keeper.BurnMessage{
+			BurnToken:     fillByteArray(0, 32),
+			MintRecipient: fillByteArray(0, 32),
+			Amount:        *big.NewInt(10000),
+			MessageSender: fillByteArray(0, 32),
+		}),
+	}))
+	require.Nil(t, err)
+}
+
+func TestUpdatePauser(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+
+	pauser := sample.AccAddress()
+	_, err := server.UpdatePauser(sdk.WrapSDKContext(ctx), &types.MsgUpdatePauser{From: owner, NewPauser: pauser})
+	require.Nil(t, err)
+	require.Equal(t, pauser, testkeeper.GetPauser(ctx))
+}
+
+func TestUpdatePauserUnauthorized(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetOwner(ctx, sample.AccAddress())
+
+	_, err := server.UpdatePauser(sdk.WrapSDKContext(ctx), &types.MsgUpdatePauser{From: sample.AccAddress(), NewPauser: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.Equal(t, "", testkeeper.GetPauser(ctx))
+}
+
+func TestPauseRoutingUnauthorized(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetOwner(ctx, sample.AccAddress())
+	testkeeper.SetPauser(ctx, sample.AccAddress())
+
+	_, err := server.PauseRouting(sdk.WrapSDKContext(ctx), &types.MsgPauseRouting{From: sample.AccAddress(), Global: true})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.False(t, testkeeper.IsRoutingPausedGlobally(ctx))
+
+	_, err = server.UnpauseRouting(sdk.WrapSDKContext(ctx), &types.MsgUnpauseRouting{From: sample.AccAddress(), Global: true})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestPauseRoutingGlobally(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	pauser := sample.AccAddress()
+	testkeeper.SetOwner(ctx, sample.AccAddress())
+	testkeeper.SetPauser(ctx, pauser)
+
+	_, err := server.PauseRouting(sdk.WrapSDKContext(ctx), &types.MsgPauseRouting{From: pauser, Global: true})
+	require.Nil(t, err)
+	require.True(t, testkeeper.IsRoutingPaused(ctx, 1))
+	require.True(t, testkeeper.IsRoutingPaused(ctx, 2))
+
+	_, err = server.UnpauseRouting(sdk.WrapSDKContext(ctx), &types.MsgUnpauseRouting{From: pauser, Global: true})
+	require.Nil(t, err)
+	require.False(t, testkeeper.IsRoutingPaused(ctx, 1))
+	require.False(t, testkeeper.IsRoutingPaused(ctx, 2))
+}
+
+func TestPauseRoutingHoldsAndReleasesForwards(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	testkeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+
+	_, err := server.PauseRouting(sdk.WrapSDKContext(ctx), &types.MsgPauseRouting{From: owner, SourceDomain: 1})
+	require.Nil(t, err)
+	require.Equal(t, []uint32{1}, testkeeper.GetPausedSourceDomains(ctx))
+
+	receiveForward(t, ctx, testkeeper, 1, 4)
+	receiveForward(t, ctx, testkeeper, 2, 5)
+
+	require.True(t, testkeeper.IsForwardHeld(ctx, 1, 4))
+	_, found := testkeeper.GetInFlightPacketByNonce(ctx, 1, 4)
+	require.False(t, found)
+
+	require.False(t, testkeeper.IsForwardHeld(ctx, 2, 5))
+	_, found = testkeeper.GetInFlightPacketByNonce(ctx, 2, 5)
+	require.True(t, found)
+
+	status, err := keeper.NewQueryServer(testkeeper).ForwardStatus(sdk.WrapSDKContext(ctx), &types.QueryForwardStatusRequest{SourceDomain: 1, Nonce: 4})
+	require.Nil(t, err)
+	require.Equal(t, types.FORWARD_STATUS_HELD, status.Status)
+
+	_, err = server.UnpauseRouting(sdk.WrapSDKContext(ctx), &types.MsgUnpauseRouting{From: owner, SourceDomain: 1})
+	require.Nil(t, err)
+
+	require.Empty(t, testkeeper.GetAllHeldForwards(ctx))
+	_, found = testkeeper.GetInFlightPacketByNonce(ctx, 1, 4)
+	require.True(t, found)
+}
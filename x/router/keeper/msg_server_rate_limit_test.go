@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/strangelove-ventures/noble/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+* Set and remove source domain rate limit
+* Set and remove channel rate limit
+* Invalid authority
+* Remove rate limit not found
+ */
+
+func TestSourceDomainRateLimitHappyPath(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+
+	_, err := server.SetSourceDomainRateLimit(sdk.WrapSDKContext(ctx), &types.MsgSetSourceDomainRateLimit{
+		From:         owner,
+		SourceDomain: 1,
+		WindowBlocks: 100,
+		MaxAmount:    sdk.NewInt(1000000),
+	})
+	require.Nil(t, err)
+
+	rateLimit, found := testkeeper.GetSourceDomainRateLimit(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(100), rateLimit.WindowBlocks)
+	require.Equal(t, sdk.NewInt(1000000), rateLimit.MaxAmount)
+
+	_, err = server.RemoveSourceDomainRateLimit(sdk.WrapSDKContext(ctx), &types.MsgRemoveSourceDomainRateLimit{
+		From:         owner,
+		SourceDomain: 1,
+	})
+	require.Nil(t, err)
+
+	_, found = testkeeper.GetSourceDomainRateLimit(ctx, 1)
+	require.False(t, found)
+}
+
+func TestChannelRateLimitHappyPath(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+
+	_, err := server.SetChannelRateLimit(sdk.WrapSDKContext(ctx), &types.MsgSetChannelRateLimit{
+		From:         owner,
+		Channel:      "channel-1",
+		WindowBlocks: 100,
+		MaxAmount:    sdk.NewInt(1000000),
+	})
+	require.Nil(t, err)
+
+	rateLimit, found := testkeeper.GetChannelRateLimit(ctx, "channel-1")
+	require.True(t, found)
+	require.Equal(t, uint64(100), rateLimit.WindowBlocks)
+
+	_, err = server.RemoveChannelRateLimit(sdk.WrapSDKContext(ctx), &types.MsgRemoveChannelRateLimit{
+		From:    owner,
+		Channel: "channel-1",
+	})
+	require.Nil(t, err)
+
+	_, found = testkeeper.GetChannelRateLimit(ctx, "channel-1")
+	require.False(t, found)
+}
+
+func TestSetRateLimitInvalidAuthority(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetOwner(ctx, sample.AccAddress())
+
+	_, err := server.SetSourceDomainRateLimit(sdk.WrapSDKContext(ctx), &types.MsgSetSourceDomainRateLimit{
+		From:         sample.AccAddress(),
+		SourceDomain: 1,
+		WindowBlocks: 100,
+		MaxAmount:    sdk.NewInt(1000000),
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.SetChannelRateLimit(sdk.WrapSDKContext(ctx), &types.MsgSetChannelRateLimit{
+		From:         sample.AccAddress(),
+		Channel:      "channel-1",
+		WindowBlocks: 100,
+		MaxAmount:    sdk.NewInt(1000000),
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestRemoveRateLimitNotFound(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+
+	_, err := server.RemoveSourceDomainRateLimit(sdk.WrapSDKContext(ctx), &types.MsgRemoveSourceDomainRateLimit{
+		From:         owner,
+		SourceDomain: 1,
+	})
+	require.ErrorIs(t, err, types.ErrRateLimitNotFound)
+
+	_, err = server.RemoveChannelRateLimit(sdk.WrapSDKContext(ctx), &types.MsgRemoveChannelRateLimit{
+		From:    owner,
+		Channel: "channel-1",
+	})
+	require.ErrorIs(t, err, types.ErrRateLimitNotFound)
+}
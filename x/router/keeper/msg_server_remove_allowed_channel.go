@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+func (m msgServer) RemoveAllowedChannel(goCtx context.Context, msg *types.MsgRemoveAllowedChannel) (*types.MsgRemoveAllowedChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner := m.keeper.GetOwner(ctx)
+	if owner != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "this message sender cannot remove allowed channels")
+	}
+
+	if !m.keeper.IsAllowedChannel(ctx, msg.Channel) {
+		return nil, types.ErrAllowedChannelNotFound
+	}
+
+	m.keeper.DeleteAllowedChannel(ctx, msg.Channel)
+
+	event := types.AllowedChannelRemoved{
+		Channel: msg.Channel,
+	}
+	err := ctx.EventManager().EmitTypedEvent(&event)
+
+	return &types.MsgRemoveAllowedChannelResponse{}, err
+}
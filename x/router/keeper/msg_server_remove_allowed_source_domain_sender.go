@@ -11,8 +11,7 @@ import (
 func (m msgServer) RemoveAllowedSourceDomainSender(goCtx context.Context, msg *types.MsgRemoveAllowedSourceDomainSender) (*types.MsgRemoveAllowedSourceDomainSenderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner := m.keeper.GetOwner(ctx)
-	if owner != msg.From {
+	if !m.isOwnerOrAuthority(ctx, msg.From) {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "this message sender cannot remove allowed source domain senders")
 	}
 
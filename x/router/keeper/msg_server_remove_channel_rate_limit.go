@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+func (m msgServer) RemoveChannelRateLimit(goCtx context.Context, msg *types.MsgRemoveChannelRateLimit) (*types.MsgRemoveChannelRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner := m.keeper.GetOwner(ctx)
+	if owner != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "this message sender cannot remove channel rate limits")
+	}
+
+	if _, found := m.keeper.GetChannelRateLimit(ctx, msg.Channel); !found {
+		return nil, types.ErrRateLimitNotFound
+	}
+
+	m.keeper.DeleteChannelRateLimit(ctx, msg.Channel)
+
+	event := types.ChannelRateLimitRemoved{
+		Channel: msg.Channel,
+	}
+	err := ctx.EventManager().EmitTypedEvent(&event)
+
+	return &types.MsgRemoveChannelRateLimitResponse{}, err
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+func (m msgServer) RemoveSourceDomainRateLimit(goCtx context.Context, msg *types.MsgRemoveSourceDomainRateLimit) (*types.MsgRemoveSourceDomainRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner := m.keeper.GetOwner(ctx)
+	if owner != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "this message sender cannot remove source domain rate limits")
+	}
+
+	if _, found := m.keeper.GetSourceDomainRateLimit(ctx, msg.SourceDomain); !found {
+		return nil, types.ErrRateLimitNotFound
+	}
+
+	m.keeper.DeleteSourceDomainRateLimit(ctx, msg.SourceDomain)
+
+	event := types.SourceDomainRateLimitRemoved{
+		SourceDomain: msg.SourceDomain,
+	}
+	err := ctx.EventManager().EmitTypedEvent(&event)
+
+	return &types.MsgRemoveSourceDomainRateLimitResponse{}, err
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+func (m msgServer) SetOwner(goCtx context.Context, msg *types.MsgSetOwner) (*types.MsgSetOwnerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.keeper.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "this message sender cannot set the owner")
+	}
+
+	currentOwner := m.keeper.GetOwner(ctx)
+	m.keeper.SetOwner(ctx, msg.NewOwner)
+	// a pending transfer proposed by the replaced owner can no longer be accepted
+	m.keeper.DeletePendingOwner(ctx)
+
+	event := types.OwnerUpdated{
+		PreviousOwner: currentOwner,
+		NewOwner:      msg.NewOwner,
+	}
+	err := ctx.EventManager().EmitTypedEvent(&event)
+
+	return &types.MsgSetOwnerResponse{}, err
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+func (m msgServer) SetSourceDomainRateLimit(goCtx context.Context, msg *types.MsgSetSourceDomainRateLimit) (*types.MsgSetSourceDomainRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner := m.keeper.GetOwner(ctx)
+	if owner != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "this message sender cannot set source domain rate limits")
+	}
+
+	rateLimit := msg.RateLimit()
+	if err := rateLimit.Validate(); err != nil {
+		return nil, err
+	}
+
+	m.keeper.SetSourceDomainRateLimit(ctx, rateLimit)
+
+	event := types.SourceDomainRateLimitSet{
+		RateLimit: rateLimit,
+	}
+	err := ctx.EventManager().EmitTypedEvent(&event)
+
+	return &types.MsgSetSourceDomainRateLimitResponse{}, err
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+func (m msgServer) UnpauseRouting(goCtx context.Context, msg *types.MsgUnpauseRouting) (*types.MsgUnpauseRoutingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !m.isOwnerOrPauser(ctx, msg.From) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "this message sender cannot unpause routing")
+	}
+
+	if msg.Global {
+		m.keeper.SetRoutingPausedGlobally(ctx, false)
+	} else {
+		m.keeper.SetSourceDomainPaused(ctx, msg.SourceDomain, false)
+	}
+
+	event := types.RoutingUnpaused{
+		Global:       msg.Global,
+		SourceDomain: msg.SourceDomain,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		return nil, err
+	}
+
+	m.keeper.ReleaseHeldForwards(ctx)
+
+	return &types.MsgUnpauseRoutingResponse{}, nil
+}
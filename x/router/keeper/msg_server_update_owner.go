@@ -3,10 +3,9 @@ package keeper
 import (
 	"context"
 
-	"github.com/circlefin/noble-cctp/x/cctp/types"
-
-	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
 )
 
 func (m msgServer) UpdateOwner(goCtx context.Context, msg *types.MsgUpdateOwner) (*types.MsgUpdateOwnerResponse, error) {
@@ -14,10 +13,16 @@ func (m msgServer) UpdateOwner(goCtx context.Context, msg *types.MsgUpdateOwner)
 
 	currentOwner := m.keeper.GetOwner(ctx)
 	if currentOwner != msg.From {
-		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "this message sender cannot update the authority")
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "this message sender cannot update the owner")
 	}
 
 	m.keeper.SetPendingOwner(ctx, msg.NewOwner)
 
-	return &types.MsgUpdateOwnerResponse{}, nil
+	event := types.OwnerUpdateProposed{
+		CurrentOwner: currentOwner,
+		PendingOwner: msg.NewOwner,
+	}
+	err := ctx.EventManager().EmitTypedEvent(&event)
+
+	return &types.MsgUpdateOwnerResponse{}, err
 }
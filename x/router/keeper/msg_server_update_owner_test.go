@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/strangelove-ventures/noble/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+* Owner proposes a new owner, who accepts
+* Non owner cannot propose a new owner
+* Only the pending owner can accept
+* Owner and pending owner queries
+ */
+
+func TestUpdateOwner(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+
+	newOwner := sample.AccAddress()
+	_, err := server.UpdateOwner(sdk.WrapSDKContext(ctx), &types.MsgUpdateOwner{From: owner, NewOwner: newOwner})
+	require.Nil(t, err)
+
+	// ownership only changes once the pending owner accepts
+	require.Equal(t, owner, testkeeper.GetOwner(ctx))
+	pendingOwner, found := testkeeper.GetPendingOwner(ctx)
+	require.True(t, found)
+	require.Equal(t, newOwner, pendingOwner)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, "noble.router.OwnerUpdateProposed", events[len(events)-1].Type)
+
+	_, err = server.AcceptOwner(sdk.WrapSDKContext(ctx), &types.MsgAcceptOwner{From: newOwner})
+	require.Nil(t, err)
+	require.Equal(t, newOwner, testkeeper.GetOwner(ctx))
+	_, found = testkeeper.GetPendingOwner(ctx)
+	require.False(t, found)
+}
+
+func TestUpdateOwnerUnauthorized(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetOwner(ctx, sample.AccAddress())
+
+	_, err := server.UpdateOwner(sdk.WrapSDKContext(ctx), &types.MsgUpdateOwner{From: sample.AccAddress(), NewOwner: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, found := testkeeper.GetPendingOwner(ctx)
+	require.False(t, found)
+}
+
+func TestAcceptOwnerUnauthorized(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+
+	_, err := server.AcceptOwner(sdk.WrapSDKContext(ctx), &types.MsgAcceptOwner{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.UpdateOwner(sdk.WrapSDKContext(ctx), &types.MsgUpdateOwner{From: owner, NewOwner: sample.AccAddress()})
+	require.Nil(t, err)
+
+	_, err = server.AcceptOwner(sdk.WrapSDKContext(ctx), &types.MsgAcceptOwner{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.Equal(t, owner, testkeeper.GetOwner(ctx))
+}
+
+func TestOwnerQueries(t *testing.T) {
+	testkeeper, ctx := keepertest.RouterKeeper(t)
+	q := keeper.NewQueryServer(testkeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+
+	res, err := q.Owner(goCtx, &types.QueryOwnerRequest{})
+	require.NoError(t, err)
+	require.Equal(t, owner, res.Owner)
+
+	_, err = q.PendingOwner(goCtx, &types.QueryPendingOwnerRequest{})
+	require.Error(t, err)
+
+	pendingOwner := sample.AccAddress()
+	testkeeper.SetPendingOwner(ctx, pendingOwner)
+
+	pendingRes, err := q.PendingOwner(goCtx, &types.QueryPendingOwnerRequest{})
+	require.NoError(t, err)
+	require.Equal(t, pendingOwner, pendingRes.PendingOwner)
+
+	_, err = q.Owner(goCtx, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !m.isOwnerOrAuthority(ctx, msg.Authority) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "this message sender cannot update the params")
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid params: %s", err)
+	}
+
+	previousParams := m.keeper.GetParams(ctx)
+	m.keeper.SetParams(ctx, msg.Params)
+
+	event := types.ParamsUpdated{
+		PreviousParams: previousParams,
+		NewParams:      msg.Params,
+	}
+	err := ctx.EventManager().EmitTypedEvent(&event)
+
+	return &types.MsgUpdateParamsResponse{}, err
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+func (m msgServer) UpdatePauser(goCtx context.Context, msg *types.MsgUpdatePauser) (*types.MsgUpdatePauserResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner := m.keeper.GetOwner(ctx)
+	if owner != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "this message sender cannot update the pauser")
+	}
+
+	currentPauser := m.keeper.GetPauser(ctx)
+	m.keeper.SetPauser(ctx, msg.NewPauser)
+
+	event := types.PauserUpdated{
+		PreviousPauser: currentPauser,
+		NewPauser:      msg.NewPauser,
+	}
+	err := ctx.EventManager().EmitTypedEvent(&event)
+
+	return &types.MsgUpdatePauserResponse{}, err
+}
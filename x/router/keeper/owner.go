@@ -1,8 +1,8 @@
 package keeper
 
 import (
-	"github.com/circlefin/noble-cctp/x/cctp/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
 )
 
 // DeletePendingOwner deletes the pending owner of the router module from state.
@@ -14,7 +14,7 @@ func (k *Keeper) DeletePendingOwner(ctx sdk.Context) {
 func (k *Keeper) GetOwner(ctx sdk.Context) (owner string) {
 	bz := ctx.KVStore(k.storeKey).Get(types.OwnerKey)
 	if bz == nil {
-		panic("cctp owner not found in state")
+		panic("router owner not found in state")
 	}
 
 	return string(bz)
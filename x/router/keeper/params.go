@@ -6,13 +6,18 @@ import (
 )
 
 // GetParams get all parameters as types.Params
-func (k *Keeper) GetParams(ctx sdk.Context) types.Params {
-	params := types.Params{}
-	k.paramstore.GetParamSet(ctx, &params)
+func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
 // SetParams set the params
 func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramstore.SetParamSet(ctx, &params)
+	bz := k.cdc.MustMarshal(&params)
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, bz)
 }
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPauser returns the pauser of the router module from state, or an empty string if unset.
+func (k *Keeper) GetPauser(ctx sdk.Context) (pauser string) {
+	return string(ctx.KVStore(k.storeKey).Get(types.PauserKey))
+}
+
+// SetPauser stores the pauser of the router module in state.
+func (k *Keeper) SetPauser(ctx sdk.Context, pauser string) {
+	ctx.KVStore(k.storeKey).Set(types.PauserKey, []byte(pauser))
+}
+
+// IsRoutingPausedGlobally returns whether routing is paused for all source domains.
+func (k *Keeper) IsRoutingPausedGlobally(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.RoutingPausedKey)
+}
+
+// SetRoutingPausedGlobally pauses or unpauses routing for all source domains.
+func (k *Keeper) SetRoutingPausedGlobally(ctx sdk.Context, paused bool) {
+	if paused {
+		ctx.KVStore(k.storeKey).Set(types.RoutingPausedKey, []byte{1})
+	} else {
+		ctx.KVStore(k.storeKey).Delete(types.RoutingPausedKey)
+	}
+}
+
+// IsSourceDomainPaused returns whether routing is paused for a single source domain.
+func (k *Keeper) IsSourceDomainPaused(ctx sdk.Context, sourceDomain uint32) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedSourceDomainPrefix)
+	return store.Has(types.SourceDomainKey(sourceDomain))
+}
+
+// SetSourceDomainPaused pauses or unpauses routing for a single source domain.
+func (k *Keeper) SetSourceDomainPaused(ctx sdk.Context, sourceDomain uint32, paused bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedSourceDomainPrefix)
+	if paused {
+		store.Set(types.SourceDomainKey(sourceDomain), []byte{1})
+	} else {
+		store.Delete(types.SourceDomainKey(sourceDomain))
+	}
+}
+
+// GetPausedSourceDomains returns all source domains routing is paused for.
+func (k *Keeper) GetPausedSourceDomains(ctx sdk.Context) (list []uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedSourceDomainPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, binary.BigEndian.Uint32(iterator.Key()))
+	}
+
+	return
+}
+
+// IsRoutingPaused returns whether routing is paused for a source domain, either globally or for the domain itself.
+func (k *Keeper) IsRoutingPaused(ctx sdk.Context, sourceDomain uint32) bool {
+	return k.IsRoutingPausedGlobally(ctx) || k.IsSourceDomainPaused(ctx, sourceDomain)
+}
+
+// HoldForward queues a matched IBC forward until routing is unpaused for its source domain.
+func (k *Keeper) HoldForward(ctx sdk.Context, sourceDomain uint32, nonce uint64) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HeldForwardPrefix)
+	store.Set(types.LookupKey(sourceDomain, nonce), []byte{})
+
+	return ctx.EventManager().EmitTypedEvent(&types.ForwardHeld{
+		SourceDomain: sourceDomain,
+		Nonce:        nonce,
+	})
+}
+
+// IsForwardHeld returns whether a matched IBC forward is held until routing is unpaused.
+func (k *Keeper) IsForwardHeld(ctx sdk.Context, sourceDomain uint32, nonce uint64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HeldForwardPrefix)
+	return store.Has(types.LookupKey(sourceDomain, nonce))
+}
+
+// GetAllHeldForwards returns all HeldForwards
+func (k *Keeper) GetAllHeldForwards(ctx sdk.Context) (list []types.HeldForward) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HeldForwardPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		sourceDomain, nonce := types.ParseLookupKey(iterator.Key())
+		list = append(list, types.HeldForward{SourceDomain: sourceDomain, Nonce: nonce})
+	}
+
+	return
+}
+
+func (k *Keeper) GetAllHeldForwardsPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]types.HeldForward, *query.PageResponse, error) {
+	var heldForwards []types.HeldForward
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HeldForwardPrefix)
+
+	pageRes, err := query.Paginate(store, pagination, func(key []byte, value []byte) error {
+		sourceDomain, nonce := types.ParseLookupKey(key)
+		heldForwards = append(heldForwards, types.HeldForward{SourceDomain: sourceDomain, Nonce: nonce})
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return heldForwards, pageRes, nil
+}
+
+// ReleaseHeldForwards sends the IBC transfer packets of all held forwards whose source domain is no
+// longer paused. A forward that cannot be sent is marked as failed, its mint stays claimable.
+func (k *Keeper) ReleaseHeldForwards(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HeldForwardPrefix)
+
+	for _, held := range k.GetAllHeldForwards(ctx) {
+		if k.IsRoutingPaused(ctx, held.SourceDomain) {
+			continue
+		}
+
+		store.Delete(types.LookupKey(held.SourceDomain, held.Nonce))
+
+		forward, found := k.GetIBCForward(ctx, held.SourceDomain, held.Nonce)
+		if !found {
+			continue
+		}
+
+		mint, found := k.GetMint(ctx, held.SourceDomain, held.Nonce)
+		if !found {
+			k.Logger(ctx).Error("no existing mint in store for held ibc forward", "source-domain", held.SourceDomain, "nonce", held.Nonce)
+			continue
+		}
+
+		k.sendQueuedForward(ctx, forward, mint)
+	}
+}
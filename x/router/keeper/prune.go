@@ -1,18 +1,73 @@
 package keeper
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"encoding/binary"
 
-func (k *Keeper) Prune(ctx sdk.Context) {
-	mints := k.GetAllMints(ctx)
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
 
-	height := ctx.BlockHeight()
+// Prune deletes mints that have not been matched with an IBC forward within
+// MintPruneBlocks, and forward receipts recorded more than MintPruneBlocks
+// ago. Only the entries recorded before the prune window are visited, by
+// iterating the height indexes up to the cutoff height.
+func (k *Keeper) Prune(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
 	params := k.GetParams(ctx)
 
-	for _, mint := range mints {
-		if uint64(height)-mint.Height > params.MintPruneBlocks {
-			if _, found := k.GetIBCForward(ctx, mint.SourceDomain, mint.Nonce); !found {
-				k.DeleteMint(ctx, mint.SourceDomain, mint.Nonce)
-			}
+	if height <= params.MintPruneBlocks {
+		return
+	}
+
+	// a mint is expired once height - mint.Height > MintPruneBlocks
+	cutoff := make([]byte, 8)
+	binary.BigEndian.PutUint64(cutoff, height-params.MintPruneBlocks)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintByHeightPrefix)
+	iterator := store.Iterator(nil, cutoff)
+
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range expired {
+		sourceDomain, nonce := types.ParseLookupKey(key[8:])
+
+		if _, found := k.GetIBCForward(ctx, sourceDomain, nonce); found {
+			// the mint is owned by a forward, it is dropped from the index so that it is
+			// not visited again and indexed again once the forward is removed
+			store.Delete(key)
+			continue
 		}
+
+		k.DeleteMint(ctx, sourceDomain, nonce)
+		k.SetForwardReceipt(ctx, types.ForwardReceipt{
+			SourceDomain: sourceDomain,
+			Nonce:        nonce,
+			Status:       types.FORWARD_STATUS_PRUNED,
+			Height:       height,
+		})
+	}
+
+	k.pruneForwardReceipts(ctx, cutoff)
+}
+
+// pruneForwardReceipts deletes the forward receipts recorded before the cutoff height.
+func (k *Keeper) pruneForwardReceipts(ctx sdk.Context, cutoff []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ForwardReceiptByHeightPrefix)
+	iterator := store.Iterator(nil, cutoff)
+
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range expired {
+		sourceDomain, nonce := types.ParseLookupKey(key[8:])
+		k.DeleteForwardReceipt(ctx, sourceDomain, nonce)
 	}
 }
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrune(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	pruneBlocks := routerKeeper.GetParams(ctx).MintPruneBlocks
+
+	// expired, no forward -> pruned
+	routerKeeper.SetMint(ctx, types.Mint{SourceDomain: 0, Nonce: 1, Height: 1})
+	// expired, existing forward -> kept
+	routerKeeper.SetMint(ctx, types.Mint{SourceDomain: 0, Nonce: 2, Height: 1})
+	routerKeeper.SetIBCForward(ctx, types.StoreIBCForwardMetadata{
+		SourceDomain: 0,
+		Metadata:     &types.IBCForwardMetadata{Nonce: 2},
+	})
+	// within the prune window -> kept
+	routerKeeper.SetMint(ctx, types.Mint{SourceDomain: 0, Nonce: 3, Height: 2})
+
+	ctx = ctx.WithBlockHeight(int64(pruneBlocks + 2))
+	routerKeeper.Prune(ctx)
+
+	_, found := routerKeeper.GetMint(ctx, 0, 1)
+	require.False(t, found)
+	receipt, found := routerKeeper.GetForwardReceipt(ctx, 0, 1)
+	require.True(t, found)
+	require.Equal(t, types.FORWARD_STATUS_PRUNED, receipt.Status)
+	_, found = routerKeeper.GetMint(ctx, 0, 2)
+	require.True(t, found)
+	_, found = routerKeeper.GetMint(ctx, 0, 3)
+	require.True(t, found)
+
+	// one block later the remaining unforwarded mint expires
+	ctx = ctx.WithBlockHeight(int64(pruneBlocks + 3))
+	routerKeeper.Prune(ctx)
+
+	_, found = routerKeeper.GetMint(ctx, 0, 2)
+	require.True(t, found)
+	_, found = routerKeeper.GetMint(ctx, 0, 3)
+	require.False(t, found)
+}
+
+func TestPruneMintOfRemovedForward(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	pruneBlocks := routerKeeper.GetParams(ctx).MintPruneBlocks
+
+	routerKeeper.SetMint(ctx, types.Mint{SourceDomain: 0, Nonce: 1, Height: 1})
+	routerKeeper.SetIBCForward(ctx, types.StoreIBCForwardMetadata{
+		SourceDomain: 0,
+		Metadata:     &types.IBCForwardMetadata{Nonce: 1},
+	})
+
+	ctx = ctx.WithBlockHeight(int64(pruneBlocks + 2))
+	routerKeeper.Prune(ctx)
+
+	_, found := routerKeeper.GetMint(ctx, 0, 1)
+	require.True(t, found)
+
+	// the mint owned by the forward is no longer visited
+	mint := types.Mint{SourceDomain: 0, Nonce: 1, Height: 1}
+	require.False(t, routerKeeper.HasMintHeightIndex(ctx, mint))
+
+	// the mint is pruned once it is no longer owned by a forward
+	routerKeeper.DeleteIBCForward(ctx, 0, 1)
+	require.True(t, routerKeeper.HasMintHeightIndex(ctx, mint))
+
+	ctx = ctx.WithBlockHeight(int64(pruneBlocks + 3))
+	routerKeeper.Prune(ctx)
+
+	_, found = routerKeeper.GetMint(ctx, 0, 1)
+	require.False(t, found)
+	receipt, found := routerKeeper.GetForwardReceipt(ctx, 0, 1)
+	require.True(t, found)
+	require.Equal(t, types.FORWARD_STATUS_PRUNED, receipt.Status)
+}
+
+func TestPruneMintHeightUpdated(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	pruneBlocks := routerKeeper.GetParams(ctx).MintPruneBlocks
+
+	routerKeeper.SetMint(ctx, types.Mint{SourceDomain: 1, Nonce: 1, Height: 1})
+	// re-recording the mint at a later height moves it in the index
+	routerKeeper.SetMint(ctx, types.Mint{SourceDomain: 1, Nonce: 1, Height: 10})
+
+	ctx = ctx.WithBlockHeight(int64(pruneBlocks + 2))
+	routerKeeper.Prune(ctx)
+
+	_, found := routerKeeper.GetMint(ctx, 1, 1)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(int64(pruneBlocks + 11))
+	routerKeeper.Prune(ctx)
+
+	_, found = routerKeeper.GetMint(ctx, 1, 1)
+	require.False(t, found)
+}
+
+func TestPruneBeforePruneWindow(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+
+	routerKeeper.SetMint(ctx, types.Mint{SourceDomain: 2, Nonce: 1, Height: 0})
+
+	ctx = ctx.WithBlockHeight(1)
+	routerKeeper.Prune(ctx)
+
+	_, found := routerKeeper.GetMint(ctx, 2, 1)
+	require.True(t, found)
+}
+
+func TestPruneForwardReceipts(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	pruneBlocks := routerKeeper.GetParams(ctx).MintPruneBlocks
+
+	routerKeeper.SetForwardReceipt(ctx, types.ForwardReceipt{SourceDomain: 0, Nonce: 1, Status: types.FORWARD_STATUS_COMPLETED, Height: 1})
+	routerKeeper.SetForwardReceipt(ctx, types.ForwardReceipt{SourceDomain: 0, Nonce: 2, Status: types.FORWARD_STATUS_CLAIMED, Height: 2})
+
+	ctx = ctx.WithBlockHeight(int64(pruneBlocks + 2))
+	routerKeeper.Prune(ctx)
+
+	_, found := routerKeeper.GetForwardReceipt(ctx, 0, 1)
+	require.False(t, found)
+	_, found = routerKeeper.GetForwardReceipt(ctx, 0, 2)
+	require.True(t, found)
+
+	// the receipt of a pruned mint is kept for another prune window
+	routerKeeper.SetMint(ctx, types.Mint{SourceDomain: 0, Nonce: 3, Height: 2})
+
+	ctx = ctx.WithBlockHeight(int64(pruneBlocks + 3))
+	routerKeeper.Prune(ctx)
+
+	_, found = routerKeeper.GetForwardReceipt(ctx, 0, 2)
+	require.False(t, found)
+	receipt, found := routerKeeper.GetForwardReceipt(ctx, 0, 3)
+	require.True(t, found)
+	require.Equal(t, types.FORWARD_STATUS_PRUNED, receipt.Status)
+
+	ctx = ctx.WithBlockHeight(int64(2*pruneBlocks + 4))
+	routerKeeper.Prune(ctx)
+
+	_, found = routerKeeper.GetForwardReceipt(ctx, 0, 3)
+	require.False(t, found)
+	require.Empty(t, routerKeeper.GetAllForwardReceipts(ctx))
+}
@@ -0,0 +1,381 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetSourceDomainRateLimit returns the rate limit of a source domain
+func (k *Keeper) GetSourceDomainRateLimit(ctx sdk.Context, sourceDomain uint32) (val types.SourceDomainRateLimit, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SourceDomainRateLimitPrefix)
+
+	b := store.Get(types.SourceDomainKey(sourceDomain))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// SetSourceDomainRateLimit sets the rate limit of a source domain, keeping the usage of its window
+func (k *Keeper) SetSourceDomainRateLimit(ctx sdk.Context, rateLimit types.SourceDomainRateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SourceDomainRateLimitPrefix)
+	b := k.cdc.MustMarshal(&rateLimit)
+	store.Set(types.SourceDomainKey(rateLimit.SourceDomain), b)
+}
+
+// DeleteSourceDomainRateLimit removes the rate limit of a source domain and its usage
+func (k *Keeper) DeleteSourceDomainRateLimit(ctx sdk.Context, sourceDomain uint32) {
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.SourceDomainRateLimitPrefix).Delete(types.SourceDomainKey(sourceDomain))
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.SourceDomainRateLimitUsagePrefix).Delete(types.SourceDomainKey(sourceDomain))
+	k.deleteRateLimitBuckets(ctx, types.SourceDomainRateLimitBucketPrefix, types.SourceDomainKey(sourceDomain))
+}
+
+// GetAllSourceDomainRateLimits returns all source domain rate limits
+func (k *Keeper) GetAllSourceDomainRateLimits(ctx sdk.Context) (list []types.SourceDomainRateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SourceDomainRateLimitPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SourceDomainRateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetSourceDomainRateLimitUsage returns the usage of the sliding window of a source domain rate limit
+func (k *Keeper) GetSourceDomainRateLimitUsage(ctx sdk.Context, sourceDomain uint32) types.RateLimitUsage {
+	rateLimit, _ := k.GetSourceDomainRateLimit(ctx, sourceDomain)
+	return k.getRateLimitUsage(ctx, types.SourceDomainRateLimitUsagePrefix, types.SourceDomainRateLimitBucketPrefix, types.SourceDomainKey(sourceDomain), rateLimit.WindowBlocks)
+}
+
+// GetChannelRateLimit returns the rate limit of a channel
+func (k *Keeper) GetChannelRateLimit(ctx sdk.Context, channel string) (val types.ChannelRateLimit, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelRateLimitPrefix)
+
+	b := store.Get([]byte(channel))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// SetChannelRateLimit sets the rate limit of a channel, keeping the usage of its window
+func (k *Keeper) SetChannelRateLimit(ctx sdk.Context, rateLimit types.ChannelRateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelRateLimitPrefix)
+	b := k.cdc.MustMarshal(&rateLimit)
+	store.Set([]byte(rateLimit.Channel), b)
+}
+
+// DeleteChannelRateLimit removes the rate limit of a channel and its usage
+func (k *Keeper) DeleteChannelRateLimit(ctx sdk.Context, channel string) {
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelRateLimitPrefix).Delete([]byte(channel))
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelRateLimitUsagePrefix).Delete([]byte(channel))
+	k.deleteRateLimitBuckets(ctx, types.ChannelRateLimitBucketPrefix, []byte(channel))
+}
+
+// GetAllChannelRateLimits returns all channel rate limits
+func (k *Keeper) GetAllChannelRateLimits(ctx sdk.Context) (list []types.ChannelRateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelRateLimitPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ChannelRateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetChannelRateLimitUsage returns the usage of the sliding window of a channel rate limit
+func (k *Keeper) GetChannelRateLimitUsage(ctx sdk.Context, channel string) types.RateLimitUsage {
+	rateLimit, _ := k.GetChannelRateLimit(ctx, channel)
+	return k.getRateLimitUsage(ctx, types.ChannelRateLimitUsagePrefix, types.ChannelRateLimitBucketPrefix, []byte(channel), rateLimit.WindowBlocks)
+}
+
+// getRateLimitUsage returns the amount forwarded in the last windowBlocks blocks. The usage is tracked in
+// per-block buckets, and the stored usage is the total of the buckets that are still in the store. The
+// buckets that have left the window are only deleted when usage is added, until then they are subtracted.
+func (k *Keeper) getRateLimitUsage(ctx sdk.Context, usagePrefix []byte, bucketPrefix []byte, key []byte, windowBlocks uint64) types.RateLimitUsage {
+	usage := types.RateLimitUsage{Amount: sdk.ZeroInt()}
+	if b := prefix.NewStore(ctx.KVStore(k.storeKey), usagePrefix).Get(key); b != nil {
+		k.cdc.MustUnmarshal(b, &usage)
+	}
+
+	k.iterateExpiredRateLimitBuckets(ctx, bucketPrefix, key, windowBlocks, func(_ []byte, bucket types.RateLimitUsage) {
+		usage.Amount = usage.Amount.Sub(bucket.Amount)
+	})
+
+	return usage
+}
+
+// addRateLimitUsage adds a forwarded amount to the bucket of the current block, and deletes the buckets
+// that have left the window.
+func (k *Keeper) addRateLimitUsage(ctx sdk.Context, usagePrefix []byte, bucketPrefix []byte, key []byte, windowBlocks uint64, amount sdk.Int) {
+	usage := k.getRateLimitUsage(ctx, usagePrefix, bucketPrefix, key, windowBlocks)
+	usage.Amount = usage.Amount.Add(amount)
+
+	bucketStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitBucketsPrefix(bucketPrefix, key))
+
+	var expired [][]byte
+	k.iterateExpiredRateLimitBuckets(ctx, bucketPrefix, key, windowBlocks, func(bucketKey []byte, _ types.RateLimitUsage) {
+		expired = append(expired, bucketKey)
+	})
+	for _, bucketKey := range expired {
+		bucketStore.Delete(bucketKey)
+	}
+
+	bucket := types.RateLimitUsage{Amount: sdk.ZeroInt()}
+	bucketKey := types.RateLimitBucketKey(uint64(ctx.BlockHeight()))
+	if b := bucketStore.Get(bucketKey); b != nil {
+		k.cdc.MustUnmarshal(b, &bucket)
+	}
+	bucket.Amount = bucket.Amount.Add(amount)
+	bucketStore.Set(bucketKey, k.cdc.MustMarshal(&bucket))
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), usagePrefix)
+	store.Set(key, k.cdc.MustMarshal(&usage))
+}
+
+// iterateExpiredRateLimitBuckets calls cb with the buckets of a rate limit whose block is no longer in
+// the window of the last windowBlocks blocks.
+func (k *Keeper) iterateExpiredRateLimitBuckets(ctx sdk.Context, bucketPrefix []byte, key []byte, windowBlocks uint64, cb func(bucketKey []byte, bucket types.RateLimitUsage)) {
+	height := uint64(ctx.BlockHeight())
+	if height < windowBlocks {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitBucketsPrefix(bucketPrefix, key))
+	iterator := store.Iterator(nil, types.RateLimitBucketKey(height-windowBlocks+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bucket types.RateLimitUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &bucket)
+		cb(iterator.Key(), bucket)
+	}
+}
+
+// deleteRateLimitBuckets deletes all buckets of a rate limit.
+func (k *Keeper) deleteRateLimitBuckets(ctx sdk.Context, bucketPrefix []byte, key []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitBucketsPrefix(bucketPrefix, key))
+	iterator := store.Iterator(nil, nil)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, bucketKey := range keys {
+		store.Delete(bucketKey)
+	}
+}
+
+// HasRateLimitCapacity returns whether an amount can be forwarded from a source domain over a channel
+// without exceeding the sliding window of either rate limit. Unset rate limits never block a forward.
+func (k *Keeper) HasRateLimitCapacity(ctx sdk.Context, sourceDomain uint32, channel string, amount sdk.Int) bool {
+	if rateLimit, found := k.GetSourceDomainRateLimit(ctx, sourceDomain); found {
+		usage := k.GetSourceDomainRateLimitUsage(ctx, sourceDomain)
+		if usage.Amount.Add(amount).GT(rateLimit.MaxAmount) {
+			return false
+		}
+	}
+
+	if rateLimit, found := k.GetChannelRateLimit(ctx, channel); found {
+		usage := k.GetChannelRateLimitUsage(ctx, channel)
+		if usage.Amount.Add(amount).GT(rateLimit.MaxAmount) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// consumeRateLimits records a forwarded amount in the window of the source domain and channel rate limits.
+func (k *Keeper) consumeRateLimits(ctx sdk.Context, sourceDomain uint32, channel string, amount sdk.Int) {
+	if rateLimit, found := k.GetSourceDomainRateLimit(ctx, sourceDomain); found {
+		k.addRateLimitUsage(ctx, types.SourceDomainRateLimitUsagePrefix, types.SourceDomainRateLimitBucketPrefix, types.SourceDomainKey(sourceDomain), rateLimit.WindowBlocks, amount)
+	}
+
+	if rateLimit, found := k.GetChannelRateLimit(ctx, channel); found {
+		k.addRateLimitUsage(ctx, types.ChannelRateLimitUsagePrefix, types.ChannelRateLimitBucketPrefix, []byte(channel), rateLimit.WindowBlocks, amount)
+	}
+}
+
+// exceedsRateLimits returns a reason when an amount is above the max amount of the source domain or
+// channel rate limit, in which case it can never be forwarded.
+func (k *Keeper) exceedsRateLimits(ctx sdk.Context, sourceDomain uint32, channel string, amount sdk.Int) (string, bool) {
+	if rateLimit, found := k.GetSourceDomainRateLimit(ctx, sourceDomain); found && amount.GT(rateLimit.MaxAmount) {
+		return fmt.Sprintf("amount %s exceeds the rate limit of source domain %d of %s", amount, sourceDomain, rateLimit.MaxAmount), true
+	}
+
+	if rateLimit, found := k.GetChannelRateLimit(ctx, channel); found && amount.GT(rateLimit.MaxAmount) {
+		return fmt.Sprintf("amount %s exceeds the rate limit of channel %s of %s", amount, channel, rateLimit.MaxAmount), true
+	}
+
+	return "", false
+}
+
+// RateLimitForward queues a matched IBC forward until its rate limits have capacity for it. A forward
+// above the max amount of a rate limit would never leave the queue, it is failed instead.
+func (k *Keeper) RateLimitForward(ctx sdk.Context, ibcForward *types.IBCForwardMetadata, mint types.Mint) error {
+	if reason, exceeds := k.exceedsRateLimits(ctx, mint.SourceDomain, ibcForward.Channel, mint.Amount.Amount); exceeds {
+		return k.failForwardOfMint(ctx, mint, reason)
+	}
+
+	k.SetRateLimitedForward(ctx, mint.SourceDomain, mint.Nonce)
+
+	return ctx.EventManager().EmitTypedEvent(&types.ForwardRateLimited{
+		SourceDomain: mint.SourceDomain,
+		Nonce:        mint.Nonce,
+		Channel:      ibcForward.Channel,
+		Amount:       *mint.Amount,
+	})
+}
+
+// SetRateLimitedForward adds a matched IBC forward to the rate limited queue
+func (k *Keeper) SetRateLimitedForward(ctx sdk.Context, sourceDomain uint32, nonce uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitedForwardPrefix)
+	store.Set(types.LookupKey(sourceDomain, nonce), []byte{})
+}
+
+// IsForwardRateLimited returns whether a matched IBC forward is queued until its rate limits have capacity for it.
+func (k *Keeper) IsForwardRateLimited(ctx sdk.Context, sourceDomain uint32, nonce uint64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitedForwardPrefix)
+	return store.Has(types.LookupKey(sourceDomain, nonce))
+}
+
+// GetAllRateLimitedForwards returns all RateLimitedForwards
+func (k *Keeper) GetAllRateLimitedForwards(ctx sdk.Context) (list []types.RateLimitedForward) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitedForwardPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		sourceDomain, nonce := types.ParseLookupKey(iterator.Key())
+		list = append(list, types.RateLimitedForward{SourceDomain: sourceDomain, Nonce: nonce})
+	}
+
+	return
+}
+
+func (k *Keeper) GetAllRateLimitedForwardsPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]types.RateLimitedForward, *query.PageResponse, error) {
+	var rateLimitedForwards []types.RateLimitedForward
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitedForwardPrefix)
+
+	pageRes, err := query.Paginate(store, pagination, func(key []byte, value []byte) error {
+		sourceDomain, nonce := types.ParseLookupKey(key)
+		rateLimitedForwards = append(rateLimitedForwards, types.RateLimitedForward{SourceDomain: sourceDomain, Nonce: nonce})
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rateLimitedForwards, pageRes, nil
+}
+
+// MaxRateLimitedForwardsPerBlock caps the queued forwards that ProcessRateLimitedForwards looks at in a block.
+const MaxRateLimitedForwardsPerBlock = 100
+
+// ProcessRateLimitedForwards sends the IBC transfer packets of queued forwards as soon as their rate
+// limits have capacity for them. Forwards of paused source domains stay queued, and forwards above the
+// max amount of a rate limit that was lowered since they were queued are failed.
+//
+// At most MaxRateLimitedForwardsPerBlock forwards are looked at per block. The queue is walked from where
+// the previous block stopped, so that forwards which cannot be sent yet do not hold back the ones behind them.
+func (k *Keeper) ProcessRateLimitedForwards(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitedForwardPrefix)
+
+	queue := k.nextRateLimitedForwards(ctx, MaxRateLimitedForwardsPerBlock)
+	if len(queue) == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.RateLimitedForwardCursorKey)
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.RateLimitedForwardCursorKey, types.LookupKey(queue[len(queue)-1].SourceDomain, queue[len(queue)-1].Nonce))
+
+	for _, queued := range queue {
+		if k.IsRoutingPaused(ctx, queued.SourceDomain) {
+			continue
+		}
+
+		forward, found := k.GetIBCForward(ctx, queued.SourceDomain, queued.Nonce)
+		if !found {
+			store.Delete(types.LookupKey(queued.SourceDomain, queued.Nonce))
+			continue
+		}
+
+		mint, found := k.GetMint(ctx, queued.SourceDomain, queued.Nonce)
+		if !found {
+			k.Logger(ctx).Error("no existing mint in store for rate limited ibc forward", "source-domain", queued.SourceDomain, "nonce", queued.Nonce)
+			store.Delete(types.LookupKey(queued.SourceDomain, queued.Nonce))
+			continue
+		}
+
+		if reason, exceeds := k.exceedsRateLimits(ctx, queued.SourceDomain, forward.Metadata.Channel, mint.Amount.Amount); exceeds {
+			store.Delete(types.LookupKey(queued.SourceDomain, queued.Nonce))
+			if err := k.failForward(ctx, forward, reason); err != nil {
+				k.Logger(ctx).Error("error emitting forward failed event", "error", err)
+			}
+			continue
+		}
+
+		if !k.HasRateLimitCapacity(ctx, queued.SourceDomain, forward.Metadata.Channel, mint.Amount.Amount) {
+			continue
+		}
+
+		store.Delete(types.LookupKey(queued.SourceDomain, queued.Nonce))
+		k.sendQueuedForward(ctx, forward, mint)
+	}
+}
+
+// nextRateLimitedForwards returns up to limit queued forwards, starting after the forward the previous
+// block stopped at and wrapping around to the start of the queue.
+func (k *Keeper) nextRateLimitedForwards(ctx sdk.Context, limit int) (list []types.RateLimitedForward) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitedForwardPrefix)
+
+	var after, upTo []byte
+	if cursor := ctx.KVStore(k.storeKey).Get(types.RateLimitedForwardCursorKey); cursor != nil {
+		// the key right after the cursor, the end of the wrapped around range is exclusive
+		after = append(append([]byte{}, cursor...), 0)
+		upTo = after
+	}
+
+	for _, iterator := range []func() sdk.Iterator{
+		func() sdk.Iterator { return store.Iterator(after, nil) },
+		func() sdk.Iterator { return store.Iterator(nil, upTo) },
+	} {
+		it := iterator()
+		for ; it.Valid() && len(list) < limit; it.Next() {
+			sourceDomain, nonce := types.ParseLookupKey(it.Key())
+			list = append(list, types.RateLimitedForward{SourceDomain: sourceDomain, Nonce: nonce})
+		}
+		it.Close()
+
+		if after == nil {
+			break
+		}
+	}
+
+	return
+}
@@ -0,0 +1,224 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceDomainRateLimitQueuesForwards(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+	routerKeeper.SetSourceDomainRateLimit(ctx, types.SourceDomainRateLimit{
+		SourceDomain: 1,
+		WindowBlocks: 10,
+		MaxAmount:    sdk.NewInt(15000),
+	})
+
+	forward, mint := setupForward(ctx, routerKeeper, 1, 1, 10000)
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, forward.Metadata, mint))
+	require.False(t, routerKeeper.IsForwardRateLimited(ctx, 1, 1))
+	require.Equal(t, sdk.NewInt(10000), routerKeeper.GetSourceDomainRateLimitUsage(ctx, 1).Amount)
+
+	forward, mint = setupForward(ctx, routerKeeper, 1, 2, 10000)
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, forward.Metadata, mint))
+	require.True(t, routerKeeper.IsForwardRateLimited(ctx, 1, 2))
+	require.Equal(t, sdk.NewInt(10000), routerKeeper.GetSourceDomainRateLimitUsage(ctx, 1).Amount)
+
+	// other source domains are not limited
+	forward, mint = setupForward(ctx, routerKeeper, 2, 3, 10000)
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, forward.Metadata, mint))
+	require.False(t, routerKeeper.IsForwardRateLimited(ctx, 2, 3))
+
+	status, err := keeper.NewQueryServer(routerKeeper).ForwardStatus(sdk.WrapSDKContext(ctx), &types.QueryForwardStatusRequest{SourceDomain: 1, Nonce: 2})
+	require.NoError(t, err)
+	require.Equal(t, types.FORWARD_STATUS_RATE_LIMITED, status.Status)
+
+	// the window has not passed yet
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 9)
+	routerKeeper.ProcessRateLimitedForwards(ctx)
+	require.True(t, routerKeeper.IsForwardRateLimited(ctx, 1, 2))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	routerKeeper.ProcessRateLimitedForwards(ctx)
+	require.False(t, routerKeeper.IsForwardRateLimited(ctx, 1, 2))
+
+	// the first forward has left the window
+	require.Equal(t, sdk.NewInt(10000), routerKeeper.GetSourceDomainRateLimitUsage(ctx, 1).Amount)
+}
+
+func TestRateLimitSlidingWindow(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+	routerKeeper.SetSourceDomainRateLimit(ctx, types.SourceDomainRateLimit{
+		SourceDomain: 1,
+		WindowBlocks: 10,
+		MaxAmount:    sdk.NewInt(20000),
+	})
+
+	forward, mint := setupForward(ctx, routerKeeper, 1, 1, 10000)
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, forward.Metadata, mint))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	forward, mint = setupForward(ctx, routerKeeper, 1, 2, 10000)
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, forward.Metadata, mint))
+	require.Equal(t, sdk.NewInt(20000), routerKeeper.GetSourceDomainRateLimitUsage(ctx, 1).Amount)
+
+	// only the first forward has left the window, the usage does not reset at once
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	require.Equal(t, sdk.NewInt(10000), routerKeeper.GetSourceDomainRateLimitUsage(ctx, 1).Amount)
+
+	forward, mint = setupForward(ctx, routerKeeper, 1, 3, 10000)
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, forward.Metadata, mint))
+	require.False(t, routerKeeper.IsForwardRateLimited(ctx, 1, 3))
+
+	forward, mint = setupForward(ctx, routerKeeper, 1, 4, 10000)
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, forward.Metadata, mint))
+	require.True(t, routerKeeper.IsForwardRateLimited(ctx, 1, 4))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	require.Equal(t, sdk.NewInt(10000), routerKeeper.GetSourceDomainRateLimitUsage(ctx, 1).Amount)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	require.True(t, routerKeeper.GetSourceDomainRateLimitUsage(ctx, 1).Amount.IsZero())
+
+	// deleting the rate limit deletes its buckets
+	routerKeeper.DeleteSourceDomainRateLimit(ctx, 1)
+	routerKeeper.SetSourceDomainRateLimit(ctx, types.SourceDomainRateLimit{SourceDomain: 1, WindowBlocks: 100, MaxAmount: sdk.NewInt(20000)})
+	require.True(t, routerKeeper.GetSourceDomainRateLimitUsage(ctx, 1).Amount.IsZero())
+}
+
+func TestRateLimitFailsForwardAboveMaxAmount(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+	routerKeeper.SetChannelRateLimit(ctx, types.ChannelRateLimit{
+		Channel:      "channel-10",
+		WindowBlocks: 10,
+		MaxAmount:    sdk.NewInt(5000),
+	})
+
+	// the forward would never leave the queue
+	forward, mint := setupForward(ctx, routerKeeper, 1, 1, 10000)
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, forward.Metadata, mint))
+	require.False(t, routerKeeper.IsForwardRateLimited(ctx, 1, 1))
+
+	forward, found := routerKeeper.GetIBCForward(ctx, 1, 1)
+	require.True(t, found)
+	require.True(t, forward.Failed)
+}
+
+func TestRateLimitFailsQueuedForwardAboveLoweredMaxAmount(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+	routerKeeper.SetSourceDomainRateLimit(ctx, types.SourceDomainRateLimit{
+		SourceDomain: 1,
+		WindowBlocks: 10,
+		MaxAmount:    sdk.NewInt(10000),
+	})
+
+	forward, mint := setupForward(ctx, routerKeeper, 1, 1, 10000)
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, forward.Metadata, mint))
+	forward, mint = setupForward(ctx, routerKeeper, 1, 2, 10000)
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, forward.Metadata, mint))
+	require.True(t, routerKeeper.IsForwardRateLimited(ctx, 1, 2))
+
+	routerKeeper.SetSourceDomainRateLimit(ctx, types.SourceDomainRateLimit{
+		SourceDomain: 1,
+		WindowBlocks: 10,
+		MaxAmount:    sdk.NewInt(5000),
+	})
+	routerKeeper.ProcessRateLimitedForwards(ctx)
+	require.False(t, routerKeeper.IsForwardRateLimited(ctx, 1, 2))
+
+	forward, found := routerKeeper.GetIBCForward(ctx, 1, 2)
+	require.True(t, found)
+	require.True(t, forward.Failed)
+}
+
+func TestProcessRateLimitedForwardsIsCapped(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+	routerKeeper.SetSourceDomainRateLimit(ctx, types.SourceDomainRateLimit{
+		SourceDomain: 1,
+		WindowBlocks: 10,
+		MaxAmount:    sdk.NewInt(10000),
+	})
+
+	queued := keeper.MaxRateLimitedForwardsPerBlock + 10
+	for nonce := uint64(0); nonce <= uint64(queued); nonce++ {
+		forward, mint := setupForward(ctx, routerKeeper, 1, nonce, 10000)
+		require.NoError(t, routerKeeper.ForwardPacket(ctx, forward.Metadata, mint))
+	}
+	require.Len(t, routerKeeper.GetAllRateLimitedForwards(ctx), queued)
+
+	// the forwards at the start of the queue cannot be sent yet, they do not hold back the rest of the queue
+	routerKeeper.ProcessRateLimitedForwards(ctx)
+	require.Len(t, routerKeeper.GetAllRateLimitedForwards(ctx), queued)
+
+	routerKeeper.SetSourceDomainRateLimit(ctx, types.SourceDomainRateLimit{
+		SourceDomain: 1,
+		WindowBlocks: 10,
+		MaxAmount:    sdk.NewInt(10000 * int64(queued+1)),
+	})
+	routerKeeper.ProcessRateLimitedForwards(ctx)
+	require.Len(t, routerKeeper.GetAllRateLimitedForwards(ctx), queued-keeper.MaxRateLimitedForwardsPerBlock)
+	require.False(t, routerKeeper.IsForwardRateLimited(ctx, 1, uint64(queued)))
+
+	routerKeeper.ProcessRateLimitedForwards(ctx)
+	require.Empty(t, routerKeeper.GetAllRateLimitedForwards(ctx))
+}
+
+func TestChannelRateLimitQueuesForwards(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+	routerKeeper.SetChannelRateLimit(ctx, types.ChannelRateLimit{
+		Channel:      "channel-10",
+		WindowBlocks: 5,
+		MaxAmount:    sdk.NewInt(10000),
+	})
+
+	forward, mint := setupForward(ctx, routerKeeper, 1, 1, 10000)
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, forward.Metadata, mint))
+	require.False(t, routerKeeper.IsForwardRateLimited(ctx, 1, 1))
+
+	// the channel limit applies across source domains
+	forward, mint = setupForward(ctx, routerKeeper, 2, 2, 10000)
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, forward.Metadata, mint))
+	require.True(t, routerKeeper.IsForwardRateLimited(ctx, 2, 2))
+	require.Len(t, routerKeeper.GetAllRateLimitedForwards(ctx), 1)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	routerKeeper.ProcessRateLimitedForwards(ctx)
+	require.Empty(t, routerKeeper.GetAllRateLimitedForwards(ctx))
+	require.Equal(t, sdk.NewInt(10000), routerKeeper.GetChannelRateLimitUsage(ctx, "channel-10").Amount)
+}
+
+func TestRateLimitedForwardStaysQueuedWhilePaused(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+	routerKeeper.SetSourceDomainRateLimit(ctx, types.SourceDomainRateLimit{
+		SourceDomain: 1,
+		WindowBlocks: 1,
+		MaxAmount:    sdk.NewInt(10000),
+	})
+
+	forward, mint := setupForward(ctx, routerKeeper, 1, 0, 10000)
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, forward.Metadata, mint))
+	forward, mint = setupForward(ctx, routerKeeper, 1, 1, 10000)
+	require.NoError(t, routerKeeper.ForwardPacket(ctx, forward.Metadata, mint))
+	require.True(t, routerKeeper.IsForwardRateLimited(ctx, 1, 1))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	routerKeeper.SetSourceDomainPaused(ctx, 1, true)
+
+	routerKeeper.ProcessRateLimitedForwards(ctx)
+	require.True(t, routerKeeper.IsForwardRateLimited(ctx, 1, 1))
+
+	routerKeeper.SetSourceDomainPaused(ctx, 1, false)
+	routerKeeper.ProcessRateLimitedForwards(ctx)
+	require.False(t, routerKeeper.IsForwardRateLimited(ctx, 1, 1))
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/strangelove-ventures/noble/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+* Tip paid to the relayer completing the pairing
+* Tip not paid without a known relayer
+* Amount below the tip
+ */
+
+// receiveTippedForward handles a v2 forward with a relayer tip followed by its mint of 10000uusdc.
+func receiveTippedForward(t *testing.T, ctx sdk.Context, routerKeeper *keeper.Keeper, tip uint64) {
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+
+	sourceDomainSender := fillByteArray(0, 32)
+	routerKeeper.AddAllowedSourceDomainSender(ctx, 0, sourceDomainSender)
+
+	metadata := types.IBCForwardMetadata{
+		Nonce:               1,
+		Channel:             "channel-10",
+		DestinationReceiver: sample.AccAddress(),
+		RelayerTip:          tip,
+	}
+	metadataBz, err := metadata.Bytes(types.IBCForwardMetadataV2, sdk.Bech32PrefixAccAddr)
+	require.NoError(t, err)
+
+	require.NoError(t, routerKeeper.HandleMessage(ctx, bytesFromMessage(keeper.Message{
+		Version:           1,
+		SourceDomain:      0,
+		DestinationDomain: 3,
+		Nonce:             1,
+		Sender:            sourceDomainSender,
+		Recipient:         fillByteArray(32, 32),
+		DestinationCaller: fillByteArray(64, 32),
+		MessageBody:       metadataBz,
+	})))
+
+	require.NoError(t, routerKeeper.HandleMessage(ctx, bytesFromMessage(keeper.Message{
+		Version:           1,
+		SourceDomain:      0,
+		DestinationDomain: 4,
+		Nonce:             1,
+		Sender:            sourceDomainSender,
+		Recipient:         fillByteArray(32, 32),
+		DestinationCaller: fillByteArray(64, 32),
+		MessageBody: bytesFromBurnMessage(keeper.BurnMessage{
+			BurnToken:     fillByteArray(0, 32),
+			MintRecipient: fillByteArray(0, 32),
+			Amount:        *big.NewInt(10000),
+			MessageSender: fillByteArray(0, 32),
+		}),
+	})))
+}
+
+func TestRelayerTipPaid(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	relayer := sdk.AccAddress(fillByteArray(96, 20))
+	ctx = types.ContextWithRelayer(ctx, relayer)
+
+	receiveTippedForward(t, ctx, routerKeeper, 100)
+
+	forward, found := routerKeeper.GetIBCForward(ctx, 0, 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 100), *forward.RelayerTip)
+
+	_, found = routerKeeper.GetInFlightPacketByNonce(ctx, 0, 1)
+	require.True(t, found)
+
+	event := forwardPacketSent(t, ctx)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 9900), event.Amount)
+}
+
+func TestRelayerTipWithoutRelayer(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+
+	receiveTippedForward(t, ctx, routerKeeper, 100)
+
+	forward, found := routerKeeper.GetIBCForward(ctx, 0, 1)
+	require.True(t, found)
+	require.Nil(t, forward.RelayerTip)
+
+	event := forwardPacketSent(t, ctx)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 10000), event.Amount)
+}
+
+func TestRelayerTipAboveAmount(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	ctx = types.ContextWithRelayer(ctx, sdk.AccAddress(fillByteArray(96, 20)))
+
+	receiveTippedForward(t, ctx, routerKeeper, 10000)
+
+	forward, found := routerKeeper.GetIBCForward(ctx, 0, 1)
+	require.True(t, found)
+	require.True(t, forward.Failed)
+	require.Nil(t, forward.RelayerTip)
+
+	_, found = routerKeeper.GetInFlightPacketByNonce(ctx, 0, 1)
+	require.False(t, found)
+}
@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/strangelove-ventures/noble/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+* Recipient and destination caller recorded
+* Pairing by the required submitter
+* Pairing by another submitter, then by the required submitter
+* Claiming an unpaired forward
+* Pairing without a known submitter
+ */
+
+func restrictedForwardMessage(t *testing.T, requiredSubmitter string) []byte {
+	return restrictedForwardMessageWithFallback(t, requiredSubmitter, "")
+}
+
+func restrictedForwardMessageWithFallback(t *testing.T, requiredSubmitter string, fallbackRecipient string) []byte {
+	metadata := types.IBCForwardMetadata{
+		Nonce:               1,
+		Channel:             "channel-10",
+		DestinationReceiver: sample.AccAddress(),
+		RequiredSubmitter:   requiredSubmitter,
+		FallbackRecipient:   fallbackRecipient,
+	}
+	metadataBz, err := metadata.Bytes(types.IBCForwardMetadataV4, sdk.Bech32PrefixAccAddr)
+	require.NoError(t, err)
+
+	return bytesFromMessage(keeper.Message{
+		Version:           1,
+		SourceDomain:      0,
+		DestinationDomain: 3,
+		Nonce:             1,
+		Sender:            fillByteArray(0, 32),
+		Recipient:         fillByteArray(32, 32),
+		DestinationCaller: fillByteArray(64, 32),
+		MessageBody:       metadataBz,
+	})
+}
+
+func restrictedMintMessage() []byte {
+	return bytesFromMessage(keeper.Message{
+		Version:           1,
+		SourceDomain:      0,
+		DestinationDomain: 4,
+		Nonce:             1,
+		Sender:            fillByteArray(0, 32),
+		Recipient:         fillByteArray(32, 32),
+		DestinationCaller: make([]byte, 32),
+		MessageBody: bytesFromBurnMessage(keeper.BurnMessage{
+			BurnToken:     fillByteArray(0, 32),
+			MintRecipient: fillByteArray(0, 32),
+			Amount:        *big.NewInt(10000),
+			MessageSender: fillByteArray(0, 32),
+		}),
+	})
+}
+
+func setupRestrictedForward(ctx sdk.Context, routerKeeper *keeper.Keeper) {
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+	routerKeeper.AddAllowedSourceDomainSender(ctx, 0, fillByteArray(0, 32))
+}
+
+func TestHandleMessageRecordsRecipientAndDestinationCaller(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	setupRestrictedForward(ctx, routerKeeper)
+
+	require.NoError(t, routerKeeper.HandleMessage(ctx, restrictedForwardMessage(t, "")))
+
+	forward, found := routerKeeper.GetIBCForward(ctx, 0, 1)
+	require.True(t, found)
+	require.Equal(t, fillByteArray(32, 32), forward.Recipient)
+	require.Equal(t, fillByteArray(64, 32), forward.DestinationCaller)
+
+	require.NoError(t, routerKeeper.HandleMessage(ctx, restrictedMintMessage()))
+
+	mint, found := routerKeeper.GetMint(ctx, 0, 1)
+	require.True(t, found)
+	require.Equal(t, fillByteArray(32, 32), mint.Recipient)
+	// a zero destination caller means that any address can receive the message
+	require.Nil(t, mint.DestinationCaller)
+
+	res, err := keeper.NewQueryServer(routerKeeper).Mint(sdk.WrapSDKContext(ctx), &types.QueryGetMintRequest{SourceDomain: 0, Nonce: 1})
+	require.NoError(t, err)
+	require.Equal(t, fillByteArray(32, 32), res.Mint.Recipient)
+}
+
+func TestRequiredSubmitterPairsForward(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	setupRestrictedForward(ctx, routerKeeper)
+
+	submitter := sdk.AccAddress(fillByteArray(96, 20))
+	require.NoError(t, routerKeeper.HandleMessage(ctx, restrictedForwardMessage(t, submitter.String())))
+
+	ctx = types.ContextWithRelayer(ctx, submitter)
+	require.NoError(t, routerKeeper.HandleMessage(ctx, restrictedMintMessage()))
+
+	_, found := routerKeeper.GetInFlightPacketByNonce(ctx, 0, 1)
+	require.True(t, found)
+}
+
+func TestRequiredSubmitterPairsUnpairedForward(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	setupRestrictedForward(ctx, routerKeeper)
+	server := keeper.NewMsgServerImpl(routerKeeper)
+
+	submitter := sdk.AccAddress(fillByteArray(96, 20))
+	require.NoError(t, routerKeeper.HandleMessage(ctx, restrictedForwardMessage(t, submitter.String())))
+
+	// the mint is received from another submitter, without reverting it
+	ctx = types.ContextWithRelayer(ctx, sdk.AccAddress(fillByteArray(128, 20)))
+	require.NoError(t, routerKeeper.HandleMessage(ctx, restrictedMintMessage()))
+
+	forward, found := routerKeeper.GetIBCForward(ctx, 0, 1)
+	require.True(t, found)
+	require.True(t, forward.Unpaired)
+
+	_, found = routerKeeper.GetInFlightPacketByNonce(ctx, 0, 1)
+	require.False(t, found)
+
+	res, err := keeper.NewQueryServer(routerKeeper).ForwardStatus(sdk.WrapSDKContext(ctx), &types.QueryForwardStatusRequest{SourceDomain: 0, Nonce: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.FORWARD_STATUS_UNPAIRED, res.Status)
+
+	// only the required submitter can pair the forward
+	_, err = server.PairForward(sdk.WrapSDKContext(ctx), &types.MsgPairForward{From: sample.AccAddress(), SourceDomain: 0, Nonce: 1})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.PairForward(sdk.WrapSDKContext(ctx), &types.MsgPairForward{From: submitter.String(), SourceDomain: 0, Nonce: 1})
+	require.NoError(t, err)
+
+	forward, found = routerKeeper.GetIBCForward(ctx, 0, 1)
+	require.True(t, found)
+	require.False(t, forward.Unpaired)
+
+	_, found = routerKeeper.GetInFlightPacketByNonce(ctx, 0, 1)
+	require.True(t, found)
+
+	// a paired forward cannot be paired again
+	_, err = server.PairForward(sdk.WrapSDKContext(ctx), &types.MsgPairForward{From: submitter.String(), SourceDomain: 0, Nonce: 1})
+	require.ErrorIs(t, err, types.ErrPairForward)
+}
+
+func TestFallbackRecipientClaimsUnpairedForward(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	setupRestrictedForward(ctx, routerKeeper)
+	server := keeper.NewMsgServerImpl(routerKeeper)
+
+	submitter := sdk.AccAddress(fillByteArray(96, 20))
+	fallbackRecipient := sample.AccAddress()
+	require.NoError(t, routerKeeper.HandleMessage(ctx, restrictedForwardMessageWithFallback(t, submitter.String(), fallbackRecipient)))
+
+	ctx = types.ContextWithRelayer(ctx, sdk.AccAddress(fillByteArray(128, 20)))
+	require.NoError(t, routerKeeper.HandleMessage(ctx, restrictedMintMessage()))
+
+	_, err := server.ClaimFailedForward(sdk.WrapSDKContext(ctx), &types.MsgClaimFailedForward{From: fallbackRecipient, SourceDomain: 0, Nonce: 1})
+	require.NoError(t, err)
+
+	_, found := routerKeeper.GetIBCForward(ctx, 0, 1)
+	require.False(t, found)
+	_, found = routerKeeper.GetMint(ctx, 0, 1)
+	require.False(t, found)
+
+	// the claimed forward cannot be paired anymore
+	_, err = server.PairForward(sdk.WrapSDKContext(ctx), &types.MsgPairForward{From: submitter.String(), SourceDomain: 0, Nonce: 1})
+	require.ErrorIs(t, err, types.ErrPairForward)
+}
+
+func TestRequiredSubmitterWithoutKnownSubmitter(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	setupRestrictedForward(ctx, routerKeeper)
+
+	require.NoError(t, routerKeeper.HandleMessage(ctx, restrictedMintMessage()))
+
+	// the relayer is unknown when the RelayerDecorator is not part of the ante handler
+	submitter := sdk.AccAddress(fillByteArray(96, 20))
+	require.NoError(t, routerKeeper.HandleMessage(ctx, restrictedForwardMessage(t, submitter.String())))
+
+	_, found := routerKeeper.GetIBCForward(ctx, 0, 1)
+	require.True(t, found)
+
+	_, found = routerKeeper.GetInFlightPacketByNonce(ctx, 0, 1)
+	require.False(t, found)
+}
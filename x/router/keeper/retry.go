@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+// ForwardRetryBackoffBlocks is the number of blocks to wait before the first retry of a timed out
+// IBC forward. The delay doubles with every subsequent retry, up to MaxForwardRetryBackoffBlocks.
+var ForwardRetryBackoffBlocks = uint64(10)
+
+// MaxForwardRetryBackoffBlocks caps the delay between two retries of a timed out IBC forward.
+var MaxForwardRetryBackoffBlocks = uint64(10_000)
+
+// forwardRetryBackoff returns the number of blocks to wait before the given retry.
+func forwardRetryBackoff(retry uint64) uint64 {
+	backoff := ForwardRetryBackoffBlocks
+	for i := uint64(1); i < retry && backoff < MaxForwardRetryBackoffBlocks; i++ {
+		backoff *= 2
+	}
+	if backoff > MaxForwardRetryBackoffBlocks {
+		backoff = MaxForwardRetryBackoffBlocks
+	}
+	return backoff
+}
+
+// HandleForwardTimeout schedules the retry of a timed out IBC forward, or marks it as failed once
+// MaxForwardRetries has been reached. A failed forward keeps its mint, which stays claimable by a
+// new IBC forward for the same nonce.
+func (k *Keeper) HandleForwardTimeout(ctx sdk.Context, forward types.StoreIBCForwardMetadata) error {
+	height := uint64(ctx.BlockHeight())
+
+	if forward.Retries >= k.GetParams(ctx).MaxForwardRetries {
+		return k.failForward(ctx, forward, "max forward retries reached")
+	}
+
+	forward.Retries++
+	forward.NextRetryHeight = height + forwardRetryBackoff(forward.Retries)
+	k.SetIBCForward(ctx, forward)
+
+	return ctx.EventManager().EmitTypedEvent(&types.ForwardRetryScheduled{
+		SourceDomain:    forward.SourceDomain,
+		Nonce:           forward.Metadata.Nonce,
+		Retries:         forward.Retries,
+		NextRetryHeight: forward.NextRetryHeight,
+	})
+}
+
+// failForward marks an IBC forward as failed, its mint stays claimable by the fallback recipient.
+func (k *Keeper) failForward(ctx sdk.Context, forward types.StoreIBCForwardMetadata, reason string) error {
+	forward.Failed = true
+	forward.NextRetryHeight = 0
+	k.SetIBCForward(ctx, forward)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.ForwardFailed{
+		SourceDomain: forward.SourceDomain,
+		Nonce:        forward.Metadata.Nonce,
+		Retries:      forward.Retries,
+		Reason:       reason,
+	}); err != nil {
+		return err
+	}
+
+	k.AfterForwardFailed(ctx, forward.SourceDomain, forward.Metadata.Nonce, reason)
+	return nil
+}
+
+// ProcessForwardRetries re-sends every IBC forward whose retry is due at the current height.
+// A retry that cannot be sent counts as a timeout and is rescheduled or marked as failed.
+// Retries of paused source domains are held until routing is unpaused.
+func (k *Keeper) ProcessForwardRetries(ctx sdk.Context) {
+	end := make([]byte, 8)
+	binary.BigEndian.PutUint64(end, uint64(ctx.BlockHeight())+1)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ForwardRetryQueuePrefix)
+	iterator := store.Iterator(nil, end)
+
+	var due [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		due = append(due, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range due {
+		sourceDomain, nonce := types.ParseLookupKey(key[8:])
+
+		forward, found := k.GetIBCForward(ctx, sourceDomain, nonce)
+		if !found {
+			store.Delete(key)
+			continue
+		}
+
+		forward.NextRetryHeight = 0
+		k.SetIBCForward(ctx, forward)
+
+		mint, found := k.GetMint(ctx, sourceDomain, nonce)
+		if !found {
+			k.Logger(ctx).Error("no existing mint in store for ibc forward retry", "source-domain", sourceDomain, "nonce", nonce)
+			continue
+		}
+
+		if k.IsRoutingPaused(ctx, sourceDomain) {
+			if err := k.HoldForward(ctx, sourceDomain, nonce); err != nil {
+				k.Logger(ctx).Error("error holding ibc forward retry", "source-domain", sourceDomain, "nonce", nonce, "error", err)
+			}
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.ForwardPacket(cacheCtx, forward.Metadata, mint); err != nil {
+			k.Logger(ctx).Error("error retrying ibc forward", "source-domain", sourceDomain, "nonce", nonce, "error", err)
+			if err := k.HandleForwardTimeout(ctx, forward); err != nil {
+				k.Logger(ctx).Error("error handling ibc forward retry failure", "source-domain", sourceDomain, "nonce", nonce, "error", err)
+			}
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
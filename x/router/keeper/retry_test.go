@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/strangelove-ventures/noble/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func createRetryForward(sourceDomain uint32, nonce uint64) types.StoreIBCForwardMetadata {
+	return types.StoreIBCForwardMetadata{
+		SourceDomain: sourceDomain,
+		Metadata: &types.IBCForwardMetadata{
+			Nonce:               nonce,
+			Port:                "transfer",
+			Channel:             "channel-10",
+			DestinationReceiver: "12345",
+		},
+	}
+}
+
+func TestHandleForwardTimeoutSchedulesRetry(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	forward := createRetryForward(1, 2)
+	routerKeeper.SetIBCForward(ctx, forward)
+
+	require.NoError(t, routerKeeper.HandleForwardTimeout(ctx, forward))
+
+	stored, found := routerKeeper.GetIBCForward(ctx, 1, 2)
+	require.True(t, found)
+	require.Equal(t, uint64(1), stored.Retries)
+	require.Equal(t, 100+keeper.ForwardRetryBackoffBlocks, stored.NextRetryHeight)
+	require.False(t, stored.Failed)
+
+	// the backoff doubles with every retry
+	require.NoError(t, routerKeeper.HandleForwardTimeout(ctx, stored))
+
+	stored, found = routerKeeper.GetIBCForward(ctx, 1, 2)
+	require.True(t, found)
+	require.Equal(t, uint64(2), stored.Retries)
+	require.Equal(t, 100+2*keeper.ForwardRetryBackoffBlocks, stored.NextRetryHeight)
+}
+
+func TestHandleForwardTimeoutMaxRetries(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	maxRetries := routerKeeper.GetParams(ctx).MaxForwardRetries
+
+	forward := createRetryForward(1, 2)
+	forward.Retries = maxRetries
+	routerKeeper.SetIBCForward(ctx, forward)
+
+	require.NoError(t, routerKeeper.HandleForwardTimeout(ctx, forward))
+
+	stored, found := routerKeeper.GetIBCForward(ctx, 1, 2)
+	require.True(t, found)
+	require.True(t, stored.Failed)
+	require.Equal(t, maxRetries, stored.Retries)
+	require.Zero(t, stored.NextRetryHeight)
+}
+
+func TestProcessForwardRetries(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-10", ChainLabel: "osmosis"})
+	ctx = ctx.WithBlockHeight(100)
+
+	forward, _ := setupForward(ctx, routerKeeper, 1, 2, 10000)
+
+	require.NoError(t, routerKeeper.HandleForwardTimeout(ctx, forward))
+
+	// not yet due
+	ctx = ctx.WithBlockHeight(int64(100 + keeper.ForwardRetryBackoffBlocks - 1))
+	routerKeeper.ProcessForwardRetries(ctx)
+
+	_, found := routerKeeper.GetInFlightPacket(ctx, "channel-10", "transfer", 0)
+	require.False(t, found)
+
+	ctx = ctx.WithBlockHeight(int64(100 + keeper.ForwardRetryBackoffBlocks))
+	routerKeeper.ProcessForwardRetries(ctx)
+
+	_, found = routerKeeper.GetInFlightPacket(ctx, "channel-10", "transfer", 0)
+	require.True(t, found)
+
+	stored, found := routerKeeper.GetIBCForward(ctx, 1, 2)
+	require.True(t, found)
+	require.Equal(t, uint64(1), stored.Retries)
+	require.Zero(t, stored.NextRetryHeight)
+}
+
+func TestProcessForwardRetriesDeletedForward(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	forward := createRetryForward(1, 2)
+	routerKeeper.SetIBCForward(ctx, forward)
+	require.NoError(t, routerKeeper.HandleForwardTimeout(ctx, forward))
+
+	// deleting the forward drops its queued retry
+	routerKeeper.DeleteIBCForward(ctx, 1, 2)
+
+	ctx = ctx.WithBlockHeight(int64(100 + keeper.ForwardRetryBackoffBlocks))
+	routerKeeper.ProcessForwardRetries(ctx)
+
+	_, found := routerKeeper.GetInFlightPacket(ctx, "channel-10", "transfer", 0)
+	require.False(t, found)
+}
+
+// valid forward, found failed forward, existing mint -> forward packet with reset retries
+func TestForwardOnFailedWithExistingMint(t *testing.T) {
+	routerKeeper, ctx := keepertest.RouterKeeper(t)
+	routerKeeper.SetAllowedChannel(ctx, types.AllowedChannel{Channel: "channel-11", ChainLabel: "osmosis"})
+
+	sourceDomain, sourceDomainSender, nonce := uint32(8), string(fillByteArray(0, 32)), uint64(4)
+
+	forward, _ := setupForward(ctx, routerKeeper, sourceDomain, nonce, 10000)
+	forward.Retries = 5
+	forward.Failed = true
+	routerKeeper.SetIBCForward(ctx, forward)
+
+	msg := bytesFromMessage(keeper.Message{
+		Version:           1,
+		SourceDomain:      sourceDomain,
+		DestinationDomain: 3,
+		Nonce:             nonce,
+		Sender:            []byte(sourceDomainSender),
+		Recipient:         fillByteArray(32, 32),
+		DestinationCaller: fillByteArray(64, 32),
+		MessageBody:       createMockMetadata(nonce, "channel-11", sdk.Bech32PrefixAccAddr, sample.AccAddress(), "12345"),
+	})
+
+	require.NoError(t, routerKeeper.HandleMessage(ctx, msg))
+
+	_, found := routerKeeper.GetInFlightPacket(ctx, "channel-11", "transfer", 0)
+	require.True(t, found)
+
+	stored, found := routerKeeper.GetIBCForward(ctx, sourceDomain, nonce)
+	require.True(t, found)
+	require.Equal(t, "channel-11", stored.Metadata.Channel)
+	require.False(t, stored.Failed)
+	require.Zero(t, stored.Retries)
+}
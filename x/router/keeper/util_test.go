@@ -49,7 +49,7 @@ func TestDecodeIBCForward(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.Equal(t,
-					nullify.Fill(tc.expected),
+					nullify.Fill(&tc.expected),
 					nullify.Fill(result),
 				)
 			}
@@ -57,6 +57,23 @@ func TestDecodeIBCForward(t *testing.T) {
 	}
 }
 
+// setupForward stores a forward over channel-10 and its mint of the given amount of uusdc,
+// and returns both.
+func setupForward(ctx sdk.Context, routerKeeper *keeper.Keeper, sourceDomain uint32, nonce uint64, amount int64) (types.StoreIBCForwardMetadata, types.Mint) {
+	forward := createRetryForward(sourceDomain, nonce)
+	routerKeeper.SetIBCForward(ctx, forward)
+
+	mint := types.Mint{
+		SourceDomain:  sourceDomain,
+		Nonce:         nonce,
+		Amount:        &sdk.Coin{Denom: "uusdc", Amount: sdk.NewInt(amount)},
+		MintRecipient: sample.AccAddress(),
+	}
+	routerKeeper.SetMint(ctx, mint)
+
+	return forward, mint
+}
+
 func bytesFromMessage(msg keeper.Message) []byte {
 	result := make([]byte, keeper.MessageBodyIndex+len(msg.MessageBody))
 
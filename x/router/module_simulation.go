@@ -0,0 +1,62 @@
+package router
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/simulation"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+var _ module.AppModuleSimulation = AppModuleSimulation{}
+
+// ----------------------------------------------------------------------------
+// AppModuleSimulation
+// ----------------------------------------------------------------------------
+
+// AppModuleSimulation is the router module as registered with the simulation manager of the app. The
+// weighted operations sign their messages with simulation accounts, which need the account keeper.
+type AppModuleSimulation struct {
+	AppModule
+	accountKeeper types.AccountKeeper
+}
+
+func NewAppModuleSimulation(
+	cdc codec.Codec,
+	keeper *keeper.Keeper,
+	accountKeeper types.AccountKeeper,
+) AppModuleSimulation {
+	return AppModuleSimulation{
+		AppModule:     NewAppModule(cdc, keeper),
+		accountKeeper: accountKeeper,
+	}
+}
+
+// GenerateGenesisState creates a randomized GenState of the router module.
+func (AppModuleSimulation) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// ProposalContents doesn't return any content functions for governance proposals.
+func (AppModuleSimulation) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalContent {
+	return nil
+}
+
+// RandomizedParams doesn't create randomized router param changes for the simulator.
+func (AppModuleSimulation) RandomizedParams(_ *rand.Rand) []simtypes.ParamChange {
+	return nil
+}
+
+// RegisterStoreDecoder registers a decoder for router module's types
+func (am AppModuleSimulation) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(am.cdc)
+}
+
+// WeightedOperations returns the all the router module operations with their respective weights.
+func (am AppModuleSimulation) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.Cdc, am.accountKeeper, am.keeper)
+}
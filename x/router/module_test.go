@@ -0,0 +1,17 @@
+package router_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+)
+
+func TestValidateWiringRequiresCctpMsgServer(t *testing.T) {
+	routerKeeper, _ := keepertest.RouterKeeper(t)
+	require.Error(t, routerKeeper.ValidateWiring())
+
+	routerKeeper.SetCctpMsgServer(&keepertest.MockCctpMsgServer{})
+	require.NoError(t, routerKeeper.ValidateWiring())
+}
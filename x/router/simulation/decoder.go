@@ -0,0 +1,142 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding router type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.IBCForwardPrefix):
+			var forwardA, forwardB types.StoreIBCForwardMetadata
+			cdc.MustUnmarshal(kvA.Value, &forwardA)
+			cdc.MustUnmarshal(kvB.Value, &forwardB)
+			return fmt.Sprintf("%v\n%v", forwardA, forwardB)
+
+		case bytes.HasPrefix(kvA.Key, types.InFlightPacketPrefix):
+			var packetA, packetB types.InFlightPacket
+			cdc.MustUnmarshal(kvA.Value, &packetA)
+			cdc.MustUnmarshal(kvB.Value, &packetB)
+			return fmt.Sprintf("%v\n%v", packetA, packetB)
+
+		case bytes.HasPrefix(kvA.Key, types.InFlightPacketByNoncePrefix):
+			// the index points at the in flight packet key
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.MintPrefix):
+			var mintA, mintB types.Mint
+			cdc.MustUnmarshal(kvA.Value, &mintA)
+			cdc.MustUnmarshal(kvB.Value, &mintB)
+			return fmt.Sprintf("%v\n%v", mintA, mintB)
+
+		case bytes.HasPrefix(kvA.Key, types.MintByHeightPrefix):
+			return fmt.Sprintf("%s\n%s",
+				heightLookupKeyString(kvA.Key[len(types.MintByHeightPrefix):]),
+				heightLookupKeyString(kvB.Key[len(types.MintByHeightPrefix):]))
+
+		case bytes.HasPrefix(kvA.Key, types.ForwardRetryQueuePrefix):
+			return fmt.Sprintf("%s\n%s",
+				heightLookupKeyString(kvA.Key[len(types.ForwardRetryQueuePrefix):]),
+				heightLookupKeyString(kvB.Key[len(types.ForwardRetryQueuePrefix):]))
+
+		case bytes.HasPrefix(kvA.Key, types.ForwardReceiptByHeightPrefix):
+			return fmt.Sprintf("%s\n%s",
+				heightLookupKeyString(kvA.Key[len(types.ForwardReceiptByHeightPrefix):]),
+				heightLookupKeyString(kvB.Key[len(types.ForwardReceiptByHeightPrefix):]))
+
+		case bytes.HasPrefix(kvA.Key, types.ForwardReceiptPrefix):
+			var receiptA, receiptB types.ForwardReceipt
+			cdc.MustUnmarshal(kvA.Value, &receiptA)
+			cdc.MustUnmarshal(kvB.Value, &receiptB)
+			return fmt.Sprintf("%v\n%v", receiptA, receiptB)
+
+		case bytes.HasPrefix(kvA.Key, types.AllowedSourceDomainSenderKeyPrefix):
+			return fmt.Sprintf("%s\n%s",
+				sourceDomainSenderKeyString(kvA.Key[len(types.AllowedSourceDomainSenderKeyPrefix):]),
+				sourceDomainSenderKeyString(kvB.Key[len(types.AllowedSourceDomainSenderKeyPrefix):]))
+
+		case bytes.HasPrefix(kvA.Key, types.AllowedChannelKeyPrefix):
+			var channelA, channelB types.AllowedChannel
+			cdc.MustUnmarshal(kvA.Value, &channelA)
+			cdc.MustUnmarshal(kvB.Value, &channelB)
+			return fmt.Sprintf("%v\n%v", channelA, channelB)
+
+		case bytes.HasPrefix(kvA.Key, types.SourceDomainRateLimitPrefix):
+			var rateLimitA, rateLimitB types.SourceDomainRateLimit
+			cdc.MustUnmarshal(kvA.Value, &rateLimitA)
+			cdc.MustUnmarshal(kvB.Value, &rateLimitB)
+			return fmt.Sprintf("%v\n%v", rateLimitA, rateLimitB)
+
+		case bytes.HasPrefix(kvA.Key, types.ChannelRateLimitPrefix):
+			var rateLimitA, rateLimitB types.ChannelRateLimit
+			cdc.MustUnmarshal(kvA.Value, &rateLimitA)
+			cdc.MustUnmarshal(kvB.Value, &rateLimitB)
+			return fmt.Sprintf("%v\n%v", rateLimitA, rateLimitB)
+
+		case bytes.HasPrefix(kvA.Key, types.SourceDomainRateLimitUsagePrefix),
+			bytes.HasPrefix(kvA.Key, types.ChannelRateLimitUsagePrefix),
+			bytes.HasPrefix(kvA.Key, types.SourceDomainRateLimitBucketPrefix),
+			bytes.HasPrefix(kvA.Key, types.ChannelRateLimitBucketPrefix):
+			var usageA, usageB types.RateLimitUsage
+			cdc.MustUnmarshal(kvA.Value, &usageA)
+			cdc.MustUnmarshal(kvB.Value, &usageB)
+			return fmt.Sprintf("%v\n%v", usageA, usageB)
+
+		case bytes.HasPrefix(kvA.Key, types.RateLimitedForwardPrefix):
+			return fmt.Sprintf("%s\n%s",
+				lookupKeyString(kvA.Key[len(types.RateLimitedForwardPrefix):]),
+				lookupKeyString(kvB.Key[len(types.RateLimitedForwardPrefix):]))
+
+		case bytes.HasPrefix(kvA.Key, types.HeldForwardPrefix):
+			return fmt.Sprintf("%s\n%s",
+				lookupKeyString(kvA.Key[len(types.HeldForwardPrefix):]),
+				lookupKeyString(kvB.Key[len(types.HeldForwardPrefix):]))
+
+		case bytes.HasPrefix(kvA.Key, types.PausedSourceDomainPrefix):
+			return fmt.Sprintf("source domain %d\nsource domain %d",
+				binary.BigEndian.Uint32(kvA.Key[len(types.PausedSourceDomainPrefix):]),
+				binary.BigEndian.Uint32(kvB.Key[len(types.PausedSourceDomainPrefix):]))
+
+		case bytes.Equal(kvA.Key, types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key, types.RateLimitedForwardCursorKey):
+			return fmt.Sprintf("%s\n%s", lookupKeyString(kvA.Value), lookupKeyString(kvB.Value))
+
+		case bytes.Equal(kvA.Key, types.RoutingPausedKey):
+			return fmt.Sprintf("%t\n%t", len(kvA.Value) != 0, len(kvB.Value) != 0)
+
+		case bytes.Equal(kvA.Key, types.PauserKey),
+			bytes.Equal(kvA.Key, types.OwnerKey),
+			bytes.Equal(kvA.Key, types.PendingOwnerKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid router key %X", kvA.Key))
+		}
+	}
+}
+
+func lookupKeyString(key []byte) string {
+	sourceDomain, nonce := types.ParseLookupKey(key)
+	return fmt.Sprintf("source domain %d, nonce %d", sourceDomain, nonce)
+}
+
+func heightLookupKeyString(key []byte) string {
+	return fmt.Sprintf("height %d, %s", binary.BigEndian.Uint64(key[:8]), lookupKeyString(key[8:]))
+}
+
+func sourceDomainSenderKeyString(key []byte) string {
+	return fmt.Sprintf("source domain %d, sender %X", binary.BigEndian.Uint32(key[:4]), key[4:])
+}
@@ -0,0 +1,76 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/strangelove-ventures/noble-router/x/router/simulation"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+func prefixed(prefix []byte, key []byte) []byte {
+	return append(append([]byte{}, prefix...), key...)
+}
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	mint := types.Mint{SourceDomain: 1, Nonce: 2, Amount: &sdk.Coin{Denom: "uusdc", Amount: sdk.NewInt(10)}}
+	forward := types.StoreIBCForwardMetadata{SourceDomain: 1, Metadata: &types.IBCForwardMetadata{Nonce: 2, Channel: "channel-0"}}
+	packet := types.InFlightPacket{SourceDomain: 1, Nonce: 2, Channel: "channel-0", Port: "transfer", Sequence: 3}
+	receipt := types.ForwardReceipt{SourceDomain: 1, Nonce: 2, Status: types.FORWARD_STATUS_COMPLETED}
+	channel := types.AllowedChannel{Channel: "channel-0", ChainLabel: "osmosis"}
+	domainRateLimit := types.SourceDomainRateLimit{SourceDomain: 1, WindowBlocks: 10, MaxAmount: sdk.NewInt(100)}
+	channelRateLimit := types.ChannelRateLimit{Channel: "channel-0", WindowBlocks: 10, MaxAmount: sdk.NewInt(100)}
+	usage := types.RateLimitUsage{Amount: sdk.NewInt(50)}
+	params := types.DefaultParams()
+
+	lookupKey := types.LookupKey(1, 2)
+	inFlightKey := types.InFlightPacketKey("channel-0", "transfer", 3)
+	senderKey := types.SourceDomainSenderKey(1, make([]byte, 32))
+
+	tests := []struct {
+		name     string
+		pair     kv.Pair
+		expected string
+	}{
+		{"Mint", kv.Pair{Key: prefixed(types.MintPrefix, lookupKey), Value: cdc.MustMarshal(&mint)}, fmt.Sprintf("%v\n%v", mint, mint)},
+		{"MintByHeight", kv.Pair{Key: prefixed(types.MintByHeightPrefix, types.MintByHeightKey(7, 1, 2))}, "height 7, source domain 1, nonce 2\nheight 7, source domain 1, nonce 2"},
+		{"IBCForward", kv.Pair{Key: prefixed(types.IBCForwardPrefix, lookupKey), Value: cdc.MustMarshal(&forward)}, fmt.Sprintf("%v\n%v", forward, forward)},
+		{"ForwardRetry", kv.Pair{Key: prefixed(types.ForwardRetryQueuePrefix, types.ForwardRetryKey(8, 1, 2))}, "height 8, source domain 1, nonce 2\nheight 8, source domain 1, nonce 2"},
+		{"InFlightPacket", kv.Pair{Key: prefixed(types.InFlightPacketPrefix, inFlightKey), Value: cdc.MustMarshal(&packet)}, fmt.Sprintf("%v\n%v", packet, packet)},
+		{"InFlightPacketByNonce", kv.Pair{Key: prefixed(types.InFlightPacketByNoncePrefix, lookupKey), Value: inFlightKey}, "channel-0/transfer/3\nchannel-0/transfer/3"},
+		{"ForwardReceipt", kv.Pair{Key: prefixed(types.ForwardReceiptPrefix, lookupKey), Value: cdc.MustMarshal(&receipt)}, fmt.Sprintf("%v\n%v", receipt, receipt)},
+		{"ForwardReceiptByHeight", kv.Pair{Key: prefixed(types.ForwardReceiptByHeightPrefix, types.ForwardReceiptByHeightKey(7, 1, 2))}, "height 7, source domain 1, nonce 2\nheight 7, source domain 1, nonce 2"},
+		{"AllowedSourceDomainSender", kv.Pair{Key: prefixed(types.AllowedSourceDomainSenderKeyPrefix, senderKey)}, fmt.Sprintf("source domain 1, sender %X\nsource domain 1, sender %X", make([]byte, 32), make([]byte, 32))},
+		{"AllowedChannel", kv.Pair{Key: prefixed(types.AllowedChannelKeyPrefix, []byte("channel-0")), Value: cdc.MustMarshal(&channel)}, fmt.Sprintf("%v\n%v", channel, channel)},
+		{"SourceDomainRateLimit", kv.Pair{Key: prefixed(types.SourceDomainRateLimitPrefix, types.SourceDomainKey(1)), Value: cdc.MustMarshal(&domainRateLimit)}, fmt.Sprintf("%v\n%v", domainRateLimit, domainRateLimit)},
+		{"ChannelRateLimit", kv.Pair{Key: prefixed(types.ChannelRateLimitPrefix, []byte("channel-0")), Value: cdc.MustMarshal(&channelRateLimit)}, fmt.Sprintf("%v\n%v", channelRateLimit, channelRateLimit)},
+		{"RateLimitUsage", kv.Pair{Key: prefixed(types.ChannelRateLimitUsagePrefix, []byte("channel-0")), Value: cdc.MustMarshal(&usage)}, fmt.Sprintf("%v\n%v", usage, usage)},
+		{"RateLimitBucket", kv.Pair{Key: append(types.RateLimitBucketsPrefix(types.ChannelRateLimitBucketPrefix, []byte("channel-0")), types.RateLimitBucketKey(5)...), Value: cdc.MustMarshal(&usage)}, fmt.Sprintf("%v\n%v", usage, usage)},
+		{"RateLimitedForwardCursor", kv.Pair{Key: types.RateLimitedForwardCursorKey, Value: lookupKey}, "source domain 1, nonce 2\nsource domain 1, nonce 2"},
+		{"RateLimitedForward", kv.Pair{Key: prefixed(types.RateLimitedForwardPrefix, lookupKey)}, "source domain 1, nonce 2\nsource domain 1, nonce 2"},
+		{"HeldForward", kv.Pair{Key: prefixed(types.HeldForwardPrefix, lookupKey)}, "source domain 1, nonce 2\nsource domain 1, nonce 2"},
+		{"PausedSourceDomain", kv.Pair{Key: prefixed(types.PausedSourceDomainPrefix, types.SourceDomainKey(1)), Value: []byte{1}}, "source domain 1\nsource domain 1"},
+		{"Params", kv.Pair{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)}, fmt.Sprintf("%v\n%v", params, params)},
+		{"RoutingPaused", kv.Pair{Key: types.RoutingPausedKey, Value: []byte{1}}, "true\ntrue"},
+		{"Pauser", kv.Pair{Key: types.PauserKey, Value: []byte("pauser")}, "pauser\npauser"},
+		{"Owner", kv.Pair{Key: types.OwnerKey, Value: []byte("owner")}, "owner\nowner"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, dec(tt.pair, tt.pair))
+		})
+	}
+
+	require.Panics(t, func() {
+		dec(kv.Pair{Key: []byte("unknown")}, kv.Pair{Key: []byte("unknown")})
+	})
+}
@@ -0,0 +1,198 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+const (
+	// maxSimulatedDomain bounds the source domains used in the simulation
+	maxSimulatedDomain = 8
+	// maxSimulatedChannel bounds the channel identifiers used in the simulation
+	maxSimulatedChannel = 16
+)
+
+// RandomizedGenState generates a random GenesisState for the router module.
+// The owner, pauser and fee collector are simulation accounts, so that the weighted operations can sign as them.
+// Every nonce is either an unmatched mint, an unmatched IBC forward, a forward in flight, a forward with an
+// ack error, a forward awaiting its retry, a failed forward, a forward held by a paused source domain or a
+// forward queued by the rate limits, which keeps the generated state consistent with the invariants of the module.
+func RandomizedGenState(simState *module.SimulationState) {
+	r := simState.Rand
+	genesis := types.DefaultGenesis()
+
+	genesis.Owner = randomAddress(r, simState.Accounts)
+	genesis.Pauser = randomAddress(r, simState.Accounts)
+
+	genesis.Params.MaxForwardRetries = uint64(r.Intn(int(types.MaxMaxForwardRetries)) + 1)
+	if r.Intn(2) == 0 {
+		genesis.Params.FeeCollector = randomAddress(r, simState.Accounts)
+	}
+
+	numSenders := r.Intn(5) + 1
+	for i := 0; i < numSenders; i++ {
+		genesis.AllowedSourceDomainSenders = append(genesis.AllowedSourceDomainSenders, types.AllowedSourceDomainSender{
+			DomainId: uint32(i % maxSimulatedDomain),
+			Address:  RandomSourceDomainSender(r),
+		})
+	}
+
+	numChannels := r.Intn(3) + 1
+	for i := 0; i < numChannels; i++ {
+		genesis.AllowedChannels = append(genesis.AllowedChannels, types.AllowedChannel{
+			Channel:    fmt.Sprintf("channel-%d", i),
+			ChainLabel: simtypes.RandStringOfLength(r, 10),
+		})
+	}
+
+	if genesis.Params.FeeCollector != "" {
+		for domain := uint32(0); domain < maxSimulatedDomain; domain++ {
+			if r.Intn(2) == 0 {
+				genesis.Params.SourceDomainFees = append(genesis.Params.SourceDomainFees, types.SourceDomainFee{
+					SourceDomain: domain,
+					Fee:          randomFee(r),
+				})
+			}
+		}
+		for _, channel := range genesis.AllowedChannels {
+			if r.Intn(2) == 0 {
+				genesis.Params.ChannelFees = append(genesis.Params.ChannelFees, types.ChannelFee{
+					Channel: channel.Channel,
+					Fee:     randomFee(r),
+				})
+			}
+		}
+	}
+
+	// the rate limits have room for any simulated mint, so that queued forwards are not failed
+	for domain := uint32(0); domain < maxSimulatedDomain; domain++ {
+		if r.Intn(2) == 0 {
+			genesis.SourceDomainRateLimits = append(genesis.SourceDomainRateLimits, types.SourceDomainRateLimit{
+				SourceDomain: domain,
+				WindowBlocks: uint64(r.Intn(1_000) + 1),
+				MaxAmount:    randomRateLimitAmount(r),
+			})
+		}
+	}
+	for _, channel := range genesis.AllowedChannels {
+		if r.Intn(2) == 0 {
+			genesis.ChannelRateLimits = append(genesis.ChannelRateLimits, types.ChannelRateLimit{
+				Channel:      channel.Channel,
+				WindowBlocks: uint64(r.Intn(1_000) + 1),
+				MaxAmount:    randomRateLimitAmount(r),
+			})
+		}
+	}
+
+	pausedSourceDomains := make(map[uint32]struct{})
+
+	numNonces := r.Intn(20)
+	for nonce := uint64(0); nonce < uint64(numNonces); nonce++ {
+		sender := genesis.AllowedSourceDomainSenders[r.Intn(numSenders)]
+		channel := genesis.AllowedChannels[r.Intn(numChannels)].Channel
+
+		mint := types.Mint{
+			SourceDomain:       sender.DomainId,
+			SourceDomainSender: sender.Address,
+			Nonce:              nonce,
+			Amount:             &sdk.Coin{Denom: "uusdc", Amount: sdk.NewInt(int64(r.Intn(1_000_000_000) + 1))},
+			DestinationDomain:  cctptypes.NobleDomainId,
+			MintRecipient:      randomAddress(r, simState.Accounts),
+		}
+		forward := types.StoreIBCForwardMetadata{
+			SourceDomain: sender.DomainId,
+			Metadata: &types.IBCForwardMetadata{
+				Nonce:               nonce,
+				Port:                "transfer",
+				Channel:             channel,
+				DestinationReceiver: simtypes.RandStringOfLength(r, 20),
+			},
+		}
+
+		switch r.Intn(8) {
+		case 0:
+			genesis.Mints = append(genesis.Mints, mint)
+		case 1:
+			genesis.IbcForwards = append(genesis.IbcForwards, forward)
+		case 2:
+			genesis.Mints = append(genesis.Mints, mint)
+			genesis.IbcForwards = append(genesis.IbcForwards, forward)
+			genesis.InFlightPackets = append(genesis.InFlightPackets, types.InFlightPacket{
+				SourceDomain: sender.DomainId,
+				Nonce:        nonce,
+				Port:         "transfer",
+				Channel:      channel,
+				Sequence:     nonce + 1,
+			})
+		case 3:
+			forward.AckError = true
+			genesis.Mints = append(genesis.Mints, mint)
+			genesis.IbcForwards = append(genesis.IbcForwards, forward)
+		case 4:
+			forward.Retries = uint64(r.Intn(int(genesis.Params.MaxForwardRetries)) + 1)
+			forward.NextRetryHeight = uint64(r.Intn(100) + 1)
+			genesis.Mints = append(genesis.Mints, mint)
+			genesis.IbcForwards = append(genesis.IbcForwards, forward)
+		case 5:
+			forward.Retries = genesis.Params.MaxForwardRetries
+			forward.Failed = true
+			genesis.Mints = append(genesis.Mints, mint)
+			genesis.IbcForwards = append(genesis.IbcForwards, forward)
+		case 6:
+			genesis.Mints = append(genesis.Mints, mint)
+			genesis.IbcForwards = append(genesis.IbcForwards, forward)
+			genesis.HeldForwards = append(genesis.HeldForwards, types.HeldForward{SourceDomain: sender.DomainId, Nonce: nonce})
+			pausedSourceDomains[sender.DomainId] = struct{}{}
+		case 7:
+			genesis.Mints = append(genesis.Mints, mint)
+			genesis.IbcForwards = append(genesis.IbcForwards, forward)
+			genesis.RateLimitedForwards = append(genesis.RateLimitedForwards, types.RateLimitedForward{SourceDomain: sender.DomainId, Nonce: nonce})
+		}
+	}
+
+	// held forwards stay held until their source domain is unpaused
+	for domain := uint32(0); domain < maxSimulatedDomain; domain++ {
+		if _, ok := pausedSourceDomains[domain]; ok {
+			genesis.PausedSourceDomains = append(genesis.PausedSourceDomains, domain)
+		}
+	}
+
+	bz, err := json.MarshalIndent(&genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated router parameters:\n%s\n", bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
+
+func randomFee(r *rand.Rand) types.Fee {
+	fee := types.Fee{Bps: uint32(r.Intn(100))}
+	if r.Intn(2) == 0 {
+		fee.MinFees = sdk.NewCoins(sdk.NewInt64Coin("uusdc", int64(r.Intn(10_000)+1)))
+	}
+	return fee
+}
+
+func randomRateLimitAmount(r *rand.Rand) sdk.Int {
+	return sdk.NewInt(int64(r.Intn(9_000_000_000)) + 1_000_000_000)
+}
+
+// RandomSourceDomainSender returns a random 32 byte source domain sender address.
+func RandomSourceDomainSender(r *rand.Rand) []byte {
+	address := make([]byte, types.SourceDomainSenderLen)
+	r.Read(address)
+	return address
+}
+
+func randomAddress(r *rand.Rand, accs []simtypes.Account) string {
+	acc, _ := simtypes.RandomAcc(r, accs)
+	return acc.Address.String()
+}
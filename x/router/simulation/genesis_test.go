@@ -0,0 +1,68 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	keepertest "github.com/strangelove-ventures/noble-router/testutil/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/simulation"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRandomizedGenState checks that the randomized genesis is valid and satisfies the invariants once imported.
+func TestRandomizedGenState(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	var retrying, failed, held, rateLimited, fees, rateLimits bool
+	for seed := int64(0); seed < 20; seed++ {
+		r := rand.New(rand.NewSource(seed))
+
+		simState := module.SimulationState{
+			AppParams: make(simtypes.AppParams),
+			Cdc:       cdc,
+			Rand:      r,
+			Accounts:  simtypes.RandomAccounts(r, 3),
+			GenState:  make(map[string]json.RawMessage),
+		}
+
+		simulation.RandomizedGenState(&simState)
+
+		var genesis types.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genesis)
+		require.NoError(t, genesis.Validate())
+		require.NotEmpty(t, genesis.Owner)
+		require.NotEmpty(t, genesis.AllowedSourceDomainSenders)
+		require.NotEmpty(t, genesis.AllowedChannels)
+
+		k, ctx := keepertest.RouterKeeper(t)
+		router.InitGenesis(ctx, k, genesis)
+
+		msg, broken := keeper.AllInvariants(k)(ctx)
+		require.False(t, broken, msg)
+
+		for _, forward := range genesis.IbcForwards {
+			retrying = retrying || forward.NextRetryHeight != 0
+			failed = failed || forward.Failed
+		}
+		held = held || len(genesis.HeldForwards) > 0
+		rateLimited = rateLimited || len(genesis.RateLimitedForwards) > 0
+		fees = fees || len(genesis.Params.SourceDomainFees)+len(genesis.Params.ChannelFees) > 0
+		rateLimits = rateLimits || len(genesis.SourceDomainRateLimits)+len(genesis.ChannelRateLimits) > 0
+	}
+
+	// the retry, pause, rate limit and fee state is part of the randomized genesis
+	require.True(t, retrying)
+	require.True(t, failed)
+	require.True(t, held)
+	require.True(t, rateLimited)
+	require.True(t, fees)
+	require.True(t, rateLimits)
+}
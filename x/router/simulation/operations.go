@@ -0,0 +1,195 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/strangelove-ventures/noble-router/x/router/keeper"
+	"github.com/strangelove-ventures/noble-router/x/router/types"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec
+const (
+	OpWeightMsgAddAllowedSourceDomainSender    = "op_weight_msg_add_allowed_source_domain_sender"
+	OpWeightMsgRemoveAllowedSourceDomainSender = "op_weight_msg_remove_allowed_source_domain_sender"
+	OpWeightMsgAddAllowedChannel               = "op_weight_msg_add_allowed_channel"
+	OpWeightMsgRemoveAllowedChannel            = "op_weight_msg_remove_allowed_channel"
+)
+
+// router operations weights
+const (
+	WeightAddAllowedSourceDomainSender    = 50
+	WeightRemoveAllowedSourceDomainSender = 20
+	WeightAddAllowedChannel               = 50
+	WeightRemoveAllowedChannel            = 20
+)
+
+// router message types
+var (
+	TypeMsgAddAllowedSourceDomainSender    = sdk.MsgTypeURL(&types.MsgAddAllowedSourceDomainSender{})
+	TypeMsgRemoveAllowedSourceDomainSender = sdk.MsgTypeURL(&types.MsgRemoveAllowedSourceDomainSender{})
+	TypeMsgAddAllowedChannel               = sdk.MsgTypeURL(&types.MsgAddAllowedChannel{})
+	TypeMsgRemoveAllowedChannel            = sdk.MsgTypeURL(&types.MsgRemoveAllowedChannel{})
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, k *keeper.Keeper) simulation.WeightedOperations {
+	var (
+		weightAddAllowedSourceDomainSender    int
+		weightRemoveAllowedSourceDomainSender int
+		weightAddAllowedChannel               int
+		weightRemoveAllowedChannel            int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAddAllowedSourceDomainSender, &weightAddAllowedSourceDomainSender, nil,
+		func(_ *rand.Rand) {
+			weightAddAllowedSourceDomainSender = WeightAddAllowedSourceDomainSender
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRemoveAllowedSourceDomainSender, &weightRemoveAllowedSourceDomainSender, nil,
+		func(_ *rand.Rand) {
+			weightRemoveAllowedSourceDomainSender = WeightRemoveAllowedSourceDomainSender
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAddAllowedChannel, &weightAddAllowedChannel, nil,
+		func(_ *rand.Rand) {
+			weightAddAllowedChannel = WeightAddAllowedChannel
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRemoveAllowedChannel, &weightRemoveAllowedChannel, nil,
+		func(_ *rand.Rand) {
+			weightRemoveAllowedChannel = WeightRemoveAllowedChannel
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightAddAllowedSourceDomainSender, SimulateMsgAddAllowedSourceDomainSender(ak, k)),
+		simulation.NewWeightedOperation(weightRemoveAllowedSourceDomainSender, SimulateMsgRemoveAllowedSourceDomainSender(ak, k)),
+		simulation.NewWeightedOperation(weightAddAllowedChannel, SimulateMsgAddAllowedChannel(ak, k)),
+		simulation.NewWeightedOperation(weightRemoveAllowedChannel, SimulateMsgRemoveAllowedChannel(ak, k)),
+	}
+}
+
+// SimulateMsgAddAllowedSourceDomainSender generates a MsgAddAllowedSourceDomainSender signed by the owner
+func SimulateMsgAddAllowedSourceDomainSender(ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, found := ownerAccount(ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAddAllowedSourceDomainSender, "owner is not a simulation account"), nil, nil
+		}
+
+		domainID := uint32(r.Intn(maxSimulatedDomain))
+		address := RandomSourceDomainSender(r)
+		if k.IsAllowedSourceDomainSender(ctx, domainID, address) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAddAllowedSourceDomainSender, "source domain sender is already allowed"), nil, nil
+		}
+
+		msg := types.NewMsgAddAllowedSourceDomainSender(owner.Address.String(), domainID, address)
+		return deliver(r, app, ctx, ak, owner, msg, TypeMsgAddAllowedSourceDomainSender)
+	}
+}
+
+// SimulateMsgRemoveAllowedSourceDomainSender generates a MsgRemoveAllowedSourceDomainSender signed by the owner
+func SimulateMsgRemoveAllowedSourceDomainSender(ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, found := ownerAccount(ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgRemoveAllowedSourceDomainSender, "owner is not a simulation account"), nil, nil
+		}
+
+		senders := k.GetAllowedSourceDomainSenders(ctx)
+		if len(senders) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgRemoveAllowedSourceDomainSender, "no allowed source domain senders"), nil, nil
+		}
+		sender := senders[r.Intn(len(senders))]
+
+		msg := types.NewMsgRemoveAllowedSourceDomainSender(owner.Address.String(), sender.DomainId, sender.Address)
+		return deliver(r, app, ctx, ak, owner, msg, TypeMsgRemoveAllowedSourceDomainSender)
+	}
+}
+
+// SimulateMsgAddAllowedChannel generates a MsgAddAllowedChannel signed by the owner
+func SimulateMsgAddAllowedChannel(ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, found := ownerAccount(ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAddAllowedChannel, "owner is not a simulation account"), nil, nil
+		}
+
+		channel := fmt.Sprintf("channel-%d", r.Intn(maxSimulatedChannel))
+		if _, found := k.GetAllowedChannel(ctx, channel); found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAddAllowedChannel, "channel is already allowed"), nil, nil
+		}
+
+		msg := types.NewMsgAddAllowedChannel(owner.Address.String(), channel, simtypes.RandStringOfLength(r, 10))
+		return deliver(r, app, ctx, ak, owner, msg, TypeMsgAddAllowedChannel)
+	}
+}
+
+// SimulateMsgRemoveAllowedChannel generates a MsgRemoveAllowedChannel signed by the owner
+func SimulateMsgRemoveAllowedChannel(ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, found := ownerAccount(ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgRemoveAllowedChannel, "owner is not a simulation account"), nil, nil
+		}
+
+		channels := k.GetAllowedChannels(ctx)
+		if len(channels) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgRemoveAllowedChannel, "no allowed channels"), nil, nil
+		}
+		channel := channels[r.Intn(len(channels))]
+
+		msg := types.NewMsgRemoveAllowedChannel(owner.Address.String(), channel.Channel)
+		return deliver(r, app, ctx, ak, owner, msg, TypeMsgRemoveAllowedChannel)
+	}
+}
+
+// ownerAccount returns the simulation account of the owner of the router module
+func ownerAccount(ctx sdk.Context, k *keeper.Keeper, accs []simtypes.Account) (simtypes.Account, bool) {
+	owner, err := sdk.AccAddressFromBech32(k.GetOwner(ctx))
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+	return simtypes.FindAccount(accs, owner)
+}
+
+// deliver signs and delivers a message without fees, as the owner messages do not spend any funds
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, account simtypes.Account, msg sdk.Msg, msgType string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:           codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		Msg:           msg,
+		MsgType:       msgType,
+		Context:       ctx,
+		SimAccount:    account,
+		AccountKeeper: ak,
+		ModuleName:    types.ModuleName,
+	}
+
+	return simulation.GenAndDeliverTx(txCtx, sdk.NewCoins())
+}
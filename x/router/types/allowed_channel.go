@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const MaxChainLabelLength = 64
+
+func (a AllowedChannel) Validate() error {
+	if err := host.ChannelIdentifierValidator(a.Channel); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel: %s", err)
+	}
+	if a.ChainLabel == "" || len(a.ChainLabel) > MaxChainLabelLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "chain label must be between 1 and %d bytes", MaxChainLabelLength)
+	}
+	return nil
+}
@@ -18,4 +18,10 @@ var (
 	ErrUnauthorized                          = sdkerrors.Register(ModuleName, 9, "unauthorized")
 	ErrAllowedSourceDomainSenderAlreadyFound = sdkerrors.Register(ModuleName, 10, "this source domain sender is already allowed")
 	ErrAllowedSourceDomainSenderNotFound     = sdkerrors.Register(ModuleName, 11, "source domain sender not found")
+	ErrClaimFailedForward                    = sdkerrors.Register(ModuleName, 12, "err claiming failed forward")
+	ErrAllowedChannelAlreadyFound            = sdkerrors.Register(ModuleName, 13, "this channel is already allowed")
+	ErrAllowedChannelNotFound                = sdkerrors.Register(ModuleName, 14, "allowed channel not found")
+	ErrRateLimitNotFound                     = sdkerrors.Register(ModuleName, 15, "rate limit not found")
+	ErrInboundBurn                           = sdkerrors.Register(ModuleName, 16, "err burning inbound transfer")
+	ErrPairForward                           = sdkerrors.Register(ModuleName, 17, "err pairing forward")
 )
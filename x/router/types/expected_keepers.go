@@ -5,7 +5,9 @@ import (
 
 	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
 // TransferKeeper defines the expected transfer keeper
@@ -13,7 +15,28 @@ type TransferKeeper interface {
 	Transfer(ctx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error)
 }
 
+// ChannelKeeper defines the expected IBC channel keeper, used to seed the channel allowlist on upgrade
+type ChannelKeeper interface {
+	GetAllChannels(ctx sdk.Context) []channeltypes.IdentifiedChannel
+}
+
 // CctpKeeper defines the expected cctp keeper
 type CctpKeeper interface {
 	GetTokenPair(ctx sdk.Context, remoteDomain uint32, remoteToken []byte) (val cctptypes.TokenPair, found bool)
 }
+
+// CctpMsgServer defines the expected cctp msg server, used to burn inbound transfers
+type CctpMsgServer interface {
+	DepositForBurn(ctx context.Context, msg *cctptypes.MsgDepositForBurn) (*cctptypes.MsgDepositForBurnResponse, error)
+	DepositForBurnWithCaller(ctx context.Context, msg *cctptypes.MsgDepositForBurnWithCaller) (*cctptypes.MsgDepositForBurnWithCallerResponse, error)
+}
+
+// AccountKeeper defines the expected account keeper, used to sign the transactions of the simulation
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// MaxFeeBps is the maximum fee in basis points, i.e. the entire forwarded amount.
+const MaxFeeBps = 10_000
+
+// Compute returns the fee charged on an amount, which is the larger of its basis points
+// and the minimum fee for the denom of the amount.
+func (f Fee) Compute(amount sdk.Coin) sdk.Coin {
+	fee := amount.Amount.MulRaw(int64(f.Bps)).QuoRaw(MaxFeeBps)
+	if minFee := f.MinFees.AmountOf(amount.Denom); fee.LT(minFee) {
+		fee = minFee
+	}
+	return sdk.NewCoin(amount.Denom, fee)
+}
+
+func (f Fee) Validate() error {
+	if f.Bps > MaxFeeBps {
+		return fmt.Errorf("fee cannot be more than %d bps, got %d", MaxFeeBps, f.Bps)
+	}
+	return f.MinFees.Validate()
+}
+
+// FeeFor returns the fee charged on IBC forwards from a source domain over a channel.
+// The fee of the channel takes precedence over the fee of the source domain.
+func (p Params) FeeFor(sourceDomain uint32, channel string) (Fee, bool) {
+	for _, channelFee := range p.ChannelFees {
+		if channelFee.Channel == channel {
+			return channelFee.Fee, true
+		}
+	}
+
+	for _, sourceDomainFee := range p.SourceDomainFees {
+		if sourceDomainFee.SourceDomain == sourceDomain {
+			return sourceDomainFee.Fee, true
+		}
+	}
+
+	return Fee{}, false
+}
+
+func validateSourceDomainFees(i interface{}) error {
+	fees, ok := i.([]SourceDomainFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[uint32]struct{})
+	for _, fee := range fees {
+		if _, ok := seen[fee.SourceDomain]; ok {
+			return fmt.Errorf("duplicated fee for source domain %d", fee.SourceDomain)
+		}
+		seen[fee.SourceDomain] = struct{}{}
+
+		if err := fee.Fee.Validate(); err != nil {
+			return fmt.Errorf("invalid fee for source domain %d: %w", fee.SourceDomain, err)
+		}
+	}
+
+	return nil
+}
+
+func validateChannelFees(i interface{}) error {
+	fees, ok := i.([]ChannelFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]struct{})
+	for _, fee := range fees {
+		if err := host.ChannelIdentifierValidator(fee.Channel); err != nil {
+			return fmt.Errorf("invalid fee channel: %w", err)
+		}
+
+		if _, ok := seen[fee.Channel]; ok {
+			return fmt.Errorf("duplicated fee for channel %s", fee.Channel)
+		}
+		seen[fee.Channel] = struct{}{}
+
+		if err := fee.Fee.Validate(); err != nil {
+			return fmt.Errorf("invalid fee for channel %s: %w", fee.Channel, err)
+		}
+	}
+
+	return nil
+}
+
+func validateFeeCollector(i interface{}) error {
+	feeCollector, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if feeCollector == "" {
+		return nil
+	}
+
+	_, err := sdk.AccAddressFromBech32(feeCollector)
+	return err
+}
@@ -0,0 +1,817 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: router/fee.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Fee is charged on the minted coins of an IBC forward before they are
+// transferred
+// @param bps - fee in basis points of the forwarded amount
+// @param min_fees - minimum fee per denom
+type Fee struct {
+	Bps     uint32                                   `protobuf:"varint,1,opt,name=bps,proto3" json:"bps,omitempty" yaml:"bps"`
+	MinFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=min_fees,json=minFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_fees,omitempty" yaml:"min_fees"`
+}
+
+func (m *Fee) Reset()         { *m = Fee{} }
+func (m *Fee) String() string { return proto.CompactTextString(m) }
+func (*Fee) ProtoMessage()    {}
+func (*Fee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a73909b1767ce38, []int{0}
+}
+func (m *Fee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Fee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Fee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fee.Merge(m, src)
+}
+func (m *Fee) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fee) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fee proto.InternalMessageInfo
+
+func (m *Fee) GetBps() uint32 {
+	if m != nil {
+		return m.Bps
+	}
+	return 0
+}
+
+func (m *Fee) GetMinFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinFees
+	}
+	return nil
+}
+
+// SourceDomainFee is the fee charged on IBC forwards from a source domain
+type SourceDomainFee struct {
+	SourceDomain uint32 `protobuf:"varint,1,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty" yaml:"source_domain"`
+	Fee          Fee    `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee" yaml:"fee"`
+}
+
+func (m *SourceDomainFee) Reset()         { *m = SourceDomainFee{} }
+func (m *SourceDomainFee) String() string { return proto.CompactTextString(m) }
+func (*SourceDomainFee) ProtoMessage()    {}
+func (*SourceDomainFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a73909b1767ce38, []int{1}
+}
+func (m *SourceDomainFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceDomainFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceDomainFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourceDomainFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceDomainFee.Merge(m, src)
+}
+func (m *SourceDomainFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceDomainFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceDomainFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceDomainFee proto.InternalMessageInfo
+
+func (m *SourceDomainFee) GetSourceDomain() uint32 {
+	if m != nil {
+		return m.SourceDomain
+	}
+	return 0
+}
+
+func (m *SourceDomainFee) GetFee() Fee {
+	if m != nil {
+		return m.Fee
+	}
+	return Fee{}
+}
+
+// ChannelFee is the fee charged on IBC forwards over a channel, it takes
+// precedence over the fee of the source domain
+type ChannelFee struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty" yaml:"channel"`
+	Fee     Fee    `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee" yaml:"fee"`
+}
+
+func (m *ChannelFee) Reset()         { *m = ChannelFee{} }
+func (m *ChannelFee) String() string { return proto.CompactTextString(m) }
+func (*ChannelFee) ProtoMessage()    {}
+func (*ChannelFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a73909b1767ce38, []int{2}
+}
+func (m *ChannelFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFee.Merge(m, src)
+}
+func (m *ChannelFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelFee proto.InternalMessageInfo
+
+func (m *ChannelFee) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ChannelFee) GetFee() Fee {
+	if m != nil {
+		return m.Fee
+	}
+	return Fee{}
+}
+
+func init() {
+	proto.RegisterType((*Fee)(nil), "noble.router.Fee")
+	proto.RegisterType((*SourceDomainFee)(nil), "noble.router.SourceDomainFee")
+	proto.RegisterType((*ChannelFee)(nil), "noble.router.ChannelFee")
+}
+
+func init() { proto.RegisterFile("router/fee.proto", fileDescriptor_5a73909b1767ce38) }
+
+var fileDescriptor_5a73909b1767ce38 = []byte{
+	// 430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xb3, 0x8d, 0x44, 0x61, 0xdb, 0x50, 0x6a, 0x55, 0x22, 0xed, 0xc1, 0x8e, 0xf6, 0x42,
+	0x0e, 0x64, 0x57, 0x69, 0x6f, 0x20, 0x21, 0xe1, 0xa2, 0x3c, 0x80, 0x11, 0x12, 0x70, 0xa9, 0xbc,
+	0xee, 0x24, 0xb5, 0xc8, 0xee, 0x5a, 0xde, 0x4d, 0x44, 0x9e, 0xa2, 0x3c, 0x07, 0xbc, 0x48, 0xc5,
+	0xa9, 0x47, 0x4e, 0x06, 0x25, 0x37, 0x1f, 0x79, 0x02, 0xe4, 0xdd, 0x8d, 0x88, 0xcf, 0x3d, 0x79,
+	0xfc, 0xcf, 0xcc, 0xef, 0x6f, 0x3c, 0x83, 0x9f, 0x95, 0x6a, 0x61, 0xa0, 0x64, 0x53, 0x00, 0x5a,
+	0x94, 0xca, 0xa8, 0xe0, 0x50, 0x2a, 0x3e, 0x07, 0xea, 0xf4, 0xb3, 0x30, 0x53, 0x5a, 0x28, 0xcd,
+	0x78, 0xaa, 0x81, 0x2d, 0xc7, 0x1c, 0x4c, 0x3a, 0x66, 0x99, 0xca, 0xa5, 0xab, 0x3e, 0x3b, 0x99,
+	0xa9, 0x99, 0xb2, 0x21, 0x6b, 0x22, 0xa7, 0x92, 0x9f, 0x08, 0x77, 0x27, 0x00, 0xc1, 0x18, 0x77,
+	0x79, 0xa1, 0xfb, 0x68, 0x80, 0x86, 0xbd, 0x38, 0xaa, 0xab, 0xa8, 0xc7, 0x0b, 0xfd, 0x52, 0x89,
+	0xdc, 0x80, 0x28, 0xcc, 0xea, 0x6f, 0x15, 0xe1, 0x55, 0x2a, 0xe6, 0xaf, 0x08, 0x2f, 0x34, 0x49,
+	0x9a, 0xda, 0xe0, 0x16, 0xe1, 0xc7, 0x22, 0x97, 0x57, 0x53, 0x00, 0xdd, 0xdf, 0x1b, 0x74, 0x87,
+	0x07, 0xe7, 0xa7, 0xd4, 0x41, 0xd0, 0x06, 0x82, 0x7a, 0x08, 0x7a, 0xa9, 0x72, 0x19, 0x7f, 0xba,
+	0xab, 0xa2, 0x4e, 0x5d, 0x45, 0xc1, 0xb6, 0xa5, 0x65, 0x7e, 0xe4, 0xcc, 0xb7, 0x39, 0xf2, 0xfd,
+	0x77, 0x34, 0x9c, 0xe5, 0xe6, 0x66, 0xc1, 0x69, 0xa6, 0x04, 0xf3, 0xa3, 0xb9, 0xc7, 0x48, 0x5f,
+	0x7f, 0x61, 0x66, 0x55, 0x80, 0xb6, 0xce, 0x3a, 0xd9, 0x17, 0xb9, 0x9c, 0x34, 0x5d, 0x3f, 0x10,
+	0x3e, 0x7a, 0xaf, 0x16, 0x65, 0x06, 0xef, 0x94, 0x48, 0xad, 0x18, 0x7c, 0xc4, 0x3d, 0x6d, 0xa5,
+	0xab, 0x6b, 0xab, 0xf9, 0x11, 0x2f, 0xea, 0x2a, 0x7a, 0xde, 0x4a, 0xb4, 0x78, 0x4e, 0x1c, 0x4f,
+	0xab, 0x80, 0x24, 0x87, 0x7a, 0xc7, 0x3c, 0x78, 0x83, 0xbb, 0x53, 0x80, 0xfe, 0xde, 0x00, 0x0d,
+	0x0f, 0xce, 0x8f, 0xe9, 0xee, 0x32, 0xe8, 0x04, 0x20, 0x3e, 0xf5, 0x13, 0x37, 0x55, 0xff, 0xff,
+	0xdf, 0x14, 0x80, 0x24, 0x8d, 0x44, 0x6e, 0x11, 0xc6, 0x97, 0x37, 0xa9, 0x94, 0x30, 0x6f, 0x40,
+	0xdf, 0xe2, 0xfd, 0xcc, 0xbd, 0x59, 0xc4, 0x27, 0xf1, 0x8b, 0xba, 0x8a, 0x8e, 0xbd, 0xd4, 0x82,
+	0x7b, 0xea, 0x9c, 0x7c, 0x8a, 0x24, 0xdb, 0xbe, 0x87, 0x12, 0xc5, 0x1f, 0xee, 0xd6, 0x21, 0xba,
+	0x5f, 0x87, 0xe8, 0xcf, 0x3a, 0x44, 0xdf, 0x36, 0x61, 0xe7, 0x7e, 0x13, 0x76, 0x7e, 0x6d, 0xc2,
+	0xce, 0xe7, 0xd7, 0x3b, 0xcb, 0xd0, 0xa6, 0x4c, 0xe5, 0x0c, 0xe6, 0x6a, 0x09, 0xa3, 0x25, 0x48,
+	0xb3, 0x28, 0x41, 0x33, 0xfb, 0xad, 0x91, 0x3f, 0xd1, 0xaf, 0xcc, 0x07, 0x76, 0x4b, 0xfc, 0x91,
+	0x3d, 0xb5, 0x8b, 0x7f, 0x03, 0x00, 0xd2, 0xdd, 0x86, 0xea, 0xc2, 0x02, 0x00, 0x00,
+}
+
+func (m *Fee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinFees) > 0 {
+		for iNdEx := len(m.MinFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Bps != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.Bps))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SourceDomainFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceDomainFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceDomainFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SourceDomain != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Fee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bps != 0 {
+		n += 1 + sovFee(uint64(m.Bps))
+	}
+	if len(m.MinFees) > 0 {
+		for _, e := range m.MinFees {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SourceDomainFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceDomain != 0 {
+		n += 1 + sovFee(uint64(m.SourceDomain))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovFee(uint64(l))
+	return n
+}
+
+func (m *ChannelFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovFee(uint64(l))
+	return n
+}
+
+func sovFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFee(x uint64) (n int) {
+	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Fee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bps", wireType)
+			}
+			m.Bps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFees = append(m.MinFees, types.Coin{})
+			if err := m.MinFees[len(m.MinFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceDomainFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceDomainFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceDomainFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFee = fmt.Errorf("proto: unexpected end of group")
+)
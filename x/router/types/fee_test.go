@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/noble/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeCompute(t *testing.T) {
+	fee := Fee{Bps: 25, MinFees: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100))}
+
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 250), fee.Compute(sdk.NewInt64Coin("uusdc", 100000)))
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 100), fee.Compute(sdk.NewInt64Coin("uusdc", 1000)))
+	require.Equal(t, sdk.NewInt64Coin("ueurc", 2), fee.Compute(sdk.NewInt64Coin("ueurc", 1000)))
+}
+
+func TestParamsValidateFees(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		params func(p *Params)
+		err    bool
+	}{
+		{
+			name:   "valid fees",
+			params: func(p *Params) {},
+		},
+		{
+			name: "fees without fee collector",
+			params: func(p *Params) {
+				p.FeeCollector = ""
+			},
+			err: true,
+		},
+		{
+			name: "invalid fee collector",
+			params: func(p *Params) {
+				p.FeeCollector = "invalid_address"
+			},
+			err: true,
+		},
+		{
+			name: "bps above maximum",
+			params: func(p *Params) {
+				p.SourceDomainFees[0].Fee.Bps = MaxFeeBps + 1
+			},
+			err: true,
+		},
+		{
+			name: "duplicated source domain",
+			params: func(p *Params) {
+				p.SourceDomainFees = append(p.SourceDomainFees, p.SourceDomainFees[0])
+			},
+			err: true,
+		},
+		{
+			name: "invalid channel",
+			params: func(p *Params) {
+				p.ChannelFees[0].Channel = "invalid"
+			},
+			err: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.FeeCollector = sample.AccAddress()
+			params.SourceDomainFees = []SourceDomainFee{{SourceDomain: 1, Fee: Fee{Bps: 10}}}
+			params.ChannelFees = []ChannelFee{{Channel: "channel-10", Fee: Fee{Bps: 10}}}
+			tt.params(&params)
+
+			err := params.Validate()
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
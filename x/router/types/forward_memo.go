@@ -0,0 +1,93 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// MaxForwardHops is the maximum number of further hops of an IBC forward.
+const MaxForwardHops = 4
+
+// pfmMemo is the memo understood by packet-forward-middleware, see
+// https://github.com/strangelove-ventures/packet-forward-middleware
+type pfmMemo struct {
+	Forward pfmForward `json:"forward"`
+}
+
+type pfmForward struct {
+	Receiver string          `json:"receiver"`
+	Port     string          `json:"port"`
+	Channel  string          `json:"channel"`
+	Timeout  uint64          `json:"timeout,omitempty"`
+	Next     json.RawMessage `json:"next,omitempty"`
+}
+
+// TransferMemo returns the memo of the IBC transfer packet of a forward. Forwards with hops nest a
+// packet-forward-middleware forward memo per hop, and the memo of the forward, which must then be a
+// JSON object, is passed on to the last hop.
+func (m *IBCForwardMetadata) TransferMemo() (string, error) {
+	if len(m.Hops) == 0 {
+		return m.Memo, nil
+	}
+
+	var next json.RawMessage
+	if m.Memo != "" {
+		next = json.RawMessage(m.Memo)
+	}
+
+	for i := len(m.Hops) - 1; i >= 0; i-- {
+		hop := m.Hops[i]
+
+		bz, err := json.Marshal(pfmMemo{
+			Forward: pfmForward{
+				Receiver: hop.Receiver,
+				Port:     "transfer",
+				Channel:  hop.Channel,
+				Timeout:  hop.TimeoutInNanoseconds,
+				Next:     next,
+			},
+		})
+		if err != nil {
+			return "", fmt.Errorf("unable to compose memo of hop %d: %w", i, err)
+		}
+		next = bz
+	}
+
+	if len(next) > MaxMemoLength {
+		return "", fmt.Errorf("composed memo cannot be longer than %d bytes, got %d", MaxMemoLength, len(next))
+	}
+
+	return string(next), nil
+}
+
+// validateHops ensures that the hops of a forward can be composed into a well formed memo.
+func (m *IBCForwardMetadata) validateHops() error {
+	if len(m.Hops) == 0 {
+		return nil
+	}
+
+	if len(m.Hops) > MaxForwardHops {
+		return fmt.Errorf("cannot have more than %d hops, got %d", MaxForwardHops, len(m.Hops))
+	}
+
+	for i, hop := range m.Hops {
+		if err := host.ChannelIdentifierValidator(hop.Channel); err != nil {
+			return fmt.Errorf("invalid channel identifier of hop %d: %w", i, err)
+		}
+		if hop.Receiver == "" {
+			return fmt.Errorf("receiver of hop %d cannot be an empty string", i)
+		}
+	}
+
+	if m.Memo != "" {
+		var next map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(m.Memo), &next); err != nil {
+			return fmt.Errorf("memo of a forward with hops must be a JSON object: %w", err)
+		}
+	}
+
+	_, err := m.TransferMemo()
+	return err
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferMemo(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		metadata IBCForwardMetadata
+		expected string
+	}{
+		{
+			desc:     "no hops",
+			metadata: IBCForwardMetadata{Memo: "Hello, World!"},
+			expected: "Hello, World!",
+		},
+		{
+			desc: "single hop",
+			metadata: IBCForwardMetadata{
+				Hops: []ForwardHop{{Channel: "channel-1", Receiver: "osmo1receiver"}},
+			},
+			expected: `{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-1"}}`,
+		},
+		{
+			desc: "nested hops with memo",
+			metadata: IBCForwardMetadata{
+				Memo: `{"wasm":{"contract":"osmo1contract"}}`,
+				Hops: []ForwardHop{
+					{Channel: "channel-1", Receiver: "osmo1receiver", TimeoutInNanoseconds: 600000000000},
+					{Channel: "channel-2", Receiver: "juno1receiver"},
+				},
+			},
+			expected: `{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-1","timeout":600000000000,` +
+				`"next":{"forward":{"receiver":"juno1receiver","port":"transfer","channel":"channel-2","next":{"wasm":{"contract":"osmo1contract"}}}}}}`,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			memo, err := tc.metadata.TransferMemo()
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, memo)
+		})
+	}
+}
+
+func TestValidateHops(t *testing.T) {
+	hop := ForwardHop{Channel: "channel-1", Receiver: "osmo1receiver"}
+
+	for _, tc := range []struct {
+		desc     string
+		metadata IBCForwardMetadata
+		err      string
+	}{
+		{
+			desc:     "valid",
+			metadata: IBCForwardMetadata{Memo: `{"wasm":{}}`, Hops: []ForwardHop{hop}},
+		},
+		{
+			desc:     "too many hops",
+			metadata: IBCForwardMetadata{Hops: []ForwardHop{hop, hop, hop, hop, hop}},
+			err:      "cannot have more than",
+		},
+		{
+			desc:     "invalid channel",
+			metadata: IBCForwardMetadata{Hops: []ForwardHop{{Channel: "invalid", Receiver: "osmo1receiver"}}},
+			err:      "invalid channel identifier of hop 0",
+		},
+		{
+			desc:     "empty receiver",
+			metadata: IBCForwardMetadata{Hops: []ForwardHop{{Channel: "channel-1"}}},
+			err:      "receiver of hop 0 cannot be an empty string",
+		},
+		{
+			desc:     "memo is not a JSON object",
+			metadata: IBCForwardMetadata{Memo: "Hello, World!", Hops: []ForwardHop{hop}},
+			err:      "must be a JSON object",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.metadata.validateHops()
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
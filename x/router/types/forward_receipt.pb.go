@@ -0,0 +1,648 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: router/forward_receipt.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ForwardStatus is the derived state of a transfer identified by its source
+// domain and nonce
+type ForwardStatus int32
+
+const (
+	// the transfer is unknown to the router
+	FORWARD_STATUS_UNSPECIFIED ForwardStatus = 0
+	// the IBC forward metadata is stored, the mint has not been received yet
+	FORWARD_STATUS_AWAITING_MINT ForwardStatus = 1
+	// the mint is stored, the IBC forward metadata has not been received yet
+	FORWARD_STATUS_AWAITING_METADATA ForwardStatus = 2
+	// the IBC transfer packet has been sent and is awaiting acknowledgement
+	FORWARD_STATUS_IN_FLIGHT ForwardStatus = 3
+	// the IBC transfer packet was acknowledged with an error
+	FORWARD_STATUS_ACK_ERROR ForwardStatus = 4
+	// the IBC transfer packet timed out and will be retried
+	FORWARD_STATUS_RETRY_PENDING ForwardStatus = 5
+	// the IBC forward ran out of retries
+	FORWARD_STATUS_FAILED ForwardStatus = 6
+	// the mint was pruned before IBC forward metadata was received
+	FORWARD_STATUS_PRUNED ForwardStatus = 7
+	// the IBC transfer packet was acknowledged successfully
+	FORWARD_STATUS_COMPLETED ForwardStatus = 8
+	// the funds of a failed IBC forward were claimed by its fallback recipient
+	FORWARD_STATUS_CLAIMED ForwardStatus = 9
+	// the IBC forward is held back until routing is unpaused
+	FORWARD_STATUS_HELD ForwardStatus = 10
+	// the IBC forward is queued until its rate limits have capacity for it
+	FORWARD_STATUS_RATE_LIMITED ForwardStatus = 11
+	// the mint was received by another submitter than the required submitter,
+	// the IBC forward awaits pairing by the required submitter
+	FORWARD_STATUS_UNPAIRED ForwardStatus = 12
+)
+
+var ForwardStatus_name = map[int32]string{
+	0:  "FORWARD_STATUS_UNSPECIFIED",
+	1:  "FORWARD_STATUS_AWAITING_MINT",
+	2:  "FORWARD_STATUS_AWAITING_METADATA",
+	3:  "FORWARD_STATUS_IN_FLIGHT",
+	4:  "FORWARD_STATUS_ACK_ERROR",
+	5:  "FORWARD_STATUS_RETRY_PENDING",
+	6:  "FORWARD_STATUS_FAILED",
+	7:  "FORWARD_STATUS_PRUNED",
+	8:  "FORWARD_STATUS_COMPLETED",
+	9:  "FORWARD_STATUS_CLAIMED",
+	10: "FORWARD_STATUS_HELD",
+	11: "FORWARD_STATUS_RATE_LIMITED",
+	12: "FORWARD_STATUS_UNPAIRED",
+}
+
+var ForwardStatus_value = map[string]int32{
+	"FORWARD_STATUS_UNSPECIFIED":       0,
+	"FORWARD_STATUS_AWAITING_MINT":     1,
+	"FORWARD_STATUS_AWAITING_METADATA": 2,
+	"FORWARD_STATUS_IN_FLIGHT":         3,
+	"FORWARD_STATUS_ACK_ERROR":         4,
+	"FORWARD_STATUS_RETRY_PENDING":     5,
+	"FORWARD_STATUS_FAILED":            6,
+	"FORWARD_STATUS_PRUNED":            7,
+	"FORWARD_STATUS_COMPLETED":         8,
+	"FORWARD_STATUS_CLAIMED":           9,
+	"FORWARD_STATUS_HELD":              10,
+	"FORWARD_STATUS_RATE_LIMITED":      11,
+	"FORWARD_STATUS_UNPAIRED":          12,
+}
+
+func (x ForwardStatus) String() string {
+	return proto.EnumName(ForwardStatus_name, int32(x))
+}
+
+func (ForwardStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a3462fff30806b70, []int{0}
+}
+
+// ForwardReceipt is kept once a transfer reaches a final state and its mint
+// and IBC forward metadata are removed, it is pruned MintPruneBlocks after
+// its height
+// @param source_domain
+// @param nonce
+// @param status - final status of the transfer
+// @param height - height at which the final status was reached
+// @param port - source port of the acknowledged packet, if completed
+// @param channel - source channel of the acknowledged packet, if completed
+// @param sequence - sequence of the acknowledged packet, if completed
+type ForwardReceipt struct {
+	SourceDomain uint32        `protobuf:"varint,1,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
+	Nonce        uint64        `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Status       ForwardStatus `protobuf:"varint,3,opt,name=status,proto3,enum=noble.router.ForwardStatus" json:"status,omitempty"`
+	Height       uint64        `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Port         string        `protobuf:"bytes,5,opt,name=port,proto3" json:"port,omitempty"`
+	Channel      string        `protobuf:"bytes,6,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sequence     uint64        `protobuf:"varint,7,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *ForwardReceipt) Reset()         { *m = ForwardReceipt{} }
+func (m *ForwardReceipt) String() string { return proto.CompactTextString(m) }
+func (*ForwardReceipt) ProtoMessage()    {}
+func (*ForwardReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3462fff30806b70, []int{0}
+}
+func (m *ForwardReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardReceipt.Merge(m, src)
+}
+func (m *ForwardReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardReceipt proto.InternalMessageInfo
+
+func (m *ForwardReceipt) GetSourceDomain() uint32 {
+	if m != nil {
+		return m.SourceDomain
+	}
+	return 0
+}
+
+func (m *ForwardReceipt) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *ForwardReceipt) GetStatus() ForwardStatus {
+	if m != nil {
+		return m.Status
+	}
+	return FORWARD_STATUS_UNSPECIFIED
+}
+
+func (m *ForwardReceipt) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ForwardReceipt) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *ForwardReceipt) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ForwardReceipt) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("noble.router.ForwardStatus", ForwardStatus_name, ForwardStatus_value)
+	proto.RegisterType((*ForwardReceipt)(nil), "noble.router.ForwardReceipt")
+}
+
+func init() { proto.RegisterFile("router/forward_receipt.proto", fileDescriptor_a3462fff30806b70) }
+
+var fileDescriptor_a3462fff30806b70 = []byte{
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x4f, 0x8f, 0xd2, 0x40,
+	0x18, 0xc6, 0xe9, 0xf2, 0x6f, 0x77, 0x84, 0xcd, 0x64, 0x5c, 0x77, 0x2b, 0x90, 0xda, 0xa8, 0x07,
+	0x62, 0xb2, 0x90, 0xb8, 0x47, 0x4f, 0x23, 0x33, 0xb0, 0x13, 0x4b, 0x21, 0x43, 0xc9, 0x46, 0x2f,
+	0x0d, 0xb0, 0x23, 0x90, 0xb0, 0x1d, 0x9c, 0x4e, 0x57, 0xfd, 0x06, 0x1e, 0xfd, 0x0c, 0xfa, 0x65,
+	0x3c, 0xee, 0xd1, 0xa3, 0x81, 0x2f, 0x62, 0x68, 0xab, 0xd1, 0x8a, 0xb7, 0xf7, 0x79, 0x9f, 0xdf,
+	0xbc, 0xef, 0x93, 0xe6, 0x2d, 0x68, 0x28, 0x19, 0x69, 0xa1, 0xda, 0x6f, 0xa5, 0x7a, 0x3f, 0x51,
+	0xd7, 0xbe, 0x12, 0x33, 0xb1, 0x5c, 0xeb, 0xd6, 0x5a, 0x49, 0x2d, 0x51, 0x25, 0x90, 0xd3, 0x95,
+	0x68, 0x25, 0x4c, 0xed, 0x64, 0x2e, 0xe7, 0x32, 0x36, 0xda, 0xbb, 0x2a, 0x61, 0x1e, 0x6f, 0x0c,
+	0x70, 0xdc, 0x4d, 0x5e, 0xf3, 0xe4, 0x31, 0x7a, 0x02, 0xaa, 0xa1, 0x8c, 0xd4, 0x4c, 0xf8, 0xd7,
+	0xf2, 0x66, 0xb2, 0x0c, 0x4c, 0xc3, 0x36, 0x9a, 0x55, 0x5e, 0x49, 0x9a, 0x24, 0xee, 0xa1, 0x13,
+	0x50, 0x0c, 0x64, 0x30, 0x13, 0xe6, 0x81, 0x6d, 0x34, 0x0b, 0x3c, 0x11, 0xe8, 0x02, 0x94, 0x42,
+	0x3d, 0xd1, 0x51, 0x68, 0xe6, 0x6d, 0xa3, 0x79, 0xfc, 0xbc, 0xde, 0xfa, 0x33, 0x42, 0x2b, 0x5d,
+	0x34, 0x8a, 0x11, 0x9e, 0xa2, 0xe8, 0x14, 0x94, 0x16, 0x62, 0x39, 0x5f, 0x68, 0xb3, 0x10, 0xcf,
+	0x4a, 0x15, 0x42, 0xa0, 0xb0, 0x96, 0x4a, 0x9b, 0x45, 0xdb, 0x68, 0x1e, 0xf1, 0xb8, 0x46, 0x26,
+	0x28, 0xcf, 0x16, 0x93, 0x20, 0x10, 0x2b, 0xb3, 0x14, 0xb7, 0x7f, 0x49, 0x54, 0x03, 0x87, 0xa1,
+	0x78, 0x17, 0x89, 0x5d, 0xa6, 0x72, 0x3c, 0xe7, 0xb7, 0x7e, 0xf6, 0x25, 0x0f, 0xaa, 0x7f, 0xed,
+	0x46, 0x16, 0xa8, 0x75, 0x07, 0xfc, 0x0a, 0x73, 0xe2, 0x8f, 0x3c, 0xec, 0x8d, 0x47, 0xfe, 0xd8,
+	0x1d, 0x0d, 0x69, 0x87, 0x75, 0x19, 0x25, 0x30, 0x87, 0x6c, 0xd0, 0xc8, 0xf8, 0xf8, 0x0a, 0x33,
+	0x8f, 0xb9, 0x3d, 0xbf, 0xcf, 0x5c, 0x0f, 0x1a, 0xe8, 0x29, 0xb0, 0xff, 0x4b, 0x50, 0x0f, 0x13,
+	0xec, 0x61, 0x78, 0x80, 0x1a, 0xc0, 0xcc, 0x50, 0xcc, 0xf5, 0xbb, 0x0e, 0xeb, 0x5d, 0x7a, 0x30,
+	0xbf, 0xc7, 0xc5, 0x9d, 0x57, 0x3e, 0xe5, 0x7c, 0xc0, 0x61, 0x61, 0x4f, 0x06, 0x4e, 0x3d, 0xfe,
+	0xda, 0x1f, 0x52, 0x97, 0x30, 0xb7, 0x07, 0x8b, 0xe8, 0x21, 0x78, 0x90, 0x21, 0xba, 0x98, 0x39,
+	0x94, 0xc0, 0xd2, 0x1e, 0x6b, 0xc8, 0xc7, 0x2e, 0x25, 0xb0, 0xbc, 0x67, 0x6b, 0x67, 0xd0, 0x1f,
+	0x3a, 0xd4, 0xa3, 0x04, 0x1e, 0xa2, 0x1a, 0x38, 0xcd, 0xba, 0x0e, 0x66, 0x7d, 0x4a, 0xe0, 0x11,
+	0x3a, 0x03, 0xf7, 0x33, 0xde, 0x25, 0x75, 0x08, 0x04, 0xe8, 0x11, 0xa8, 0x67, 0xa3, 0x62, 0x8f,
+	0xfa, 0x0e, 0xeb, 0xb3, 0xdd, 0xd4, 0x7b, 0xa8, 0x0e, 0xce, 0xfe, 0xf9, 0xde, 0x43, 0xcc, 0x38,
+	0x25, 0xb0, 0x52, 0x2b, 0x7c, 0xfa, 0x6a, 0xe5, 0x5e, 0x8e, 0xbf, 0x6d, 0x2c, 0xe3, 0x6e, 0x63,
+	0x19, 0x3f, 0x36, 0x96, 0xf1, 0x79, 0x6b, 0xe5, 0xee, 0xb6, 0x56, 0xee, 0xfb, 0xd6, 0xca, 0xbd,
+	0x79, 0x31, 0x5f, 0xea, 0x45, 0x34, 0x6d, 0xcd, 0xe4, 0x4d, 0x3b, 0xd4, 0x6a, 0x12, 0xcc, 0xc5,
+	0x4a, 0xde, 0x8a, 0xf3, 0x5b, 0x11, 0xe8, 0x48, 0x89, 0xb0, 0x1d, 0x1f, 0xd9, 0x79, 0xfa, 0x2f,
+	0x7c, 0x68, 0xa7, 0x85, 0xfe, 0xb8, 0x16, 0xe1, 0xb4, 0x14, 0xdf, 0xf9, 0xc5, 0xcf, 0x01, 0x00,
+	0xc7, 0x64, 0x6f, 0x83, 0x2b, 0x03, 0x00, 0x00,
+}
+
+func (m *ForwardReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintForwardReceipt(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintForwardReceipt(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintForwardReceipt(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintForwardReceipt(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintForwardReceipt(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintForwardReceipt(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SourceDomain != 0 {
+		i = encodeVarintForwardReceipt(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintForwardReceipt(dAtA []byte, offset int, v uint64) int {
+	offset -= sovForwardReceipt(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ForwardReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceDomain != 0 {
+		n += 1 + sovForwardReceipt(uint64(m.SourceDomain))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovForwardReceipt(uint64(m.Nonce))
+	}
+	if m.Status != 0 {
+		n += 1 + sovForwardReceipt(uint64(m.Status))
+	}
+	if m.Height != 0 {
+		n += 1 + sovForwardReceipt(uint64(m.Height))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovForwardReceipt(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovForwardReceipt(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovForwardReceipt(uint64(m.Sequence))
+	}
+	return n
+}
+
+func sovForwardReceipt(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozForwardReceipt(x uint64) (n int) {
+	return sovForwardReceipt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ForwardReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForwardReceipt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwardReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwardReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwardReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ForwardStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwardReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwardReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForwardReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwardReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwardReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForwardReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwardReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwardReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForwardReceipt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthForwardReceipt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipForwardReceipt(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowForwardReceipt
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForwardReceipt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForwardReceipt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthForwardReceipt
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupForwardReceipt
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthForwardReceipt
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthForwardReceipt        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowForwardReceipt          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupForwardReceipt = fmt.Errorf("proto: unexpected end of group")
+)
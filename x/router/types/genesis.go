@@ -50,6 +50,43 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	allowedChannelsIndexMap := make(map[string]struct{})
+	for _, elem := range gs.AllowedChannels {
+		if _, ok := allowedChannelsIndexMap[elem.Channel]; ok {
+			return fmt.Errorf("duplicated index for AllowedChannels")
+		}
+		allowedChannelsIndexMap[elem.Channel] = struct{}{}
+
+		// Validate the element to ensure semantic correctness
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+	}
+
+	sourceDomainRateLimitsIndexMap := make(map[uint32]struct{})
+	for _, elem := range gs.SourceDomainRateLimits {
+		if _, ok := sourceDomainRateLimitsIndexMap[elem.SourceDomain]; ok {
+			return fmt.Errorf("duplicated index for SourceDomainRateLimits")
+		}
+		sourceDomainRateLimitsIndexMap[elem.SourceDomain] = struct{}{}
+
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+	}
+
+	channelRateLimitsIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ChannelRateLimits {
+		if _, ok := channelRateLimitsIndexMap[elem.Channel]; ok {
+			return fmt.Errorf("duplicated index for ChannelRateLimits")
+		}
+		channelRateLimitsIndexMap[elem.Channel] = struct{}{}
+
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+	}
+
 	// Check for duplicated index in mints
 	mintsIndexMap := make(map[string]struct{})
 	for _, elem := range gs.Mints {
@@ -63,12 +100,6 @@ func (gs GenesisState) Validate() error {
 		if err := elem.Validate(); err != nil {
 			return err
 		}
-
-		// ensure that this mint source domain sender is in AllowedSourceDomainSenders
-		allowedSourceDomainSenderKey := hex.EncodeToString(SourceDomainSenderKey(elem.SourceDomain, elem.SourceDomainSender))
-		if _, ok := allowedSourceDomainSendersIndexMap[allowedSourceDomainSenderKey]; !ok {
-			return fmt.Errorf("mint source domain sender not found in AllowedSourceDomainSenders")
-		}
 	}
 
 	// Check for duplicated index in ibcForwards
@@ -86,11 +117,47 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Check for duplicated index in forwardReceipts
+	forwardReceiptsIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ForwardReceipts {
+		index := hex.EncodeToString(LookupKey(elem.SourceDomain, elem.Nonce))
+		if _, ok := forwardReceiptsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for ForwardReceipts")
+		}
+		forwardReceiptsIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in heldForwards
+	heldForwardsIndexMap := make(map[string]struct{})
+	for _, elem := range gs.HeldForwards {
+		index := hex.EncodeToString(LookupKey(elem.SourceDomain, elem.Nonce))
+		if _, ok := heldForwardsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for HeldForwards")
+		}
+		heldForwardsIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in rateLimitedForwards
+	rateLimitedForwardsIndexMap := make(map[string]struct{})
+	for _, elem := range gs.RateLimitedForwards {
+		index := hex.EncodeToString(LookupKey(elem.SourceDomain, elem.Nonce))
+		if _, ok := rateLimitedForwardsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for RateLimitedForwards")
+		}
+		rateLimitedForwardsIndexMap[index] = struct{}{}
+	}
+
 	if gs.Owner != "" {
 		if _, err := sdk.AccAddressFromBech32(gs.Owner); err != nil {
 			return err
 		}
 	}
 
+	if gs.Pauser != "" {
+		if _, err := sdk.AccAddressFromBech32(gs.Pauser); err != nil {
+			return err
+		}
+	}
+
 	return gs.Params.Validate()
 }
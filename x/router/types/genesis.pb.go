@@ -31,6 +31,15 @@ type GenesisState struct {
 	InFlightPackets            []InFlightPacket            `protobuf:"bytes,5,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets"`
 	AllowedSourceDomainSenders []AllowedSourceDomainSender `protobuf:"bytes,6,rep,name=allowed_source_domain_senders,json=allowedSourceDomainSenders,proto3" json:"allowed_source_domain_senders"`
 	Owner                      string                      `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	ForwardReceipts            []ForwardReceipt            `protobuf:"bytes,8,rep,name=forward_receipts,json=forwardReceipts,proto3" json:"forward_receipts"`
+	Pauser                     string                      `protobuf:"bytes,9,opt,name=pauser,proto3" json:"pauser,omitempty"`
+	RoutingPaused              bool                        `protobuf:"varint,10,opt,name=routing_paused,json=routingPaused,proto3" json:"routing_paused,omitempty"`
+	PausedSourceDomains        []uint32                    `protobuf:"varint,11,rep,packed,name=paused_source_domains,json=pausedSourceDomains,proto3" json:"paused_source_domains,omitempty"`
+	HeldForwards               []HeldForward               `protobuf:"bytes,12,rep,name=held_forwards,json=heldForwards,proto3" json:"held_forwards"`
+	AllowedChannels            []AllowedChannel            `protobuf:"bytes,13,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels"`
+	SourceDomainRateLimits     []SourceDomainRateLimit     `protobuf:"bytes,14,rep,name=source_domain_rate_limits,json=sourceDomainRateLimits,proto3" json:"source_domain_rate_limits"`
+	ChannelRateLimits          []ChannelRateLimit          `protobuf:"bytes,15,rep,name=channel_rate_limits,json=channelRateLimits,proto3" json:"channel_rate_limits"`
+	RateLimitedForwards        []RateLimitedForward        `protobuf:"bytes,16,rep,name=rate_limited_forwards,json=rateLimitedForwards,proto3" json:"rate_limited_forwards"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +117,69 @@ func (m *GenesisState) GetOwner() string {
 	return ""
 }
 
+func (m *GenesisState) GetForwardReceipts() []ForwardReceipt {
+	if m != nil {
+		return m.ForwardReceipts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPauser() string {
+	if m != nil {
+		return m.Pauser
+	}
+	return ""
+}
+
+func (m *GenesisState) GetRoutingPaused() bool {
+	if m != nil {
+		return m.RoutingPaused
+	}
+	return false
+}
+
+func (m *GenesisState) GetPausedSourceDomains() []uint32 {
+	if m != nil {
+		return m.PausedSourceDomains
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHeldForwards() []HeldForward {
+	if m != nil {
+		return m.HeldForwards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowedChannels() []AllowedChannel {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSourceDomainRateLimits() []SourceDomainRateLimit {
+	if m != nil {
+		return m.SourceDomainRateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelRateLimits() []ChannelRateLimit {
+	if m != nil {
+		return m.ChannelRateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitedForwards() []RateLimitedForward {
+	if m != nil {
+		return m.RateLimitedForwards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "noble.router.GenesisState")
 }
@@ -115,33 +187,47 @@ func init() {
 func init() { proto.RegisterFile("router/genesis.proto", fileDescriptor_5d6fb1a9cb128c80) }
 
 var fileDescriptor_5d6fb1a9cb128c80 = []byte{
-	// 412 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0xab, 0xd3, 0x40,
-	0x14, 0x85, 0x13, 0xfa, 0x5a, 0x31, 0x2d, 0xc8, 0x8b, 0x59, 0x84, 0xe0, 0x8b, 0x55, 0x10, 0xeb,
-	0xe2, 0x25, 0x50, 0x97, 0xae, 0xac, 0xf2, 0xe4, 0x2d, 0x2a, 0xa5, 0xc1, 0x8d, 0x9b, 0x30, 0x49,
-	0x6e, 0xd3, 0xc1, 0x64, 0x26, 0xcc, 0x4c, 0x5a, 0xfd, 0x17, 0xae, 0xfd, 0x45, 0x5d, 0x76, 0xe9,
-	0x4a, 0xa4, 0xfd, 0x23, 0xd2, 0x99, 0x5b, 0x68, 0x04, 0xdd, 0x4d, 0xee, 0xf9, 0xce, 0xc9, 0x9d,
-	0x93, 0x38, 0x9e, 0xe0, 0xad, 0x02, 0x11, 0x97, 0xc0, 0x40, 0x52, 0x19, 0x35, 0x82, 0x2b, 0xee,
-	0x8e, 0x18, 0xcf, 0x2a, 0x88, 0x8c, 0x16, 0x78, 0x25, 0x2f, 0xb9, 0x16, 0xe2, 0xd3, 0xc9, 0x30,
-	0xc1, 0x33, 0x74, 0xd2, 0x2c, 0x4f, 0x57, 0x5c, 0x6c, 0x89, 0x28, 0xd2, 0x1a, 0x14, 0x29, 0x88,
-	0x22, 0x88, 0xdc, 0x9c, 0x11, 0x96, 0xae, 0x2a, 0x5a, 0xae, 0x55, 0xda, 0x90, 0xfc, 0x0b, 0x28,
-	0x94, 0xaf, 0x51, 0xae, 0x29, 0x3b, 0x8f, 0x1e, 0xe3, 0xa8, 0x21, 0x82, 0xd4, 0xb8, 0x4d, 0xf0,
-	0x0a, 0x87, 0xa4, 0xaa, 0xf8, 0x16, 0x8a, 0x54, 0xf2, 0x56, 0xe4, 0x90, 0x16, 0xbc, 0x26, 0x94,
-	0xa5, 0x12, 0x58, 0x01, 0xc2, 0xa0, 0xcf, 0x7f, 0xf4, 0x9c, 0xd1, 0x07, 0x73, 0x95, 0x44, 0x11,
-	0x05, 0xee, 0xd4, 0x19, 0x98, 0x2c, 0xdf, 0x1e, 0xdb, 0x93, 0xe1, 0xd4, 0x8b, 0x2e, 0xaf, 0x16,
-	0x2d, 0xb4, 0x36, 0xbb, 0xda, 0xfd, 0x7a, 0x6a, 0x2d, 0x91, 0x74, 0x23, 0xa7, 0x7f, 0x5a, 0x49,
-	0xfa, 0xbd, 0x71, 0x6f, 0x32, 0x9c, 0xba, 0x5d, 0xcb, 0x9c, 0x32, 0x85, 0x06, 0x83, 0xb9, 0x1f,
-	0x9d, 0xd1, 0x45, 0x09, 0xd2, 0xbf, 0xd2, 0xb6, 0x17, 0x5d, 0x5b, 0xa2, 0xb8, 0x80, 0xfb, 0xd9,
-	0xbb, 0x3b, 0x43, 0xcd, 0xb1, 0x29, 0x4c, 0x1a, 0xd2, 0x2c, 0x47, 0xe5, 0x94, 0x77, 0xfd, 0x77,
-	0x63, 0xd2, 0xef, 0xeb, 0xd0, 0x27, 0xdd, 0xd0, 0x7b, 0x76, 0xa7, 0xa9, 0x85, 0x86, 0x30, 0xeb,
-	0x11, 0xed, 0x4c, 0xa5, 0xdb, 0x38, 0x37, 0xff, 0xab, 0x4e, 0xfa, 0x03, 0x9d, 0xfd, 0xb2, 0x9b,
-	0xfd, 0xd6, 0x58, 0x12, 0xed, 0x78, 0xaf, 0x0d, 0x89, 0xe6, 0xf1, 0x35, 0x01, 0xf9, 0x17, 0x20,
-	0x5d, 0xcf, 0xe9, 0xf3, 0x2d, 0x03, 0xe1, 0x3f, 0x18, 0xdb, 0x93, 0x87, 0x4b, 0xf3, 0x30, 0xfb,
-	0xb4, 0x3b, 0x84, 0xf6, 0xfe, 0x10, 0xda, 0xbf, 0x0f, 0xa1, 0xfd, 0xfd, 0x18, 0x5a, 0xfb, 0x63,
-	0x68, 0xfd, 0x3c, 0x86, 0xd6, 0xe7, 0x37, 0x25, 0x55, 0xeb, 0x36, 0x8b, 0x72, 0x5e, 0xc7, 0x52,
-	0x09, 0xc2, 0x4a, 0xa8, 0xf8, 0x06, 0x6e, 0x37, 0xc0, 0x54, 0x2b, 0x40, 0xc6, 0x7a, 0xb3, 0x5b,
-	0xfc, 0x0f, 0xbe, 0xc6, 0x78, 0x50, 0xdf, 0x1a, 0x90, 0xd9, 0x40, 0x7f, 0xfa, 0xd7, 0x7f, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xe2, 0xf1, 0xcd, 0x55, 0xcb, 0x02, 0x00, 0x00,
+	// 638 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4f, 0x4f, 0xdb, 0x4e,
+	0x10, 0x4d, 0xc4, 0x9f, 0x1f, 0x6c, 0x12, 0xfe, 0x6c, 0x02, 0x3f, 0x13, 0x81, 0x9b, 0xb6, 0x42,
+	0x4d, 0x0f, 0x24, 0x52, 0x7a, 0xec, 0xa9, 0x80, 0x68, 0x91, 0x4a, 0x85, 0x92, 0xf6, 0xc2, 0xc5,
+	0xda, 0xd8, 0x83, 0x63, 0xd5, 0xd9, 0x8d, 0x76, 0x36, 0xd0, 0x7e, 0x84, 0xde, 0xfa, 0xb1, 0x38,
+	0x72, 0xec, 0xa9, 0xaa, 0xe0, 0x8b, 0x54, 0x5e, 0x8f, 0x49, 0xec, 0xd2, 0xde, 0xec, 0xf7, 0xde,
+	0xbc, 0x9d, 0x79, 0x63, 0x2f, 0x6b, 0x68, 0x35, 0x35, 0xa0, 0xbb, 0x21, 0x48, 0xc0, 0x08, 0x3b,
+	0x13, 0xad, 0x8c, 0xe2, 0x55, 0xa9, 0x86, 0x31, 0x74, 0x52, 0xae, 0xd9, 0x08, 0x55, 0xa8, 0x2c,
+	0xd1, 0x4d, 0x9e, 0x52, 0x4d, 0x73, 0x97, 0x2a, 0x45, 0x1c, 0xab, 0x6b, 0x08, 0x3c, 0x7f, 0x24,
+	0xa4, 0x84, 0xb8, 0xc0, 0x5e, 0x2a, 0x7d, 0x2d, 0x74, 0xe0, 0x69, 0xf0, 0x21, 0x9a, 0x18, 0x62,
+	0x9f, 0x12, 0x1b, 0x0d, 0x7d, 0x2f, 0x53, 0x8c, 0xc1, 0x88, 0x40, 0x18, 0x41, 0x92, 0xbd, 0x4c,
+	0x22, 0xbd, 0xcb, 0x38, 0x0a, 0x47, 0xc6, 0x9b, 0x08, 0xff, 0x33, 0x64, 0x0e, 0x9b, 0x44, 0x8f,
+	0x23, 0x99, 0x41, 0x75, 0x82, 0x26, 0x42, 0x8b, 0x31, 0x4d, 0xd2, 0xe4, 0x0f, 0xe0, 0x14, 0x81,
+	0xb0, 0xff, 0x09, 0xd3, 0xc2, 0x80, 0x17, 0x47, 0xe3, 0x28, 0x73, 0x78, 0x59, 0x18, 0x09, 0xd5,
+	0x54, 0xfb, 0xe0, 0x05, 0x6a, 0x2c, 0x22, 0xe9, 0x21, 0xc8, 0x00, 0x74, 0x2a, 0x7d, 0xf6, 0x6d,
+	0x85, 0x55, 0xdf, 0xa6, 0x99, 0x0d, 0x8c, 0x30, 0xc0, 0x7b, 0x6c, 0x39, 0x3d, 0xd8, 0x29, 0xb7,
+	0xca, 0xed, 0x4a, 0xaf, 0xd1, 0x99, 0xcf, 0xb0, 0x73, 0x6e, 0xb9, 0xc3, 0xc5, 0x9b, 0x9f, 0x4f,
+	0x4a, 0x7d, 0x52, 0xf2, 0x0e, 0x5b, 0x4a, 0xfa, 0x47, 0x67, 0xa1, 0xb5, 0xd0, 0xae, 0xf4, 0x78,
+	0xbe, 0xe4, 0x2c, 0x92, 0x86, 0x0a, 0x52, 0x19, 0xff, 0xc0, 0xaa, 0x73, 0x89, 0xa1, 0xb3, 0x68,
+	0xcb, 0xf6, 0xf3, 0x65, 0x03, 0xa3, 0x34, 0x9c, 0x1e, 0x1e, 0x9d, 0xa4, 0xaa, 0x33, 0x8a, 0x95,
+	0x9c, 0x2a, 0xd1, 0xd0, 0x27, 0x26, 0xf1, 0xdb, 0x2c, 0xc6, 0x8b, 0xce, 0x92, 0x35, 0xdd, 0xcd,
+	0x9b, 0x9e, 0xca, 0x13, 0xab, 0x3a, 0xb7, 0x22, 0xf2, 0x5a, 0x8f, 0x72, 0x28, 0xf2, 0x09, 0xdb,
+	0xfb, 0x57, 0x74, 0xe8, 0x2c, 0x5b, 0xef, 0x17, 0x79, 0xef, 0x37, 0x69, 0xc9, 0xc0, 0x56, 0x1c,
+	0xdb, 0x82, 0x81, 0xd5, 0xd3, 0x31, 0x4d, 0xf1, 0x37, 0x01, 0xf2, 0x06, 0x5b, 0x52, 0xd7, 0x12,
+	0xb4, 0xf3, 0x5f, 0xab, 0xdc, 0x5e, 0xed, 0xa7, 0x2f, 0xfc, 0x8c, 0x6d, 0x14, 0xbe, 0x3b, 0x74,
+	0x56, 0x1e, 0x1b, 0x8b, 0x92, 0xe8, 0xa7, 0xa2, 0x6c, 0xac, 0xcb, 0x1c, 0x8a, 0x7c, 0x3b, 0x59,
+	0xed, 0x14, 0x41, 0x3b, 0xab, 0xf6, 0x14, 0x7a, 0xe3, 0xfb, 0x6c, 0x2d, 0xf1, 0x89, 0x64, 0xe8,
+	0x59, 0x24, 0x70, 0x58, 0xab, 0xdc, 0x5e, 0xe9, 0xd7, 0x08, 0x3d, 0xb7, 0x20, 0xef, 0xb1, 0xad,
+	0x94, 0xce, 0x87, 0x82, 0x4e, 0xa5, 0xb5, 0xd0, 0xae, 0xf5, 0xeb, 0x29, 0x39, 0x3f, 0x1d, 0xf2,
+	0x63, 0x56, 0x1b, 0x41, 0x1c, 0xcc, 0x56, 0x5d, 0xb5, 0xed, 0xef, 0xe4, 0xdb, 0x7f, 0x07, 0x71,
+	0x40, 0x23, 0x50, 0xef, 0xd5, 0xd1, 0x0c, 0xc2, 0x24, 0x87, 0xc2, 0xdf, 0x89, 0x4e, 0xed, 0xb1,
+	0x1c, 0x68, 0x05, 0x47, 0xa9, 0x28, 0xcb, 0x41, 0xe4, 0x50, 0xe4, 0x01, 0xdb, 0xc9, 0xaf, 0x75,
+	0xf6, 0x03, 0xa1, 0xb3, 0x66, 0x7d, 0x9f, 0x17, 0xbe, 0xc5, 0xb9, 0xa1, 0xfa, 0xc2, 0xc0, 0xfb,
+	0x44, 0x4b, 0xf6, 0xdb, 0xf8, 0x18, 0x89, 0xfc, 0x23, 0xab, 0x53, 0xb3, 0x39, 0xff, 0x75, 0xeb,
+	0xef, 0xe6, 0xfd, 0xa9, 0xb5, 0xa2, 0xf5, 0xa6, 0x5f, 0xc0, 0x91, 0x5f, 0xb0, 0xad, 0x99, 0x1b,
+	0xcc, 0x05, 0xbb, 0x61, 0x7d, 0x5b, 0x79, 0xdf, 0x87, 0x42, 0x28, 0xe4, 0x5b, 0xd7, 0x7f, 0x30,
+	0x78, 0xf8, 0xe9, 0xe6, 0xce, 0x2d, 0xdf, 0xde, 0xb9, 0xe5, 0x5f, 0x77, 0x6e, 0xf9, 0xfb, 0xbd,
+	0x5b, 0xba, 0xbd, 0x77, 0x4b, 0x3f, 0xee, 0xdd, 0xd2, 0xc5, 0xeb, 0x30, 0x32, 0xa3, 0xe9, 0xb0,
+	0xe3, 0xab, 0x71, 0x17, 0x8d, 0x16, 0x32, 0x84, 0x58, 0x5d, 0xc1, 0xc1, 0x15, 0x48, 0x33, 0xd5,
+	0x80, 0x5d, 0x7b, 0xea, 0x01, 0x5d, 0x3b, 0x5f, 0xba, 0xf4, 0x60, 0xbe, 0x4e, 0x00, 0x87, 0xcb,
+	0xf6, 0xa6, 0x79, 0xf5, 0x7b, 0x00, 0x5a, 0xdf, 0x82, 0x63, 0xa3, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -164,6 +250,127 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RateLimitedForwards) > 0 {
+		for iNdEx := len(m.RateLimitedForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitedForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ChannelRateLimits) > 0 {
+		for iNdEx := len(m.ChannelRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.SourceDomainRateLimits) > 0 {
+		for iNdEx := len(m.SourceDomainRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceDomainRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.HeldForwards) > 0 {
+		for iNdEx := len(m.HeldForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeldForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PausedSourceDomains) > 0 {
+		dAtA2 := make([]byte, len(m.PausedSourceDomains)*10)
+		var j1 int
+		for _, num := range m.PausedSourceDomains {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.RoutingPaused {
+		i--
+		if m.RoutingPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Pauser) > 0 {
+		i -= len(m.Pauser)
+		copy(dAtA[i:], m.Pauser)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Pauser)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ForwardReceipts) > 0 {
+		for iNdEx := len(m.ForwardReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -287,6 +494,56 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ForwardReceipts) > 0 {
+		for _, e := range m.ForwardReceipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Pauser)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RoutingPaused {
+		n += 2
+	}
+	if len(m.PausedSourceDomains) > 0 {
+		l = 0
+		for _, e := range m.PausedSourceDomains {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.HeldForwards) > 0 {
+		for _, e := range m.HeldForwards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, e := range m.AllowedChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SourceDomainRateLimits) > 0 {
+		for _, e := range m.SourceDomainRateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelRateLimits) > 0 {
+		for _, e := range m.ChannelRateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitedForwards) > 0 {
+		for _, e := range m.RateLimitedForwards {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -526,6 +783,338 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardReceipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardReceipts = append(m.ForwardReceipts, ForwardReceipt{})
+			if err := m.ForwardReceipts[len(m.ForwardReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pauser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RoutingPaused = bool(v != 0)
+		case 11:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PausedSourceDomains = append(m.PausedSourceDomains, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PausedSourceDomains) == 0 {
+					m.PausedSourceDomains = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PausedSourceDomains = append(m.PausedSourceDomains, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedSourceDomains", wireType)
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldForwards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeldForwards = append(m.HeldForwards, HeldForward{})
+			if err := m.HeldForwards[len(m.HeldForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, AllowedChannel{})
+			if err := m.AllowedChannels[len(m.AllowedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomainRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceDomainRateLimits = append(m.SourceDomainRateLimits, SourceDomainRateLimit{})
+			if err := m.SourceDomainRateLimits[len(m.SourceDomainRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelRateLimits = append(m.ChannelRateLimits, ChannelRateLimit{})
+			if err := m.ChannelRateLimits[len(m.ChannelRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitedForwards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitedForwards = append(m.RateLimitedForwards, RateLimitedForward{})
+			if err := m.RateLimitedForwards[len(m.RateLimitedForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -186,6 +186,47 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			// mints are recorded from the TokenMessenger of the source domain, which is not on the allowlist
+			desc: "mint of an unlisted sender",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Mints: []types.Mint{
+					{Amount: &sdk.Coin{Amount: sdk.OneInt(), Denom: "uusdc"}, MintRecipient: "cosmos1x8rynykqla7cnc0tf2f3xn0wa822ztt788yd5a", SourceDomain: 0, SourceDomainSender: []byte("12345678901234567890123456789012"), DestinationDomain: 4},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated held forwards",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				HeldForwards: []types.HeldForward{
+					{SourceDomain: 1, Nonce: 2},
+					{SourceDomain: 1, Nonce: 2},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated allowed channels",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AllowedChannels: []types.AllowedChannel{
+					{Channel: "channel-1", ChainLabel: "osmosis"},
+					{Channel: "channel-1", ChainLabel: "dydx"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid pauser",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Pauser: "invalid_address",
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
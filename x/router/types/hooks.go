@@ -0,0 +1,91 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RouterHooks defines the hooks called by the router on the lifecycle of mints and IBC forwards.
+//
+// Hooks are called in a cached context, their state changes are discarded when they return an error or panic.
+// Errors are logged by the router and never abort the mint or forward that triggered the hook.
+type RouterHooks interface {
+	// AfterMintRecorded is called after the mint of an incoming CCTP message is stored.
+	AfterMintRecorded(ctx sdk.Context, mint Mint) error
+	// AfterForwardSent is called after the IBC transfer packet of a forward is sent.
+	AfterForwardSent(ctx sdk.Context, inFlightPacket InFlightPacket, amount sdk.Coin) error
+	// AfterForwardAcked is called after the IBC transfer packet of a forward is acknowledged successfully.
+	AfterForwardAcked(ctx sdk.Context, inFlightPacket InFlightPacket) error
+	// AfterForwardFailed is called after a forward fails, either on an error acknowledgement or once it
+	// cannot be retried anymore.
+	AfterForwardFailed(ctx sdk.Context, sourceDomain uint32, nonce uint64, reason string) error
+}
+
+var _ RouterHooks = MultiRouterHooks{}
+
+// MultiRouterHooks combines multiple router hooks, which are called in order.
+// Every hook is called even if a previous one fails, each in its own cached context.
+type MultiRouterHooks []RouterHooks
+
+func NewMultiRouterHooks(hooks ...RouterHooks) MultiRouterHooks {
+	return hooks
+}
+
+func (h MultiRouterHooks) AfterMintRecorded(ctx sdk.Context, mint Mint) error {
+	return h.callAll(ctx, func(ctx sdk.Context, hooks RouterHooks) error {
+		return hooks.AfterMintRecorded(ctx, mint)
+	})
+}
+
+func (h MultiRouterHooks) AfterForwardSent(ctx sdk.Context, inFlightPacket InFlightPacket, amount sdk.Coin) error {
+	return h.callAll(ctx, func(ctx sdk.Context, hooks RouterHooks) error {
+		return hooks.AfterForwardSent(ctx, inFlightPacket, amount)
+	})
+}
+
+func (h MultiRouterHooks) AfterForwardAcked(ctx sdk.Context, inFlightPacket InFlightPacket) error {
+	return h.callAll(ctx, func(ctx sdk.Context, hooks RouterHooks) error {
+		return hooks.AfterForwardAcked(ctx, inFlightPacket)
+	})
+}
+
+func (h MultiRouterHooks) AfterForwardFailed(ctx sdk.Context, sourceDomain uint32, nonce uint64, reason string) error {
+	return h.callAll(ctx, func(ctx sdk.Context, hooks RouterHooks) error {
+		return hooks.AfterForwardFailed(ctx, sourceDomain, nonce, reason)
+	})
+}
+
+// callAll calls a hook of every router hooks, it returns the errors of all failed hooks.
+func (h MultiRouterHooks) callAll(ctx sdk.Context, call func(ctx sdk.Context, hooks RouterHooks) error) error {
+	var errs []error
+	for _, hooks := range h {
+		if err := CallHook(ctx, func(ctx sdk.Context) error { return call(ctx, hooks) }); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CallHook calls a hook in a cached context. Its state changes and events are only kept if it succeeds.
+// A panicking hook is recovered and returned as an error, unless it ran out of gas.
+func CallHook(ctx sdk.Context, hook func(ctx sdk.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				panic(r)
+			}
+			err = fmt.Errorf("hook panicked: %v", r)
+		}
+	}()
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := hook(cacheCtx); err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
@@ -5,7 +5,7 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -32,10 +32,30 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // @param channel
 // @param destination_receiver
 // @param ack_error
+// @param retries - number of times the forward has been retried after a timeout
+// @param next_retry_height - height at which the next retry is sent, zero if
+// no retry is pending
+// @param failed - set once the forward has run out of retries, the mint stays
+// claimable
+// @param fee - fee charged on the minted coins, it is only charged once so
+// that retries forward the same amount
+// @param relayer_tip - tip paid to the relayer that completed the pairing of
+// the mint and the forward, it is only paid once
+// @param recipient - recipient of the outer CCTP message
+// @param destination_caller - caller allowed to receive the outer CCTP message,
+// empty if any address can
 type StoreIBCForwardMetadata struct {
-	SourceDomain uint32              `protobuf:"varint,1,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
-	Metadata     *IBCForwardMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	AckError     bool                `protobuf:"varint,3,opt,name=ack_error,json=ackError,proto3" json:"ack_error,omitempty"`
+	SourceDomain      uint32              `protobuf:"varint,1,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
+	Metadata          *IBCForwardMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	AckError          bool                `protobuf:"varint,3,opt,name=ack_error,json=ackError,proto3" json:"ack_error,omitempty"`
+	Retries           uint64              `protobuf:"varint,4,opt,name=retries,proto3" json:"retries,omitempty"`
+	NextRetryHeight   uint64              `protobuf:"varint,5,opt,name=next_retry_height,json=nextRetryHeight,proto3" json:"next_retry_height,omitempty"`
+	Failed            bool                `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	Fee               *types.Coin         `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	RelayerTip        *types.Coin         `protobuf:"bytes,8,opt,name=relayer_tip,json=relayerTip,proto3" json:"relayer_tip,omitempty"`
+	Recipient         []byte              `protobuf:"bytes,9,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	DestinationCaller []byte              `protobuf:"bytes,10,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
+	Unpaired          bool                `protobuf:"varint,11,opt,name=unpaired,proto3" json:"unpaired,omitempty"`
 }
 
 func (m *StoreIBCForwardMetadata) Reset()         { *m = StoreIBCForwardMetadata{} }
@@ -92,6 +112,62 @@ func (m *StoreIBCForwardMetadata) GetAckError() bool {
 	return false
 }
 
+func (m *StoreIBCForwardMetadata) GetRetries() uint64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *StoreIBCForwardMetadata) GetNextRetryHeight() uint64 {
+	if m != nil {
+		return m.NextRetryHeight
+	}
+	return 0
+}
+
+func (m *StoreIBCForwardMetadata) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+func (m *StoreIBCForwardMetadata) GetFee() *types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *StoreIBCForwardMetadata) GetRelayerTip() *types.Coin {
+	if m != nil {
+		return m.RelayerTip
+	}
+	return nil
+}
+
+func (m *StoreIBCForwardMetadata) GetRecipient() []byte {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+func (m *StoreIBCForwardMetadata) GetDestinationCaller() []byte {
+	if m != nil {
+		return m.DestinationCaller
+	}
+	return nil
+}
+
+func (m *StoreIBCForwardMetadata) GetUnpaired() bool {
+	if m != nil {
+		return m.Unpaired
+	}
+	return false
+}
+
 // IBCForwardMetadata is the information a user includes in their
 // depositForBurnWithMetadata data field
 // TODO
@@ -100,13 +176,25 @@ func (m *StoreIBCForwardMetadata) GetAckError() bool {
 // @param destination_receiver
 // @param memo
 // @param timeout_in_nanoseconds
+// @param fallback_recipient - Noble address that can claim the minted funds
+// once the forward has failed
+// @param relayer_tip - amount of the minted funds paid to the relayer that
+// completes the pairing of the mint and the forward
+// @param hops - further hops through packet-forward-middleware, starting on
+// the chain of the destination receiver
+// @param required_submitter - Noble address that must submit the transaction
+// completing the pairing of the mint and the forward, empty if any address can
 type IBCForwardMetadata struct {
-	Nonce                uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Port                 string `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
-	Channel              string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
-	DestinationReceiver  string `protobuf:"bytes,4,opt,name=destination_receiver,json=destinationReceiver,proto3" json:"destination_receiver,omitempty"`
-	Memo                 string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
-	TimeoutInNanoseconds uint64 `protobuf:"varint,6,opt,name=timeout_in_nanoseconds,json=timeoutInNanoseconds,proto3" json:"timeout_in_nanoseconds,omitempty"`
+	Nonce                uint64       `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Port                 string       `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	Channel              string       `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	DestinationReceiver  string       `protobuf:"bytes,4,opt,name=destination_receiver,json=destinationReceiver,proto3" json:"destination_receiver,omitempty"`
+	Memo                 string       `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	TimeoutInNanoseconds uint64       `protobuf:"varint,6,opt,name=timeout_in_nanoseconds,json=timeoutInNanoseconds,proto3" json:"timeout_in_nanoseconds,omitempty"`
+	FallbackRecipient    string       `protobuf:"bytes,7,opt,name=fallback_recipient,json=fallbackRecipient,proto3" json:"fallback_recipient,omitempty"`
+	RelayerTip           uint64       `protobuf:"varint,8,opt,name=relayer_tip,json=relayerTip,proto3" json:"relayer_tip,omitempty"`
+	Hops                 []ForwardHop `protobuf:"bytes,9,rep,name=hops,proto3" json:"hops"`
+	RequiredSubmitter    string       `protobuf:"bytes,10,opt,name=required_submitter,json=requiredSubmitter,proto3" json:"required_submitter,omitempty"`
 }
 
 func (m *IBCForwardMetadata) Reset()         { *m = IBCForwardMetadata{} }
@@ -184,40 +272,151 @@ func (m *IBCForwardMetadata) GetTimeoutInNanoseconds() uint64 {
 	return 0
 }
 
+func (m *IBCForwardMetadata) GetFallbackRecipient() string {
+	if m != nil {
+		return m.FallbackRecipient
+	}
+	return ""
+}
+
+func (m *IBCForwardMetadata) GetRelayerTip() uint64 {
+	if m != nil {
+		return m.RelayerTip
+	}
+	return 0
+}
+
+func (m *IBCForwardMetadata) GetHops() []ForwardHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *IBCForwardMetadata) GetRequiredSubmitter() string {
+	if m != nil {
+		return m.RequiredSubmitter
+	}
+	return ""
+}
+
+// ForwardHop is a further hop of an IBC forward, it is composed into the
+// forward memo of packet-forward-middleware
+// @param channel - channel on the chain the hop is sent from
+// @param receiver - receiver on the chain the hop is sent to
+// @param timeout_in_nanoseconds - relative timeout of the hop, zero selects
+// the default timeout of packet-forward-middleware
+type ForwardHop struct {
+	Channel              string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Receiver             string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	TimeoutInNanoseconds uint64 `protobuf:"varint,3,opt,name=timeout_in_nanoseconds,json=timeoutInNanoseconds,proto3" json:"timeout_in_nanoseconds,omitempty"`
+}
+
+func (m *ForwardHop) Reset()         { *m = ForwardHop{} }
+func (m *ForwardHop) String() string { return proto.CompactTextString(m) }
+func (*ForwardHop) ProtoMessage()    {}
+func (*ForwardHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b6b29f4e31c7ab9, []int{2}
+}
+func (m *ForwardHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardHop.Merge(m, src)
+}
+func (m *ForwardHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardHop proto.InternalMessageInfo
+
+func (m *ForwardHop) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ForwardHop) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *ForwardHop) GetTimeoutInNanoseconds() uint64 {
+	if m != nil {
+		return m.TimeoutInNanoseconds
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StoreIBCForwardMetadata)(nil), "noble.router.StoreIBCForwardMetadata")
 	proto.RegisterType((*IBCForwardMetadata)(nil), "noble.router.IBCForwardMetadata")
+	proto.RegisterType((*ForwardHop)(nil), "noble.router.ForwardHop")
 }
 
 func init() { proto.RegisterFile("router/ibc_forward_metadata.proto", fileDescriptor_0b6b29f4e31c7ab9) }
 
 var fileDescriptor_0b6b29f4e31c7ab9 = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbf, 0x8e, 0xd4, 0x30,
-	0x10, 0xc6, 0xd7, 0xb0, 0x77, 0xec, 0x9a, 0xbb, 0xc6, 0x44, 0x10, 0x1d, 0x52, 0x14, 0x8e, 0x66,
-	0x9b, 0x8b, 0xb5, 0x40, 0x07, 0xd5, 0xf1, 0x47, 0xba, 0x02, 0x8a, 0x20, 0x1a, 0x9a, 0xc8, 0x71,
-	0x86, 0x9c, 0x75, 0x9b, 0x99, 0x95, 0xed, 0x04, 0x78, 0x0b, 0x5a, 0xde, 0x88, 0xf2, 0x1a, 0x24,
-	0x4a, 0xb4, 0xfb, 0x22, 0x28, 0x4e, 0x16, 0x56, 0xe2, 0xba, 0x6f, 0xe6, 0x1b, 0xcf, 0xf7, 0x93,
-	0x6d, 0xfe, 0xc8, 0x52, 0xeb, 0xc1, 0x4a, 0x53, 0xea, 0xe2, 0x13, 0xd9, 0xcf, 0xca, 0x56, 0x45,
-	0x03, 0x5e, 0x55, 0xca, 0xab, 0x6c, 0x6d, 0xc9, 0x93, 0x38, 0x42, 0x2a, 0x57, 0x90, 0x0d, 0x83,
-	0x27, 0x89, 0x26, 0xd7, 0x90, 0x93, 0xa5, 0x72, 0x20, 0xbb, 0x65, 0x09, 0x5e, 0x2d, 0xa5, 0x26,
-	0x83, 0xc3, 0xf4, 0x49, 0x54, 0x53, 0x4d, 0x41, 0xca, 0x5e, 0x0d, 0xdd, 0xd3, 0xef, 0x8c, 0x3f,
-	0x78, 0xef, 0xc9, 0xc2, 0xc5, 0xf9, 0xcb, 0x37, 0x43, 0xcc, 0xdb, 0x31, 0x45, 0x3c, 0xe6, 0xc7,
-	0x8e, 0x5a, 0xab, 0xa1, 0xa8, 0xa8, 0x51, 0x06, 0x63, 0x96, 0xb2, 0xc5, 0x71, 0x7e, 0x34, 0x34,
-	0x5f, 0x85, 0x9e, 0x78, 0xc1, 0x67, 0x3b, 0xac, 0xf8, 0x56, 0xca, 0x16, 0x77, 0x9f, 0xa4, 0xd9,
-	0x3e, 0x57, 0xf6, 0xff, 0xe2, 0xfc, 0xef, 0x09, 0xf1, 0x90, 0xcf, 0x95, 0xbe, 0x2a, 0xc0, 0x5a,
-	0xb2, 0xf1, 0xed, 0x94, 0x2d, 0x66, 0xf9, 0x4c, 0xe9, 0xab, 0xd7, 0x7d, 0x7d, 0xfa, 0x93, 0x71,
-	0x71, 0x03, 0x56, 0xc4, 0x0f, 0x90, 0x50, 0x43, 0xc0, 0x99, 0xe6, 0x43, 0x21, 0x04, 0x9f, 0xae,
-	0xc9, 0xfa, 0xc0, 0x30, 0xcf, 0x83, 0x16, 0x31, 0xbf, 0xa3, 0x2f, 0x15, 0x22, 0xac, 0xc2, 0xee,
-	0x79, 0xbe, 0x2b, 0xc5, 0x92, 0x47, 0x15, 0x38, 0x6f, 0x50, 0x79, 0x43, 0x58, 0x58, 0xd0, 0x60,
-	0x3a, 0xb0, 0xf1, 0x34, 0x8c, 0xdd, 0xdb, 0xf3, 0xf2, 0xd1, 0xea, 0x03, 0x1a, 0x68, 0x28, 0x3e,
-	0x18, 0x02, 0x7a, 0x2d, 0x9e, 0xf1, 0xfb, 0xde, 0x34, 0x40, 0xad, 0x2f, 0x0c, 0x16, 0xa8, 0x90,
-	0x1c, 0x68, 0xc2, 0xca, 0xc5, 0x87, 0x81, 0x2d, 0x1a, 0xdd, 0x0b, 0x7c, 0xf7, 0xcf, 0x3b, 0xff,
-	0xf0, 0x63, 0x93, 0xb0, 0xeb, 0x4d, 0xc2, 0x7e, 0x6f, 0x12, 0xf6, 0x6d, 0x9b, 0x4c, 0xae, 0xb7,
-	0xc9, 0xe4, 0xd7, 0x36, 0x99, 0x7c, 0x7c, 0x5e, 0x1b, 0x7f, 0xd9, 0x96, 0x99, 0xa6, 0x46, 0x3a,
-	0x6f, 0x15, 0xd6, 0xb0, 0xa2, 0x0e, 0xce, 0x3a, 0x40, 0xdf, 0x5a, 0x70, 0x32, 0xdc, 0xec, 0xd9,
-	0xf8, 0x35, 0xbe, 0xc8, 0x51, 0xf8, 0xaf, 0x6b, 0x70, 0xe5, 0x61, 0x78, 0xd1, 0xa7, 0x7f, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x50, 0x1a, 0x62, 0x8d, 0x3a, 0x02, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc6, 0xe3, 0x1b, 0xb7, 0x8d, 0x27, 0xad, 0xae, 0x32, 0x37, 0xea, 0x9d, 0x9b, 0x8b, 0x5c,
+	0x53, 0x36, 0x11, 0xa8, 0xb6, 0x5a, 0x58, 0x01, 0xab, 0x16, 0x50, 0xbb, 0x80, 0xc5, 0x14, 0x36,
+	0x6c, 0xac, 0xb1, 0x7d, 0x92, 0x8c, 0x6a, 0xcf, 0x98, 0xf1, 0x38, 0xb4, 0x6f, 0xc0, 0x92, 0xc7,
+	0x2a, 0xbb, 0x2e, 0x59, 0x21, 0xd4, 0xbe, 0x08, 0xf2, 0xd8, 0x4e, 0x52, 0x95, 0x3f, 0xbb, 0xf3,
+	0x9d, 0xef, 0x78, 0xe6, 0xe8, 0xf7, 0x59, 0x83, 0xee, 0x2b, 0x59, 0x6a, 0x50, 0x01, 0x8f, 0xe2,
+	0x70, 0x22, 0xd5, 0x47, 0xa6, 0x92, 0x30, 0x03, 0xcd, 0x12, 0xa6, 0x99, 0x9f, 0x2b, 0xa9, 0x25,
+	0xde, 0x14, 0x32, 0x4a, 0xc1, 0xaf, 0x07, 0x47, 0x6e, 0x2c, 0x8b, 0x4c, 0x16, 0x41, 0xc4, 0x0a,
+	0x08, 0xe6, 0xfb, 0x11, 0x68, 0xb6, 0x1f, 0xc4, 0x92, 0x8b, 0x7a, 0x7a, 0x34, 0x9c, 0xca, 0xa9,
+	0x34, 0x65, 0x50, 0x55, 0x75, 0x77, 0xf7, 0x4b, 0x17, 0xfd, 0x7b, 0xaa, 0xa5, 0x82, 0x93, 0xc3,
+	0xa3, 0x57, 0xf5, 0x35, 0xaf, 0x9b, 0x5b, 0xf0, 0x03, 0xb4, 0x55, 0xc8, 0x52, 0xc5, 0x10, 0x26,
+	0x32, 0x63, 0x5c, 0x10, 0xcb, 0xb3, 0xc6, 0x5b, 0x74, 0xb3, 0x6e, 0xbe, 0x30, 0x3d, 0xfc, 0x1c,
+	0xf5, 0xda, 0xb5, 0xc8, 0x5f, 0x9e, 0x35, 0xee, 0x1f, 0x78, 0xfe, 0xea, 0x5e, 0xfe, 0xdd, 0x83,
+	0xe9, 0xe2, 0x0b, 0xfc, 0x3f, 0x72, 0x58, 0x7c, 0x16, 0x82, 0x52, 0x52, 0x91, 0xae, 0x67, 0x8d,
+	0x7b, 0xb4, 0xc7, 0xe2, 0xb3, 0x97, 0x95, 0xc6, 0x04, 0x6d, 0x28, 0xd0, 0x8a, 0x43, 0x41, 0x6c,
+	0xcf, 0x1a, 0xdb, 0xb4, 0x95, 0xf8, 0x21, 0x1a, 0x08, 0x38, 0xd7, 0x61, 0xa5, 0x2f, 0xc2, 0x19,
+	0xf0, 0xe9, 0x4c, 0x93, 0x35, 0x33, 0xf3, 0x77, 0x65, 0xd0, 0xaa, 0x7f, 0x6c, 0xda, 0x78, 0x1b,
+	0xad, 0x4f, 0x18, 0x4f, 0x21, 0x21, 0xeb, 0xe6, 0xfc, 0x46, 0xe1, 0x47, 0xa8, 0x3b, 0x01, 0x20,
+	0x1b, 0x66, 0xe7, 0xff, 0xfc, 0x9a, 0x9e, 0x5f, 0xd1, 0xf3, 0x1b, 0x7a, 0xfe, 0x91, 0xe4, 0x82,
+	0x56, 0x53, 0xf8, 0x29, 0xea, 0x2b, 0x48, 0xd9, 0x05, 0xa8, 0x50, 0xf3, 0x9c, 0xf4, 0xfe, 0xf4,
+	0x11, 0x6a, 0xa6, 0xdf, 0xf2, 0x1c, 0xdf, 0x43, 0x8e, 0x82, 0x98, 0xe7, 0x1c, 0x84, 0x26, 0x8e,
+	0x67, 0x8d, 0x37, 0xe9, 0xb2, 0x81, 0xf7, 0x10, 0x4e, 0xa0, 0xd0, 0x5c, 0x30, 0xcd, 0xa5, 0x08,
+	0x63, 0x96, 0xa6, 0xa0, 0x08, 0x32, 0x63, 0x83, 0x15, 0xe7, 0xc8, 0x18, 0x78, 0x84, 0x7a, 0xa5,
+	0xc8, 0x19, 0x57, 0x90, 0x90, 0x7e, 0xcd, 0xab, 0xd5, 0xbb, 0x9f, 0xba, 0x08, 0xff, 0x24, 0xc6,
+	0x21, 0x5a, 0x13, 0x52, 0xc4, 0x60, 0xe2, 0xb3, 0x69, 0x2d, 0x30, 0x46, 0x76, 0x2e, 0x95, 0x36,
+	0x99, 0x39, 0xd4, 0xd4, 0x15, 0xf0, 0x78, 0xc6, 0x84, 0x80, 0xd4, 0x64, 0xe1, 0xd0, 0x56, 0xe2,
+	0x7d, 0x34, 0x5c, 0xdd, 0x52, 0x41, 0x0c, 0x7c, 0x0e, 0xca, 0xe4, 0xe2, 0xd0, 0x7f, 0x56, 0x3c,
+	0xda, 0x58, 0xd5, 0x05, 0x19, 0x64, 0xd2, 0xc4, 0xe2, 0x50, 0x53, 0xe3, 0x27, 0x68, 0x5b, 0xf3,
+	0x0c, 0x64, 0xa9, 0x43, 0x2e, 0x42, 0xc1, 0x84, 0x2c, 0x20, 0x96, 0x22, 0x29, 0x4c, 0x36, 0x36,
+	0x1d, 0x36, 0xee, 0x89, 0x78, 0xb3, 0xf4, 0x2a, 0x44, 0x13, 0x96, 0xa6, 0x51, 0xf5, 0xa7, 0x2c,
+	0x49, 0x6e, 0x98, 0x73, 0x07, 0xad, 0x43, 0x17, 0x44, 0x77, 0xee, 0x66, 0x65, 0xdf, 0x0a, 0xe4,
+	0x00, 0xd9, 0x33, 0x99, 0x17, 0xc4, 0xf1, 0xba, 0xe3, 0xfe, 0x01, 0xb9, 0xfd, 0xbb, 0x36, 0xf4,
+	0x8e, 0x65, 0x7e, 0x68, 0x5f, 0x7e, 0xdb, 0xe9, 0x50, 0x33, 0x5b, 0xed, 0xa0, 0xe0, 0x43, 0x59,
+	0x71, 0x0e, 0x8b, 0x32, 0xca, 0xb8, 0xd6, 0x4d, 0x4c, 0x0e, 0x1d, 0xb4, 0xce, 0x69, 0x6b, 0xec,
+	0x9e, 0x23, 0xb4, 0x3c, 0x68, 0x95, 0xab, 0x75, 0x9b, 0xeb, 0x08, 0xf5, 0x16, 0x2c, 0xeb, 0x24,
+	0x16, 0xfa, 0x37, 0xb0, 0xba, 0xbf, 0x86, 0x75, 0xf8, 0xee, 0xf2, 0xda, 0xb5, 0xae, 0xae, 0x5d,
+	0xeb, 0xfb, 0xb5, 0x6b, 0x7d, 0xbe, 0x71, 0x3b, 0x57, 0x37, 0x6e, 0xe7, 0xeb, 0x8d, 0xdb, 0x79,
+	0xff, 0x6c, 0xca, 0xf5, 0xac, 0x8c, 0xfc, 0x58, 0x66, 0x41, 0xa1, 0x15, 0x13, 0x53, 0x48, 0xe5,
+	0x1c, 0xf6, 0xe6, 0x20, 0x74, 0xa9, 0xa0, 0x08, 0x0c, 0x87, 0xbd, 0xe6, 0xdd, 0x39, 0x0f, 0x9a,
+	0x42, 0x5f, 0xe4, 0x50, 0x44, 0xeb, 0xe6, 0xb9, 0x78, 0xfc, 0x63, 0x00, 0x8a, 0xcf, 0xc6, 0x3e,
+	0x97, 0x04, 0x00, 0x00,
 }
 
 func (m *StoreIBCForwardMetadata) Marshal() (dAtA []byte, err error) {
@@ -240,6 +439,74 @@ func (m *StoreIBCForwardMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Unpaired {
+		i--
+		if m.Unpaired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.DestinationCaller) > 0 {
+		i -= len(m.DestinationCaller)
+		copy(dAtA[i:], m.DestinationCaller)
+		i = encodeVarintIbcForwardMetadata(dAtA, i, uint64(len(m.DestinationCaller)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintIbcForwardMetadata(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RelayerTip != nil {
+		{
+			size, err := m.RelayerTip.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIbcForwardMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Fee != nil {
+		{
+			size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIbcForwardMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextRetryHeight != 0 {
+		i = encodeVarintIbcForwardMetadata(dAtA, i, uint64(m.NextRetryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Retries != 0 {
+		i = encodeVarintIbcForwardMetadata(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.AckError {
 		i--
 		if m.AckError {
@@ -290,6 +557,39 @@ func (m *IBCForwardMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RequiredSubmitter) > 0 {
+		i -= len(m.RequiredSubmitter)
+		copy(dAtA[i:], m.RequiredSubmitter)
+		i = encodeVarintIbcForwardMetadata(dAtA, i, uint64(len(m.RequiredSubmitter)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIbcForwardMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.RelayerTip != 0 {
+		i = encodeVarintIbcForwardMetadata(dAtA, i, uint64(m.RelayerTip))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.FallbackRecipient) > 0 {
+		i -= len(m.FallbackRecipient)
+		copy(dAtA[i:], m.FallbackRecipient)
+		i = encodeVarintIbcForwardMetadata(dAtA, i, uint64(len(m.FallbackRecipient)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.TimeoutInNanoseconds != 0 {
 		i = encodeVarintIbcForwardMetadata(dAtA, i, uint64(m.TimeoutInNanoseconds))
 		i--
@@ -331,6 +631,48 @@ func (m *IBCForwardMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ForwardHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutInNanoseconds != 0 {
+		i = encodeVarintIbcForwardMetadata(dAtA, i, uint64(m.TimeoutInNanoseconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintIbcForwardMetadata(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintIbcForwardMetadata(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIbcForwardMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIbcForwardMetadata(v)
 	base := offset
@@ -358,6 +700,34 @@ func (m *StoreIBCForwardMetadata) Size() (n int) {
 	if m.AckError {
 		n += 2
 	}
+	if m.Retries != 0 {
+		n += 1 + sovIbcForwardMetadata(uint64(m.Retries))
+	}
+	if m.NextRetryHeight != 0 {
+		n += 1 + sovIbcForwardMetadata(uint64(m.NextRetryHeight))
+	}
+	if m.Failed {
+		n += 2
+	}
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 1 + l + sovIbcForwardMetadata(uint64(l))
+	}
+	if m.RelayerTip != nil {
+		l = m.RelayerTip.Size()
+		n += 1 + l + sovIbcForwardMetadata(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovIbcForwardMetadata(uint64(l))
+	}
+	l = len(m.DestinationCaller)
+	if l > 0 {
+		n += 1 + l + sovIbcForwardMetadata(uint64(l))
+	}
+	if m.Unpaired {
+		n += 2
+	}
 	return n
 }
 
@@ -389,6 +759,43 @@ func (m *IBCForwardMetadata) Size() (n int) {
 	if m.TimeoutInNanoseconds != 0 {
 		n += 1 + sovIbcForwardMetadata(uint64(m.TimeoutInNanoseconds))
 	}
+	l = len(m.FallbackRecipient)
+	if l > 0 {
+		n += 1 + l + sovIbcForwardMetadata(uint64(l))
+	}
+	if m.RelayerTip != 0 {
+		n += 1 + sovIbcForwardMetadata(uint64(m.RelayerTip))
+	}
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovIbcForwardMetadata(uint64(l))
+		}
+	}
+	l = len(m.RequiredSubmitter)
+	if l > 0 {
+		n += 1 + l + sovIbcForwardMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovIbcForwardMetadata(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovIbcForwardMetadata(uint64(l))
+	}
+	if m.TimeoutInNanoseconds != 0 {
+		n += 1 + sovIbcForwardMetadata(uint64(m.TimeoutInNanoseconds))
+	}
 	return n
 }
 
@@ -502,23 +909,241 @@ func (m *StoreIBCForwardMetadata) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AckError = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIbcForwardMetadata(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIbcForwardMetadata
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcForwardMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryHeight", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
+			m.NextRetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcForwardMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRetryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcForwardMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcForwardMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fee == nil {
+				m.Fee = &types.Coin{}
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerTip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcForwardMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelayerTip == nil {
+				m.RelayerTip = &types.Coin{}
+			}
+			if err := m.RelayerTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcForwardMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcForwardMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationCaller = append(m.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestinationCaller == nil {
+				m.DestinationCaller = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unpaired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcForwardMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unpaired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcForwardMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
@@ -718,6 +1343,256 @@ func (m *IBCForwardMetadata) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcForwardMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerTip", wireType)
+			}
+			m.RelayerTip = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcForwardMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayerTip |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcForwardMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, ForwardHop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredSubmitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcForwardMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredSubmitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcForwardMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbcForwardMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcForwardMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcForwardMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcForwardMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutInNanoseconds", wireType)
+			}
+			m.TimeoutInNanoseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcForwardMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutInNanoseconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIbcForwardMetadata(dAtA[iNdEx:])
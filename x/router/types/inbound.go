@@ -0,0 +1,95 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// InboundMemoKey is the key of the router instruction in the memo of an inbound ICS-20 transfer.
+const InboundMemoKey = "router"
+
+// InboundBurnerAddress is the address that receives inbound ICS-20 transfers carrying a router
+// instruction, before they are burned through CCTP.
+var InboundBurnerAddress = sdk.AccAddress(address.Module(ModuleName, []byte("inbound")))
+
+// InboundInstruction instructs the router to burn an inbound ICS-20 transfer through CCTP.
+// Addresses are hex encoded, 20 byte addresses are left padded to 32 bytes.
+type InboundInstruction struct {
+	DestinationDomain uint32 `json:"destination_domain"`
+	MintRecipient     string `json:"mint_recipient"`
+	DestinationCaller string `json:"destination_caller,omitempty"`
+}
+
+// ParseInboundMemo returns the router instruction contained in the memo of an inbound ICS-20
+// transfer. False is returned when the memo does not contain a router instruction.
+func ParseInboundMemo(memo string) (*InboundInstruction, bool, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		// memos that are not JSON objects are meant for someone else
+		return nil, false, nil
+	}
+
+	raw, ok := fields[InboundMemoKey]
+	if !ok {
+		return nil, false, nil
+	}
+
+	var instruction InboundInstruction
+	if err := json.Unmarshal(raw, &instruction); err != nil {
+		return nil, true, fmt.Errorf("malformed router instruction: %w", err)
+	}
+
+	if _, err := instruction.MintRecipientBytes(); err != nil {
+		return nil, true, fmt.Errorf("invalid mint recipient: %w", err)
+	}
+	if _, err := instruction.DestinationCallerBytes(); err != nil {
+		return nil, true, fmt.Errorf("invalid destination caller: %w", err)
+	}
+
+	return &instruction, true, nil
+}
+
+// MintRecipientBytes returns the mint recipient of the instruction, left padded to 32 bytes.
+func (i InboundInstruction) MintRecipientBytes() ([]byte, error) {
+	if i.MintRecipient == "" {
+		return nil, fmt.Errorf("mint recipient cannot be empty")
+	}
+	return parseHexAddress(i.MintRecipient)
+}
+
+// DestinationCallerBytes returns the destination caller of the instruction, left padded to 32 bytes.
+// It is empty when any address can receive the message on the destination domain.
+func (i InboundInstruction) DestinationCallerBytes() ([]byte, error) {
+	if i.DestinationCaller == "" {
+		return nil, nil
+	}
+	return parseHexAddress(i.DestinationCaller)
+}
+
+// parseHexAddress decodes a hex encoded 20 or 32 byte address, left padded to 32 bytes.
+func parseHexAddress(s string) ([]byte, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bz) != 20 && len(bz) != cctptypes.MintRecipientLen {
+		return nil, fmt.Errorf("address must be 20 or 32 bytes, got %d", len(bz))
+	}
+
+	address := make([]byte, cctptypes.MintRecipientLen)
+	copy(address[cctptypes.MintRecipientLen-len(bz):], bz)
+
+	if bytes.Equal(address, make([]byte, cctptypes.MintRecipientLen)) {
+		return nil, fmt.Errorf("address cannot be empty")
+	}
+
+	return address, nil
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInboundMemo(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		memo  string
+		found bool
+		err   string
+	}{
+		{
+			desc: "empty memo",
+			memo: "",
+		},
+		{
+			desc: "memo without router instruction",
+			memo: `{"forward":{"receiver":"router"}}`,
+		},
+		{
+			desc: "memo is not JSON",
+			memo: "router",
+		},
+		{
+			desc:  "valid instruction",
+			memo:  `{"router":{"destination_domain":0,"mint_recipient":"0x000000000000000000000000000000000000dEaD"}}`,
+			found: true,
+		},
+		{
+			desc:  "escaped router key",
+			memo:  `{"\u0072outer":{"destination_domain":0,"mint_recipient":"0x000000000000000000000000000000000000dEaD"}}`,
+			found: true,
+		},
+		{
+			desc: "router instruction nested in another key",
+			memo: `{"wasm":{"router":{"destination_domain":0,"mint_recipient":"0x000000000000000000000000000000000000dEaD"}}}`,
+		},
+		{
+			desc:  "32 byte mint recipient",
+			memo:  `{"router":{"destination_domain":5,"mint_recipient":"0000000000000000000000000000000000000000000000000000000000000001"}}`,
+			found: true,
+		},
+		{
+			desc:  "empty mint recipient",
+			memo:  `{"router":{"destination_domain":0}}`,
+			found: true,
+			err:   "mint recipient cannot be empty",
+		},
+		{
+			desc:  "zero mint recipient",
+			memo:  `{"router":{"destination_domain":0,"mint_recipient":"0x0000000000000000000000000000000000000000"}}`,
+			found: true,
+			err:   "address cannot be empty",
+		},
+		{
+			desc:  "invalid destination caller",
+			memo:  `{"router":{"destination_domain":0,"mint_recipient":"0x000000000000000000000000000000000000dEaD","destination_caller":"0x12"}}`,
+			found: true,
+			err:   "invalid destination caller",
+		},
+		{
+			desc:  "malformed instruction",
+			memo:  `{"router":"burn"}`,
+			found: true,
+			err:   "malformed router instruction",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			instruction, found, err := ParseInboundMemo(tc.memo)
+			require.Equal(t, tc.found, found)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			if found {
+				require.NotNil(t, instruction)
+			}
+		})
+	}
+}
@@ -22,10 +22,48 @@ const (
 var (
 	IBCForwardPrefix                   = []byte("forward/")
 	InFlightPacketPrefix               = []byte("inflight/")
+	InFlightPacketByNoncePrefix        = []byte("inflightbynonce/")
 	MintPrefix                         = []byte("mint/")
+	MintByHeightPrefix                 = []byte("mintbyheight/")
+	ForwardRetryQueuePrefix            = []byte("forwardretry/")
+	ForwardReceiptPrefix               = []byte("receipt/")
+	ForwardReceiptByHeightPrefix       = []byte("receiptbyheight/")
 	AllowedSourceDomainSenderKeyPrefix = []byte("allowedsourcedomainsender/")
+	AllowedChannelKeyPrefix            = []byte("allowedchannel/")
+	SourceDomainRateLimitPrefix        = []byte("ratelimit/domain/")
+	ChannelRateLimitPrefix             = []byte("ratelimit/channel/")
+	SourceDomainRateLimitUsagePrefix   = []byte("ratelimitusage/domain/")
+	ChannelRateLimitUsagePrefix        = []byte("ratelimitusage/channel/")
+	SourceDomainRateLimitBucketPrefix  = []byte("ratelimitbucket/domain/")
+	ChannelRateLimitBucketPrefix       = []byte("ratelimitbucket/channel/")
+	RateLimitedForwardPrefix           = []byte("ratelimitedforward/")
+	PausedSourceDomainPrefix           = []byte("pauseddomain/")
+	HeldForwardPrefix                  = []byte("heldforward/")
+
+	ParamsKey        = []byte("params")
+	OwnerKey         = []byte("owner")
+	PendingOwnerKey  = []byte("pending-owner")
+	PauserKey        = []byte("pauser")
+	RoutingPausedKey = []byte("routingpaused")
+
+	RateLimitedForwardCursorKey = []byte("ratelimitedforwardcursor")
 )
 
+// RateLimitBucketsPrefix returns the prefix of the per-block usage buckets of a rate limit, below the
+// source domain or channel bucket prefix.
+func RateLimitBucketsPrefix(bucketPrefix []byte, key []byte) []byte {
+	res := append([]byte{}, bucketPrefix...)
+	res = append(res, key...)
+	return append(res, '/')
+}
+
+// RateLimitBucketKey returns the key of the usage bucket of a block, below RateLimitBucketsPrefix.
+func RateLimitBucketKey(height uint64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, height)
+	return heightBytes
+}
+
 func LookupKey(sourceDomain uint32, nonce uint64) []byte {
 	sourceDomainBytes := make([]byte, 4)
 	binary.BigEndian.PutUint32(sourceDomainBytes, sourceDomain)
@@ -34,10 +72,44 @@ func LookupKey(sourceDomain uint32, nonce uint64) []byte {
 	return append(nonceBytes, sourceDomainBytes...)
 }
 
+// ParseLookupKey returns the source domain and nonce encoded in a LookupKey.
+func ParseLookupKey(key []byte) (sourceDomain uint32, nonce uint64) {
+	return binary.BigEndian.Uint32(key[8:12]), binary.BigEndian.Uint64(key[0:8])
+}
+
+// MintByHeightKey indexes a mint by the height it was recorded at, so that
+// mints can be iterated in the order they become eligible for pruning.
+func MintByHeightKey(height uint64, sourceDomain uint32, nonce uint64) []byte {
+	return heightLookupKey(height, sourceDomain, nonce)
+}
+
+// ForwardRetryKey queues the retry of an IBC forward at the height it is due.
+func ForwardRetryKey(height uint64, sourceDomain uint32, nonce uint64) []byte {
+	return heightLookupKey(height, sourceDomain, nonce)
+}
+
+// ForwardReceiptByHeightKey indexes a forward receipt by the height it was recorded at, so that
+// receipts can be pruned in the order they expire.
+func ForwardReceiptByHeightKey(height uint64, sourceDomain uint32, nonce uint64) []byte {
+	return heightLookupKey(height, sourceDomain, nonce)
+}
+
+func heightLookupKey(height uint64, sourceDomain uint32, nonce uint64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, height)
+	return append(heightBytes, LookupKey(sourceDomain, nonce)...)
+}
+
 func InFlightPacketKey(channelID, portID string, sequence uint64) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%d", channelID, portID, sequence))
 }
 
+func SourceDomainKey(sourceDomain uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, sourceDomain)
+	return key
+}
+
 func SourceDomainSenderKey(domainID uint32, address []byte) []byte {
 	key := make([]byte, 36)
 	binary.BigEndian.PutUint32(key, domainID)
@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAddAllowedChannel{}
+
+func NewMsgAddAllowedChannel(from string, channel string, chainLabel string) *MsgAddAllowedChannel {
+	return &MsgAddAllowedChannel{
+		From:       from,
+		Channel:    channel,
+		ChainLabel: chainLabel,
+	}
+}
+
+func (msg *MsgAddAllowedChannel) AllowedChannel() AllowedChannel {
+	return AllowedChannel{
+		Channel:    msg.Channel,
+		ChainLabel: msg.ChainLabel,
+	}
+}
+
+func (msg *MsgAddAllowedChannel) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgAddAllowedChannel) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := msg.AllowedChannel().Validate(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/strangelove-ventures/noble/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddAllowedChannel_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAddAllowedChannel
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgAddAllowedChannel{
+				From:       "invalid_address",
+				Channel:    "channel-1",
+				ChainLabel: "osmosis",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid channel",
+			msg: MsgAddAllowedChannel{
+				From:       sample.AccAddress(),
+				Channel:    "1",
+				ChainLabel: "osmosis",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "empty chain label",
+			msg: MsgAddAllowedChannel{
+				From:    sample.AccAddress(),
+				Channel: "channel-1",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid",
+			msg: MsgAddAllowedChannel{
+				From:       sample.AccAddress(),
+				Channel:    "channel-1",
+				ChainLabel: "osmosis",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
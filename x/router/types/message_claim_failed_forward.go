@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgClaimFailedForward{}
+
+func NewMsgClaimFailedForward(from string, sourceDomain uint32, nonce uint64) *MsgClaimFailedForward {
+	return &MsgClaimFailedForward{
+		From:         from,
+		SourceDomain: sourceDomain,
+		Nonce:        nonce,
+	}
+}
+
+func (msg *MsgClaimFailedForward) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgClaimFailedForward) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/strangelove-ventures/noble/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPairForward_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgPairForward
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgPairForward{
+				From:         "invalid_address",
+				SourceDomain: 1,
+				Nonce:        2,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "valid",
+			msg: MsgPairForward{
+				From:         sample.AccAddress(),
+				SourceDomain: 1,
+				Nonce:        2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
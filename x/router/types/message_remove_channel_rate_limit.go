@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRemoveChannelRateLimit{}
+
+func NewMsgRemoveChannelRateLimit(from string, channel string) *MsgRemoveChannelRateLimit {
+	return &MsgRemoveChannelRateLimit{
+		From:    from,
+		Channel: channel,
+	}
+}
+
+func (msg *MsgRemoveChannelRateLimit) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgRemoveChannelRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRemoveSourceDomainRateLimit{}
+
+func NewMsgRemoveSourceDomainRateLimit(from string, sourceDomain uint32) *MsgRemoveSourceDomainRateLimit {
+	return &MsgRemoveSourceDomainRateLimit{
+		From:         from,
+		SourceDomain: sourceDomain,
+	}
+}
+
+func (msg *MsgRemoveSourceDomainRateLimit) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgRemoveSourceDomainRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	return nil
+}
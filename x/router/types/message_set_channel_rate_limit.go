@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetChannelRateLimit{}
+
+func NewMsgSetChannelRateLimit(from string, channel string, windowBlocks uint64, maxAmount sdk.Int) *MsgSetChannelRateLimit {
+	return &MsgSetChannelRateLimit{
+		From:         from,
+		Channel:      channel,
+		WindowBlocks: windowBlocks,
+		MaxAmount:    maxAmount,
+	}
+}
+
+func (msg *MsgSetChannelRateLimit) RateLimit() ChannelRateLimit {
+	return ChannelRateLimit{
+		Channel:      msg.Channel,
+		WindowBlocks: msg.WindowBlocks,
+		MaxAmount:    msg.MaxAmount,
+	}
+}
+
+func (msg *MsgSetChannelRateLimit) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgSetChannelRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := msg.RateLimit().Validate(); err != nil {
+		return err
+	}
+	return nil
+}
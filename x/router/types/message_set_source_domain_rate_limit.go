@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetSourceDomainRateLimit{}
+
+func NewMsgSetSourceDomainRateLimit(from string, sourceDomain uint32, windowBlocks uint64, maxAmount sdk.Int) *MsgSetSourceDomainRateLimit {
+	return &MsgSetSourceDomainRateLimit{
+		From:         from,
+		SourceDomain: sourceDomain,
+		WindowBlocks: windowBlocks,
+		MaxAmount:    maxAmount,
+	}
+}
+
+func (msg *MsgSetSourceDomainRateLimit) RateLimit() SourceDomainRateLimit {
+	return SourceDomainRateLimit{
+		SourceDomain: msg.SourceDomain,
+		WindowBlocks: msg.WindowBlocks,
+		MaxAmount:    msg.MaxAmount,
+	}
+}
+
+func (msg *MsgSetSourceDomainRateLimit) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgSetSourceDomainRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := msg.RateLimit().Validate(); err != nil {
+		return err
+	}
+	return nil
+}
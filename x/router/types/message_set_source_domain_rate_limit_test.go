@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/strangelove-ventures/noble/testutil/sample"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetSourceDomainRateLimit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetSourceDomainRateLimit
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgSetSourceDomainRateLimit{
+				From:         "invalid_address",
+				WindowBlocks: 100,
+				MaxAmount:    sdk.NewInt(1000000),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty window",
+			msg: MsgSetSourceDomainRateLimit{
+				From:      sample.AccAddress(),
+				MaxAmount: sdk.NewInt(1000000),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "negative max amount",
+			msg: MsgSetSourceDomainRateLimit{
+				From:         sample.AccAddress(),
+				WindowBlocks: 100,
+				MaxAmount:    sdk.NewInt(-1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "missing max amount",
+			msg: MsgSetSourceDomainRateLimit{
+				From:         sample.AccAddress(),
+				WindowBlocks: 100,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid",
+			msg: MsgSetSourceDomainRateLimit{
+				From:         sample.AccAddress(),
+				WindowBlocks: 100,
+				MaxAmount:    sdk.NewInt(1000000),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUnpauseRouting{}
+
+func NewMsgUnpauseRouting(from string, global bool, sourceDomain uint32) *MsgUnpauseRouting {
+	return &MsgUnpauseRouting{
+		From:         from,
+		Global:       global,
+		SourceDomain: sourceDomain,
+	}
+}
+
+func (msg *MsgUnpauseRouting) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgUnpauseRouting) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	return nil
+}
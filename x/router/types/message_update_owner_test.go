@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/strangelove-ventures/noble/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateOwner_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateOwner
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgUpdateOwner{
+				From:     "invalid_address",
+				NewOwner: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid new owner",
+			msg: MsgUpdateOwner{
+				From:     sample.AccAddress(),
+				NewOwner: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "valid",
+			msg: MsgUpdateOwner{
+				From:     sample.AccAddress(),
+				NewOwner: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -3,13 +3,52 @@ package types
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
+	"unicode/utf8"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	channelTypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
-// MESSAGE FORMAT: <nonce> <sender> <channel> <bech32 prefix> <recipient> <memo>
+// MESSAGE FORMAT: <payload> or <magic> <version> <payload>
+//
+// v0 is the legacy unversioned layout, it is sent without magic and version.
+// Every later version is wrapped in an envelope starting with EnvelopeMagic.
+//
+// v0 payload: <nonce> <sender> <channel> <bech32 prefix> <recipient> <memo>
+// v1 payload: <nonce> <sender> <channel> <bech32 prefix> <recipient> <port> <timeout> <fallback recipient> <memo>
+// v2 payload: <nonce> <sender> <channel> <bech32 prefix> <recipient> <port> <timeout> <fallback recipient> <relayer tip> <memo>
+// v3 payload: <nonce> <sender> <channel> <bech32 prefix> <recipient> <port> <timeout> <fallback recipient> <relayer tip> <hop count> <hops> <memo>
+// v4 payload: <nonce> <sender> <channel> <bech32 prefix> <recipient> <port> <timeout> <fallback recipient> <relayer tip> <hop count> <hops> <required submitter> <memo>
+//
+// v3 hop: <channel> <bech32 prefix> <receiver> <timeout>
+//
+// Every version extends the payload of the previous one with fields placed
+// before the memo, which always takes up the remaining bytes.
 const (
+	IBCForwardMetadataV0 uint32 = 0
+	IBCForwardMetadataV1 uint32 = 1
+	IBCForwardMetadataV2 uint32 = 2
+	IBCForwardMetadataV3 uint32 = 3
+	IBCForwardMetadataV4 uint32 = 4
+
+	LatestIBCForwardMetadataVersion = IBCForwardMetadataV4
+)
+
+// EnvelopeMagic starts every versioned payload. A legacy v0 payload starts with its big endian
+// nonce instead, which would have to be at least 2^64 - 2^32 to start with the magic.
+var EnvelopeMagic = []byte{0xff, 0xff, 0xff, 0xff}
+
+const (
+	MagicIndex     = 0
+	MagicLength    = 4
+	VersionIndex   = MagicIndex + MagicLength
+	VersionLength  = 4
+	EnvelopeLength = VersionIndex + VersionLength
+
+	// Indices of each field in the v0 payload
 	NonceIndex   = 0
 	NonceLength  = 8
 	SenderIndex  = NonceIndex + NonceLength
@@ -22,36 +61,252 @@ const (
 	RecipientIndex  = PrefixIndex + PrefixLength
 	RecipientLength = 32
 	MemoIndex       = RecipientIndex + RecipientLength
+
+	// Lengths of the fields added in the v1 payload
+	PortLength              = 32
+	TimeoutLength           = 8
+	FallbackRecipientLength = 32
+
+	// Lengths of the fields added in the v2 payload
+	RelayerTipLength = 8
+
+	// Lengths of the fields added in the v3 payload
+	HopCountLength = 4
+	HopLength      = ChannelLength + PrefixLength + RecipientLength + TimeoutLength
+
+	// Lengths of the fields added in the v4 payload
+	RequiredSubmitterLength = 32
 )
 
-// Parse parses a byte array into a IBCForwardMetadata struct.
+// MaxMemoLength is the maximum length (in bytes) of the memo of an IBC forward.
+const MaxMemoLength = 32768
+
+// payloadLength returns the length of the fixed size fields of a payload version.
+func payloadLength(version uint32) int {
+	length := MemoIndex
+	if version >= IBCForwardMetadataV1 {
+		length += PortLength + TimeoutLength + FallbackRecipientLength
+	}
+	if version >= IBCForwardMetadataV2 {
+		length += RelayerTipLength
+	}
+	if version >= IBCForwardMetadataV3 {
+		length += HopCountLength
+	}
+	if version >= IBCForwardMetadataV4 {
+		length += RequiredSubmitterLength
+	}
+	return length
+}
+
+// Parse parses a byte array into a IBCForwardMetadata struct. Byte arrays starting with
+// EnvelopeMagic are decoded according to their version, any other byte array as a legacy v0 payload.
 func (m *IBCForwardMetadata) Parse(bz []byte) (*IBCForwardMetadata, error) {
-	if len(bz) < MemoIndex {
+	version, payload := IBCForwardMetadataV0, bz
+	if bytes.HasPrefix(bz, EnvelopeMagic) {
+		if len(bz) < EnvelopeLength {
+			return m, ErrDecodingIBCForward
+		}
+
+		version = binary.BigEndian.Uint32(bz[VersionIndex:EnvelopeLength])
+		if version == IBCForwardMetadataV0 || version > LatestIBCForwardMetadataVersion {
+			return m, sdkerrors.Wrapf(ErrDecodingIBCForward, "unsupported metadata version %d", version)
+		}
+
+		payload = bz[EnvelopeLength:]
+	}
+
+	if len(payload) < payloadLength(version) {
 		return m, ErrDecodingIBCForward
 	}
 
 	cutset := string(byte(0))
 
-	m.Nonce = binary.BigEndian.Uint64(bz[NonceIndex:SenderIndex])
+	m.Nonce = binary.BigEndian.Uint64(payload[NonceIndex:SenderIndex])
 	m.Port = "transfer"
 	m.Channel = channelTypes.FormatChannelIdentifier(
-		binary.BigEndian.Uint64(bz[ChannelIndex:PrefixIndex]),
+		binary.BigEndian.Uint64(payload[ChannelIndex:PrefixIndex]),
 	)
 
-	prefix := string(bytes.TrimLeft(bz[PrefixIndex:RecipientIndex], cutset))
-	recipient := bytes.TrimLeft(bz[RecipientIndex:MemoIndex], cutset)
-	m.DestinationReceiver = sdk.MustBech32ifyAddressBytes(prefix, recipient)
+	prefix := string(bytes.TrimLeft(payload[PrefixIndex:RecipientIndex], cutset))
+	if err := validateBech32Prefix(prefix); err != nil {
+		return m, err
+	}
+
+	recipient, err := parseAddress(payload[RecipientIndex:MemoIndex])
+	if err != nil {
+		return m, sdkerrors.Wrapf(ErrDecodingIBCForward, "invalid recipient: %s", err)
+	}
+
+	m.DestinationReceiver, err = sdk.Bech32ifyAddressBytes(prefix, recipient)
+	if err != nil {
+		return m, sdkerrors.Wrapf(ErrDecodingIBCForward, "unable to bech32 encode recipient: %s", err)
+	}
+
+	cursor := MemoIndex
+	if version >= IBCForwardMetadataV1 {
+		if port := string(bytes.TrimLeft(payload[cursor:cursor+PortLength], cutset)); port != "" {
+			m.Port = port
+		}
+		cursor += PortLength
 
-	m.Memo = string(bz[MemoIndex:])
+		m.TimeoutInNanoseconds = binary.BigEndian.Uint64(payload[cursor : cursor+TimeoutLength])
+		cursor += TimeoutLength
+
+		// the fallback recipient is an optional Noble account address, left padded to 32 bytes
+		fallbackRecipient := payload[cursor : cursor+FallbackRecipientLength]
+		if !bytes.Equal(fallbackRecipient, make([]byte, FallbackRecipientLength)) {
+			address, err := parseAddress(fallbackRecipient)
+			if err != nil {
+				return m, sdkerrors.Wrapf(ErrDecodingIBCForward, "invalid fallback recipient: %s", err)
+			}
+			m.FallbackRecipient = sdk.AccAddress(address).String()
+		}
+		cursor += FallbackRecipientLength
+	}
+
+	if version >= IBCForwardMetadataV2 {
+		m.RelayerTip = binary.BigEndian.Uint64(payload[cursor : cursor+RelayerTipLength])
+		cursor += RelayerTipLength
+	}
+
+	if version >= IBCForwardMetadataV3 {
+		hopCount := binary.BigEndian.Uint32(payload[cursor : cursor+HopCountLength])
+		cursor += HopCountLength
+
+		if hopCount > MaxForwardHops {
+			return m, sdkerrors.Wrapf(ErrDecodingIBCForward, "cannot have more than %d hops, got %d", MaxForwardHops, hopCount)
+		}
+		if len(payload) < cursor+int(hopCount)*HopLength {
+			return m, ErrDecodingIBCForward
+		}
+
+		for i := 0; i < int(hopCount); i++ {
+			hop, err := parseHop(payload[cursor : cursor+HopLength])
+			if err != nil {
+				return m, sdkerrors.Wrapf(ErrDecodingIBCForward, "invalid hop %d: %s", i, err)
+			}
+			m.Hops = append(m.Hops, hop)
+			cursor += HopLength
+		}
+	}
+
+	if version >= IBCForwardMetadataV4 {
+		// the hops are variable in length, so the required submitter is only known to fit now
+		if len(payload) < cursor+RequiredSubmitterLength {
+			return m, ErrDecodingIBCForward
+		}
+
+		// the required submitter is an optional Noble account address, left padded to 32 bytes
+		requiredSubmitter := payload[cursor : cursor+RequiredSubmitterLength]
+		if !bytes.Equal(requiredSubmitter, make([]byte, RequiredSubmitterLength)) {
+			address, err := parseAddress(requiredSubmitter)
+			if err != nil {
+				return m, sdkerrors.Wrapf(ErrDecodingIBCForward, "invalid required submitter: %s", err)
+			}
+			m.RequiredSubmitter = sdk.AccAddress(address).String()
+		}
+		cursor += RequiredSubmitterLength
+	}
+
+	memo := payload[cursor:]
+	if len(memo) > MaxMemoLength {
+		return m, sdkerrors.Wrapf(ErrDecodingIBCForward, "memo cannot be longer than %d bytes, got %d", MaxMemoLength, len(memo))
+	}
+	if !utf8.Valid(memo) {
+		return m, sdkerrors.Wrap(ErrDecodingIBCForward, "memo is not valid UTF-8")
+	}
+	m.Memo = string(memo)
 
 	return m, nil
 }
 
-// Bytes parses a IBCForwardMetadata struct into a byte array.
-func (m *IBCForwardMetadata) Bytes(prefix string) (res []byte, err error) {
+// parseHop parses a fixed size hop of a v3 payload.
+func parseHop(bz []byte) (ForwardHop, error) {
+	cursor := 0
+	channel := channelTypes.FormatChannelIdentifier(binary.BigEndian.Uint64(bz[cursor : cursor+ChannelLength]))
+	cursor += ChannelLength
+
+	prefix := string(bytes.TrimLeft(bz[cursor:cursor+PrefixLength], string(byte(0))))
+	if err := validateBech32Prefix(prefix); err != nil {
+		return ForwardHop{}, err
+	}
+	cursor += PrefixLength
+
+	address, err := parseAddress(bz[cursor : cursor+RecipientLength])
+	if err != nil {
+		return ForwardHop{}, fmt.Errorf("invalid receiver: %w", err)
+	}
+	receiver, err := sdk.Bech32ifyAddressBytes(prefix, address)
+	if err != nil {
+		return ForwardHop{}, fmt.Errorf("unable to bech32 encode receiver: %w", err)
+	}
+	cursor += RecipientLength
+
+	return ForwardHop{
+		Channel:              channel,
+		Receiver:             receiver,
+		TimeoutInNanoseconds: binary.BigEndian.Uint64(bz[cursor : cursor+TimeoutLength]),
+	}, nil
+}
+
+// validateBech32Prefix checks that a bech32 human readable part only consists of
+// lowercase US-ASCII characters in the range [33-126].
+func validateBech32Prefix(prefix string) error {
+	if prefix == "" {
+		return sdkerrors.Wrap(ErrDecodingIBCForward, "bech32 prefix cannot be empty")
+	}
+
+	for _, c := range []byte(prefix) {
+		if c < 33 || c > 126 || (c >= 'A' && c <= 'Z') {
+			return sdkerrors.Wrapf(ErrDecodingIBCForward, "bech32 prefix contains invalid character %q", c)
+		}
+	}
+
+	return nil
+}
+
+// parseAddress returns the address contained in a left padded 32 byte field.
+// Addresses are either 20 or 32 bytes long, as enforced by padAddress.
+func parseAddress(bz []byte) ([]byte, error) {
+	if bytes.Equal(bz, make([]byte, len(bz))) {
+		return nil, fmt.Errorf("address cannot be empty")
+	}
+
+	if bytes.Equal(bz[:12], make([]byte, 12)) {
+		return bz[12:], nil
+	}
+
+	return bz, nil
+}
+
+// padAddress left pads an address to a 32 byte field. Only 20 and 32 byte
+// addresses can be told apart by parseAddress, other lengths are rejected.
+func padAddress(bz []byte) ([]byte, error) {
+	if len(bz) != 20 && len(bz) != 32 {
+		return nil, fmt.Errorf("address must be 20 or 32 bytes, got %d", len(bz))
+	}
+
+	res := make([]byte, 32)
+	copy(res[32-len(bz):], bz)
+	return res, nil
+}
+
+// Bytes parses a IBCForwardMetadata struct into a byte array of the given version.
+// v0 is encoded in the legacy layout, without envelope.
+func (m *IBCForwardMetadata) Bytes(version uint32, prefix string) (res []byte, err error) {
+	if version > LatestIBCForwardMetadataVersion {
+		return nil, sdkerrors.Wrapf(ErrDecodingIBCForward, "unsupported metadata version %d", version)
+	}
+
 	nonceBz := make([]byte, 8)
 	binary.BigEndian.PutUint64(nonceBz, m.Nonce)
 
+	// a legacy payload whose nonce starts with the magic would be read as versioned
+	if version == IBCForwardMetadataV0 && bytes.HasPrefix(nonceBz, EnvelopeMagic) {
+		return nil, sdkerrors.Wrapf(ErrDecodingIBCForward, "nonce %d cannot be encoded in a v0 payload", m.Nonce)
+	}
+
 	channelBz := make([]byte, 8)
 	rawChannel, err := channelTypes.ParseChannelSequence(m.Channel)
 	if err != nil {
@@ -63,18 +318,131 @@ func (m *IBCForwardMetadata) Bytes(prefix string) (res []byte, err error) {
 	rawPrefix := []byte(prefix)
 	copy(prefixBz[32-len(rawPrefix):], rawPrefix)
 
-	recipientBz := make([]byte, 32)
 	rawRecipient, err := sdk.GetFromBech32(m.DestinationReceiver, prefix)
 	if err != nil {
 		return
 	}
-	copy(recipientBz[32-len(rawRecipient):], rawRecipient)
+	recipientBz, err := padAddress(rawRecipient)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrDecodingIBCForward, "invalid destination receiver: %s", err)
+	}
 
+	if version != IBCForwardMetadataV0 {
+		versionBz := make([]byte, 4)
+		binary.BigEndian.PutUint32(versionBz, version)
+
+		res = append(res, EnvelopeMagic...)
+		res = append(res, versionBz...)
+	}
 	res = append(res, nonceBz...)
 	res = append(res, make([]byte, 32)...) // sender
 	res = append(res, channelBz...)
 	res = append(res, prefixBz...)
 	res = append(res, recipientBz...)
+
+	if version >= IBCForwardMetadataV1 {
+		if len(m.Port) > PortLength {
+			return nil, sdkerrors.Wrapf(ErrDecodingIBCForward, "port cannot be longer than %d bytes", PortLength)
+		}
+
+		portBz := make([]byte, 32)
+		copy(portBz[32-len(m.Port):], m.Port)
+
+		timeoutBz := make([]byte, 8)
+		binary.BigEndian.PutUint64(timeoutBz, m.TimeoutInNanoseconds)
+
+		fallbackRecipientBz := make([]byte, 32)
+		if m.FallbackRecipient != "" {
+			var rawFallbackRecipient []byte
+			rawFallbackRecipient, err = sdk.GetFromBech32(m.FallbackRecipient, sdk.GetConfig().GetBech32AccountAddrPrefix())
+			if err != nil {
+				return
+			}
+			fallbackRecipientBz, err = padAddress(rawFallbackRecipient)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(ErrDecodingIBCForward, "invalid fallback recipient: %s", err)
+			}
+		}
+
+		res = append(res, portBz...)
+		res = append(res, timeoutBz...)
+		res = append(res, fallbackRecipientBz...)
+	}
+
+	if version >= IBCForwardMetadataV2 {
+		relayerTipBz := make([]byte, 8)
+		binary.BigEndian.PutUint64(relayerTipBz, m.RelayerTip)
+
+		res = append(res, relayerTipBz...)
+	}
+
+	if version >= IBCForwardMetadataV3 {
+		hopCountBz := make([]byte, 4)
+		binary.BigEndian.PutUint32(hopCountBz, uint32(len(m.Hops)))
+		res = append(res, hopCountBz...)
+
+		for _, hop := range m.Hops {
+			var hopBz []byte
+			hopBz, err = hop.bytes()
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, hopBz...)
+		}
+	}
+
+	if version >= IBCForwardMetadataV4 {
+		requiredSubmitterBz := make([]byte, 32)
+		if m.RequiredSubmitter != "" {
+			var rawRequiredSubmitter []byte
+			rawRequiredSubmitter, err = sdk.GetFromBech32(m.RequiredSubmitter, sdk.GetConfig().GetBech32AccountAddrPrefix())
+			if err != nil {
+				return
+			}
+			requiredSubmitterBz, err = padAddress(rawRequiredSubmitter)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(ErrDecodingIBCForward, "invalid required submitter: %s", err)
+			}
+		}
+
+		res = append(res, requiredSubmitterBz...)
+	}
+
 	res = append(res, []byte(m.Memo)...)
 	return
 }
+
+// bytes encodes a hop into the fixed size hop of a v3 payload.
+func (h ForwardHop) bytes() (res []byte, err error) {
+	channelBz := make([]byte, 8)
+	rawChannel, err := channelTypes.ParseChannelSequence(h.Channel)
+	if err != nil {
+		return
+	}
+	binary.BigEndian.PutUint64(channelBz, rawChannel)
+
+	prefix, rawReceiver, err := bech32.DecodeAndConvert(h.Receiver)
+	if err != nil {
+		return
+	}
+	if len(prefix) > PrefixLength {
+		return nil, sdkerrors.Wrapf(ErrDecodingIBCForward, "hop receiver %s does not fit in a hop", h.Receiver)
+	}
+
+	prefixBz := make([]byte, 32)
+	copy(prefixBz[32-len(prefix):], prefix)
+
+	receiverBz, err := padAddress(rawReceiver)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrDecodingIBCForward, "invalid hop receiver %s: %s", h.Receiver, err)
+	}
+
+	timeoutBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(timeoutBz, h.TimeoutInNanoseconds)
+
+	res = append(res, channelBz...)
+	res = append(res, prefixBz...)
+	res = append(res, receiverBz...)
+	res = append(res, timeoutBz...)
+	return
+}
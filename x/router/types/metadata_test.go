@@ -0,0 +1,397 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/noble/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIBCForwardMetadataRoundTrip(t *testing.T) {
+	recipient := sample.AccAddress()
+	fallbackRecipient := sample.AccAddress()
+	hopReceiver, err := sdk.Bech32ifyAddressBytes("osmo", []byte("hop_receiver_address"))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc     string
+		version  uint32
+		metadata IBCForwardMetadata
+		expected IBCForwardMetadata
+	}{
+		{
+			desc:    "v0",
+			version: IBCForwardMetadataV0,
+			metadata: IBCForwardMetadata{
+				Nonce:               42,
+				Port:                "transfer",
+				Channel:             "channel-0",
+				DestinationReceiver: recipient,
+				Memo:                "Hello, World!",
+			},
+			expected: IBCForwardMetadata{
+				Nonce:               42,
+				Port:                "transfer",
+				Channel:             "channel-0",
+				DestinationReceiver: recipient,
+				Memo:                "Hello, World!",
+			},
+		},
+		{
+			desc:    "v0 drops the v1 fields",
+			version: IBCForwardMetadataV0,
+			metadata: IBCForwardMetadata{
+				Nonce:                42,
+				Port:                 "custom",
+				Channel:              "channel-3",
+				DestinationReceiver:  recipient,
+				TimeoutInNanoseconds: 1000,
+				FallbackRecipient:    fallbackRecipient,
+			},
+			expected: IBCForwardMetadata{
+				Nonce:               42,
+				Port:                "transfer",
+				Channel:             "channel-3",
+				DestinationReceiver: recipient,
+			},
+		},
+		{
+			desc:    "v1",
+			version: IBCForwardMetadataV1,
+			metadata: IBCForwardMetadata{
+				Nonce:                42,
+				Port:                 "custom",
+				Channel:              "channel-3",
+				DestinationReceiver:  recipient,
+				Memo:                 "Hello, World!",
+				TimeoutInNanoseconds: 1000,
+				FallbackRecipient:    fallbackRecipient,
+			},
+			expected: IBCForwardMetadata{
+				Nonce:                42,
+				Port:                 "custom",
+				Channel:              "channel-3",
+				DestinationReceiver:  recipient,
+				Memo:                 "Hello, World!",
+				TimeoutInNanoseconds: 1000,
+				FallbackRecipient:    fallbackRecipient,
+			},
+		},
+		{
+			desc:    "v1 drops the v2 fields",
+			version: IBCForwardMetadataV1,
+			metadata: IBCForwardMetadata{
+				Nonce:               42,
+				Channel:             "channel-3",
+				DestinationReceiver: recipient,
+				RelayerTip:          500,
+			},
+			expected: IBCForwardMetadata{
+				Nonce:               42,
+				Port:                "transfer",
+				Channel:             "channel-3",
+				DestinationReceiver: recipient,
+			},
+		},
+		{
+			desc:    "v2",
+			version: IBCForwardMetadataV2,
+			metadata: IBCForwardMetadata{
+				Nonce:                42,
+				Port:                 "custom",
+				Channel:              "channel-3",
+				DestinationReceiver:  recipient,
+				Memo:                 "Hello, World!",
+				TimeoutInNanoseconds: 1000,
+				FallbackRecipient:    fallbackRecipient,
+				RelayerTip:           500,
+			},
+			expected: IBCForwardMetadata{
+				Nonce:                42,
+				Port:                 "custom",
+				Channel:              "channel-3",
+				DestinationReceiver:  recipient,
+				Memo:                 "Hello, World!",
+				TimeoutInNanoseconds: 1000,
+				FallbackRecipient:    fallbackRecipient,
+				RelayerTip:           500,
+			},
+		},
+		{
+			desc:    "v3",
+			version: IBCForwardMetadataV3,
+			metadata: IBCForwardMetadata{
+				Nonce:               42,
+				Channel:             "channel-3",
+				DestinationReceiver: recipient,
+				Memo:                "{}",
+				Hops: []ForwardHop{
+					{Channel: "channel-1", Receiver: hopReceiver, TimeoutInNanoseconds: 1000},
+					{Channel: "channel-2", Receiver: recipient},
+				},
+			},
+			expected: IBCForwardMetadata{
+				Nonce:               42,
+				Port:                "transfer",
+				Channel:             "channel-3",
+				DestinationReceiver: recipient,
+				Memo:                "{}",
+				Hops: []ForwardHop{
+					{Channel: "channel-1", Receiver: hopReceiver, TimeoutInNanoseconds: 1000},
+					{Channel: "channel-2", Receiver: recipient},
+				},
+			},
+		},
+		{
+			desc:    "v4",
+			version: IBCForwardMetadataV4,
+			metadata: IBCForwardMetadata{
+				Nonce:               42,
+				Channel:             "channel-3",
+				DestinationReceiver: recipient,
+				Memo:                "{}",
+				Hops:                []ForwardHop{{Channel: "channel-1", Receiver: hopReceiver}},
+				RequiredSubmitter:   fallbackRecipient,
+			},
+			expected: IBCForwardMetadata{
+				Nonce:               42,
+				Port:                "transfer",
+				Channel:             "channel-3",
+				DestinationReceiver: recipient,
+				Memo:                "{}",
+				Hops:                []ForwardHop{{Channel: "channel-1", Receiver: hopReceiver}},
+				RequiredSubmitter:   fallbackRecipient,
+			},
+		},
+		{
+			desc:    "v1 without port and fallback recipient",
+			version: IBCForwardMetadataV1,
+			metadata: IBCForwardMetadata{
+				Nonce:               42,
+				Channel:             "channel-3",
+				DestinationReceiver: recipient,
+			},
+			expected: IBCForwardMetadata{
+				Nonce:               42,
+				Port:                "transfer",
+				Channel:             "channel-3",
+				DestinationReceiver: recipient,
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			bz, err := tc.metadata.Bytes(tc.version, sdk.Bech32PrefixAccAddr)
+			require.NoError(t, err)
+			if tc.version == IBCForwardMetadataV0 {
+				require.False(t, bytes.HasPrefix(bz, EnvelopeMagic))
+			} else {
+				require.Equal(t, EnvelopeMagic, bz[MagicIndex:VersionIndex])
+				require.Equal(t, tc.version, binary.BigEndian.Uint32(bz[VersionIndex:EnvelopeLength]))
+			}
+
+			result, err := new(IBCForwardMetadata).Parse(bz)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, *result)
+		})
+	}
+}
+
+func TestIBCForwardMetadataParseInvalid(t *testing.T) {
+	metadata := IBCForwardMetadata{
+		Nonce:               42,
+		Channel:             "channel-0",
+		DestinationReceiver: sample.AccAddress(),
+	}
+
+	v0, err := metadata.Bytes(IBCForwardMetadataV0, sdk.Bech32PrefixAccAddr)
+	require.NoError(t, err)
+
+	envelope := func(version uint32, payload []byte) []byte {
+		bz := append(append([]byte{}, EnvelopeMagic...), make([]byte, VersionLength)...)
+		binary.BigEndian.PutUint32(bz[VersionIndex:EnvelopeLength], version)
+		return append(bz, payload...)
+	}
+
+	// a v0 payload is too short to be read as v1
+	_, err = new(IBCForwardMetadata).Parse(envelope(IBCForwardMetadataV1, v0))
+	require.ErrorIs(t, err, ErrDecodingIBCForward)
+
+	// v0 is only sent without envelope, unknown versions are rejected
+	_, err = new(IBCForwardMetadata).Parse(envelope(IBCForwardMetadataV0, v0))
+	require.ErrorIs(t, err, ErrDecodingIBCForward)
+
+	_, err = new(IBCForwardMetadata).Parse(envelope(LatestIBCForwardMetadataVersion+1, v0))
+	require.ErrorIs(t, err, ErrDecodingIBCForward)
+
+	_, err = new(IBCForwardMetadata).Parse(EnvelopeMagic)
+	require.ErrorIs(t, err, ErrDecodingIBCForward)
+
+	_, err = new(IBCForwardMetadata).Parse([]byte{0, 0})
+	require.ErrorIs(t, err, ErrDecodingIBCForward)
+
+	_, err = metadata.Bytes(LatestIBCForwardMetadataVersion+1, sdk.Bech32PrefixAccAddr)
+	require.ErrorIs(t, err, ErrDecodingIBCForward)
+
+	// a v0 nonce starting with the magic cannot be told apart from an envelope
+	metadata.Nonce = math.MaxUint64
+	_, err = metadata.Bytes(IBCForwardMetadataV0, sdk.Bech32PrefixAccAddr)
+	require.ErrorIs(t, err, ErrDecodingIBCForward)
+
+	_, err = metadata.Bytes(IBCForwardMetadataV1, sdk.Bech32PrefixAccAddr)
+	require.NoError(t, err)
+}
+
+func TestIBCForwardMetadataParseLegacy(t *testing.T) {
+	// a forward to osmo1nuk4azjpcwc8umglfgkgtcus0vtdfs4gxmep6k over channel-750, as sent before the metadata was versioned
+	bz, err := hex.DecodeString(
+		"0000000000001079" + // nonce
+			"0000000000000000000000000000000000000000000000000000000000000000" + // sender
+			"00000000000002ee" + // channel
+			"000000000000000000000000000000000000000000000000000000006f736d6f" + // bech32 prefix
+			"0000000000000000000000009f2d5e8a41c3b07e6d1f4a2c85e3907b16d4c2a8" + // recipient
+			"7b227761736d223a7b7d7d", // memo
+	)
+	require.NoError(t, err)
+
+	result, err := new(IBCForwardMetadata).Parse(bz)
+	require.NoError(t, err)
+	require.Equal(t, IBCForwardMetadata{
+		Nonce:               4217,
+		Port:                "transfer",
+		Channel:             "channel-750",
+		DestinationReceiver: "osmo1nuk4azjpcwc8umglfgkgtcus0vtdfs4gxmep6k",
+		Memo:                `{"wasm":{}}`,
+	}, *result)
+}
+
+func TestIBCForwardMetadataParseMalformed(t *testing.T) {
+	metadata := IBCForwardMetadata{
+		Nonce:               42,
+		Channel:             "channel-0",
+		DestinationReceiver: sample.AccAddress(),
+		Memo:                "Hello, World!",
+	}
+
+	valid, err := metadata.Bytes(IBCForwardMetadataV0, sdk.Bech32PrefixAccAddr)
+	require.NoError(t, err)
+
+	prefixIndex := PrefixIndex
+	recipientIndex := RecipientIndex
+	memoIndex := MemoIndex
+
+	for _, tc := range []struct {
+		desc   string
+		mutate func(bz []byte) []byte
+		err    string
+	}{
+		{
+			desc: "empty prefix",
+			mutate: func(bz []byte) []byte {
+				copy(bz[prefixIndex:recipientIndex], make([]byte, PrefixLength))
+				return bz
+			},
+			err: "bech32 prefix cannot be empty",
+		},
+		{
+			desc: "prefix with invalid character",
+			mutate: func(bz []byte) []byte {
+				bz[recipientIndex-1] = ' '
+				return bz
+			},
+			err: "bech32 prefix contains invalid character",
+		},
+		{
+			desc: "uppercase prefix",
+			mutate: func(bz []byte) []byte {
+				bz[recipientIndex-1] = 'S'
+				return bz
+			},
+			err: "bech32 prefix contains invalid character",
+		},
+		{
+			desc: "empty recipient",
+			mutate: func(bz []byte) []byte {
+				copy(bz[recipientIndex:memoIndex], make([]byte, RecipientLength))
+				return bz
+			},
+			err: "address cannot be empty",
+		},
+		{
+			desc: "invalid UTF-8 memo",
+			mutate: func(bz []byte) []byte {
+				return append(bz[:memoIndex], 0xff, 0xfe)
+			},
+			err: "memo is not valid UTF-8",
+		},
+		{
+			desc: "memo too long",
+			mutate: func(bz []byte) []byte {
+				return append(bz[:memoIndex], make([]byte, MaxMemoLength+1)...)
+			},
+			err: "memo cannot be longer than",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			bz := tc.mutate(append([]byte{}, valid...))
+
+			require.NotPanics(t, func() {
+				_, err = new(IBCForwardMetadata).Parse(bz)
+			})
+			require.ErrorIs(t, err, ErrDecodingIBCForward)
+			require.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestIBCForwardMetadataParseRecipientLength(t *testing.T) {
+	// 20 byte addresses starting with a zero byte keep their full length
+	recipient := sdk.AccAddress(append([]byte{0}, make([]byte, 19)...))
+	recipient[19] = 1
+	metadata := IBCForwardMetadata{
+		Nonce:               42,
+		Channel:             "channel-0",
+		DestinationReceiver: recipient.String(),
+	}
+
+	bz, err := metadata.Bytes(IBCForwardMetadataV0, sdk.Bech32PrefixAccAddr)
+	require.NoError(t, err)
+
+	result, err := new(IBCForwardMetadata).Parse(bz)
+	require.NoError(t, err)
+	require.Equal(t, recipient.String(), result.DestinationReceiver)
+
+	// 32 byte addresses are supported as well
+	recipient32 := sdk.AccAddress(make([]byte, 32))
+	recipient32[0] = 1
+	metadata.DestinationReceiver = recipient32.String()
+
+	bz, err = metadata.Bytes(IBCForwardMetadataV0, sdk.Bech32PrefixAccAddr)
+	require.NoError(t, err)
+
+	result, err = new(IBCForwardMetadata).Parse(bz)
+	require.NoError(t, err)
+	require.Equal(t, recipient32.String(), result.DestinationReceiver)
+}
+
+func TestIBCForwardMetadataBytesAddressLength(t *testing.T) {
+	// only 20 and 32 byte addresses can be recovered from their padded field
+	for _, length := range []int{19, 25, 33} {
+		address := make([]byte, length)
+		address[0] = 1
+		metadata := IBCForwardMetadata{
+			Nonce:               42,
+			Channel:             "channel-0",
+			DestinationReceiver: sdk.AccAddress(address).String(),
+		}
+
+		_, err := metadata.Bytes(IBCForwardMetadataV0, sdk.Bech32PrefixAccAddr)
+		require.ErrorIs(t, err, ErrDecodingIBCForward)
+		require.Contains(t, err.Error(), fmt.Sprintf("address must be 20 or 32 bytes, got %d", length))
+	}
+}
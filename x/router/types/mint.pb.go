@@ -34,7 +34,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // @param destination_domain - destination domain id
 // @param mint_recipient - address to receive minted tokens on destination
 // @param height - height at which the mint occurred
-// domain
+// @param recipient - recipient of the outer CCTP message
+// @param destination_caller - caller allowed to receive the outer CCTP message,
+// empty if any address can
 type Mint struct {
 	SourceDomain       uint32      `protobuf:"varint,1,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
 	SourceDomainSender []byte      `protobuf:"bytes,2,opt,name=source_domain_sender,json=sourceDomainSender,proto3" json:"source_domain_sender,omitempty"`
@@ -43,6 +45,8 @@ type Mint struct {
 	DestinationDomain  uint32      `protobuf:"varint,5,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
 	MintRecipient      string      `protobuf:"bytes,6,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
 	Height             uint64      `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Recipient          []byte      `protobuf:"bytes,8,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	DestinationCaller  []byte      `protobuf:"bytes,9,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
 }
 
 func (m *Mint) Reset()         { *m = Mint{} }
@@ -127,6 +131,20 @@ func (m *Mint) GetHeight() uint64 {
 	return 0
 }
 
+func (m *Mint) GetRecipient() []byte {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+func (m *Mint) GetDestinationCaller() []byte {
+	if m != nil {
+		return m.DestinationCaller
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Mint)(nil), "noble.router.Mint")
 }
@@ -134,29 +152,30 @@ func init() {
 func init() { proto.RegisterFile("router/mint.proto", fileDescriptor_650d9754adf300ce) }
 
 var fileDescriptor_650d9754adf300ce = []byte{
-	// 338 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xb1, 0x4e, 0xe3, 0x40,
-	0x10, 0x86, 0xb3, 0xb9, 0xc4, 0xa7, 0xdb, 0x4b, 0x90, 0xb2, 0x8a, 0x90, 0x49, 0x61, 0x59, 0x20,
-	0x24, 0x37, 0xf1, 0x12, 0x28, 0xe9, 0x80, 0x96, 0xc6, 0x88, 0x86, 0x26, 0xb2, 0x9d, 0x91, 0xb3,
-	0x52, 0x3c, 0x13, 0xed, 0xae, 0x23, 0x78, 0x0b, 0x0a, 0x1e, 0x8a, 0x32, 0x25, 0x25, 0x4a, 0x5e,
-	0x04, 0x79, 0xed, 0x48, 0xa1, 0x9b, 0xf9, 0xfe, 0xbf, 0xf8, 0x34, 0xc3, 0x47, 0x9a, 0x2a, 0x0b,
-	0x5a, 0x96, 0x0a, 0x6d, 0xbc, 0xd6, 0x64, 0x49, 0x0c, 0x90, 0xb2, 0x15, 0xc4, 0x4d, 0x30, 0x09,
-	0x72, 0x32, 0x25, 0x19, 0x99, 0xa5, 0x06, 0xe4, 0x66, 0x96, 0x81, 0x4d, 0x67, 0x32, 0x27, 0x85,
-	0x4d, 0x7b, 0x32, 0x2e, 0xa8, 0x20, 0x37, 0xca, 0x7a, 0x6a, 0xe8, 0xf9, 0x47, 0x97, 0xf7, 0x1e,
-	0x15, 0x5a, 0x71, 0xc1, 0x87, 0x86, 0x2a, 0x9d, 0xc3, 0x7c, 0x41, 0x65, 0xaa, 0xd0, 0x67, 0x21,
-	0x8b, 0x86, 0xc9, 0xa0, 0x81, 0x0f, 0x8e, 0x89, 0x2b, 0x3e, 0xfe, 0x55, 0x9a, 0x1b, 0xc0, 0x05,
-	0x68, 0xbf, 0x1b, 0xb2, 0x68, 0x90, 0x88, 0xe3, 0xee, 0x93, 0x4b, 0xc4, 0x98, 0xf7, 0x91, 0x30,
-	0x07, 0xff, 0x4f, 0xc8, 0xa2, 0x5e, 0xd2, 0x2c, 0x62, 0xc6, 0xbd, 0xb4, 0xa4, 0x0a, 0xad, 0xdf,
-	0x0b, 0x59, 0xf4, 0xff, 0xfa, 0x2c, 0x6e, 0xe4, 0xe3, 0x5a, 0x3e, 0x6e, 0xe5, 0xe3, 0x7b, 0x52,
-	0x98, 0xb4, 0x45, 0x31, 0xe5, 0x62, 0x01, 0xc6, 0x2a, 0x4c, 0xad, 0x22, 0x3c, 0x48, 0xf6, 0x9d,
-	0xe4, 0xe8, 0x28, 0x69, 0x4d, 0x2f, 0xf9, 0x49, 0x7d, 0xa9, 0xb9, 0x86, 0x5c, 0xad, 0x15, 0xa0,
-	0xf5, 0xbd, 0x90, 0x45, 0xff, 0x92, 0x61, 0x4d, 0x93, 0x03, 0x14, 0xa7, 0xdc, 0x5b, 0x82, 0x2a,
-	0x96, 0xd6, 0xff, 0xeb, 0xfc, 0xda, 0xed, 0xee, 0xf9, 0x73, 0x17, 0xb0, 0xed, 0x2e, 0x60, 0xdf,
-	0xbb, 0x80, 0xbd, 0xef, 0x83, 0xce, 0x76, 0x1f, 0x74, 0xbe, 0xf6, 0x41, 0xe7, 0xe5, 0xb6, 0x50,
-	0x76, 0x59, 0x65, 0x71, 0x4e, 0xa5, 0x34, 0x56, 0xa7, 0x58, 0xc0, 0x8a, 0x36, 0x30, 0xdd, 0x00,
-	0xda, 0x4a, 0x83, 0x91, 0xee, 0x29, 0xd3, 0xf6, 0x5b, 0xaf, 0xb2, 0x1d, 0xec, 0xdb, 0x1a, 0x4c,
-	0xe6, 0xb9, 0xa3, 0xdf, 0xfc, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x4e, 0x1a, 0xf6, 0xcd, 0x01,
-	0x00, 0x00,
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0x19, 0x2e, 0xf4, 0x5e, 0xe6, 0xc2, 0x4d, 0x98, 0x90, 0x9b, 0x4a, 0x4c, 0xd3, 0x68,
+	0x4c, 0xba, 0xa1, 0x23, 0xba, 0x74, 0x27, 0x6e, 0xdd, 0xd4, 0xb8, 0x71, 0x43, 0xda, 0x72, 0x52,
+	0x26, 0x69, 0xe7, 0x90, 0x99, 0x29, 0xd1, 0xb7, 0xf0, 0xb1, 0x5c, 0xb2, 0x74, 0xa9, 0xf0, 0x22,
+	0xa6, 0xd3, 0x12, 0xd0, 0xdd, 0x39, 0xdf, 0xff, 0x37, 0xf9, 0x3a, 0x87, 0x0e, 0x15, 0x96, 0x06,
+	0x14, 0x2f, 0x84, 0x34, 0xe1, 0x4a, 0xa1, 0x41, 0xd6, 0x97, 0x98, 0xe4, 0x10, 0xd6, 0xc1, 0xd8,
+	0x4b, 0x51, 0x17, 0xa8, 0x79, 0x12, 0x6b, 0xe0, 0xeb, 0x69, 0x02, 0x26, 0x9e, 0xf2, 0x14, 0x85,
+	0xac, 0xdb, 0xe3, 0x51, 0x86, 0x19, 0xda, 0x91, 0x57, 0x53, 0x4d, 0xcf, 0x3e, 0xdb, 0xb4, 0x73,
+	0x2f, 0xa4, 0x61, 0xe7, 0x74, 0xa0, 0xb1, 0x54, 0x29, 0xcc, 0x17, 0x58, 0xc4, 0x42, 0xba, 0xc4,
+	0x27, 0xc1, 0x20, 0xea, 0xd7, 0xf0, 0xce, 0x32, 0x76, 0x49, 0x47, 0xdf, 0x4a, 0x73, 0x0d, 0x72,
+	0x01, 0xca, 0x6d, 0xfb, 0x24, 0xe8, 0x47, 0xec, 0xb8, 0xfb, 0x60, 0x13, 0x36, 0xa2, 0x5d, 0x89,
+	0x32, 0x05, 0xf7, 0x97, 0x4f, 0x82, 0x4e, 0x54, 0x2f, 0x6c, 0x4a, 0x9d, 0xb8, 0xc0, 0x52, 0x1a,
+	0xb7, 0xe3, 0x93, 0xe0, 0xef, 0xd5, 0x49, 0x58, 0xcb, 0x87, 0x95, 0x7c, 0xd8, 0xc8, 0x87, 0x33,
+	0x14, 0x32, 0x6a, 0x8a, 0x6c, 0x42, 0xd9, 0x02, 0xb4, 0x11, 0x32, 0x36, 0x02, 0xe5, 0x5e, 0xb2,
+	0x6b, 0x25, 0x87, 0x47, 0x49, 0x63, 0x7a, 0x41, 0xff, 0x55, 0x2f, 0x35, 0x57, 0x90, 0x8a, 0x95,
+	0x00, 0x69, 0x5c, 0xc7, 0x27, 0x41, 0x2f, 0x1a, 0x54, 0x34, 0xda, 0x43, 0xf6, 0x9f, 0x3a, 0x4b,
+	0x10, 0xd9, 0xd2, 0xb8, 0xbf, 0xad, 0x5f, 0xb3, 0xb1, 0x53, 0xda, 0x3b, 0x7c, 0xf9, 0xc7, 0xfe,
+	0xdd, 0x01, 0xfc, 0x74, 0x49, 0xe3, 0x3c, 0x07, 0xe5, 0xf6, 0x6c, 0xed, 0xd8, 0x65, 0x66, 0x83,
+	0xdb, 0xc7, 0xb7, 0xad, 0x47, 0x36, 0x5b, 0x8f, 0x7c, 0x6c, 0x3d, 0xf2, 0xba, 0xf3, 0x5a, 0x9b,
+	0x9d, 0xd7, 0x7a, 0xdf, 0x79, 0xad, 0xa7, 0x9b, 0x4c, 0x98, 0x65, 0x99, 0x84, 0x29, 0x16, 0x5c,
+	0x1b, 0x15, 0xcb, 0x0c, 0x72, 0x5c, 0xc3, 0x64, 0x0d, 0xd2, 0x94, 0x0a, 0x34, 0xb7, 0x17, 0x9e,
+	0x34, 0xa7, 0x7f, 0xe6, 0xcd, 0x60, 0x5e, 0x56, 0xa0, 0x13, 0xc7, 0x5e, 0xf0, 0xfa, 0x6b, 0x00,
+	0x17, 0x0d, 0x6b, 0x55, 0x1a, 0x02, 0x00, 0x00,
 }
 
 func (m *Mint) Marshal() (dAtA []byte, err error) {
@@ -179,6 +198,20 @@ func (m *Mint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DestinationCaller) > 0 {
+		i -= len(m.DestinationCaller)
+		copy(dAtA[i:], m.DestinationCaller)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.DestinationCaller)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Height != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.Height))
 		i--
@@ -269,6 +302,14 @@ func (m *Mint) Size() (n int) {
 	if m.Height != 0 {
 		n += 1 + sovMint(uint64(m.Height))
 	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.DestinationCaller)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
 	return n
 }
 
@@ -485,6 +526,74 @@ func (m *Mint) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationCaller = append(m.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestinationCaller == nil {
+				m.DestinationCaller = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
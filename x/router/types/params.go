@@ -2,43 +2,110 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
-const DefaultMintPruneBlocks = 37028
+const (
+	DefaultMintPruneBlocks   = 37028
+	DefaultMaxForwardRetries = 5
+)
+
+// DefaultMaxRelativePacketTimeoutTimestamp is the default maximum relative timeout (in nanoseconds)
+// a sender can request for the packet of an IBC forward.
+const DefaultMaxRelativePacketTimeoutTimestamp = uint64(24 * time.Hour)
+
+// MinimumRelativePacketTimeoutTimestamp is the minimum relative timeout (in nanoseconds) of the packet of an
+// IBC forward, the MaxRelativePacketTimeoutTimestamp param cannot be below it. Shorter timeouts are rejected
+// to prevent DoS styled attacks on relayer resources.
+const MinimumRelativePacketTimeoutTimestamp = uint64(30 * time.Second)
+
+// Upper bounds of the params, so that a mistaken update cannot keep unmatched mints or retry forwards
+// for practically forever.
+const (
+	MaxMintPruneBlocks                   = uint64(1_000_000)
+	MaxMaxForwardRetries                 = uint64(100)
+	MaxMaxRelativePacketTimeoutTimestamp = uint64(7 * 24 * time.Hour)
+)
 
-var KeyMintPruneBlocks = []byte("MintPruneBlocks")
+var (
+	KeyMintPruneBlocks                   = []byte("MintPruneBlocks")
+	KeyMaxForwardRetries                 = []byte("MaxForwardRetries")
+	KeyMaxRelativePacketTimeoutTimestamp = []byte("MaxRelativePacketTimeoutTimestamp")
+	KeyFeeCollector                      = []byte("FeeCollector")
+	KeySourceDomainFees                  = []byte("SourceDomainFees")
+	KeyChannelFees                       = []byte("ChannelFees")
+)
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
-// ParamKeyTable the param key table for launch module
+// ParamKeyTable the param key table of the legacy x/params subspace, which only the store migrations read
+// since the params are kept in the module store
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams(mintPruneBlocks uint64) Params {
+func NewParams(
+	mintPruneBlocks uint64,
+	maxForwardRetries uint64,
+	maxRelativePacketTimeoutTimestamp uint64,
+	feeCollector string,
+	sourceDomainFees []SourceDomainFee,
+	channelFees []ChannelFee,
+) Params {
 	return Params{
-		MintPruneBlocks: mintPruneBlocks,
+		MintPruneBlocks:                   mintPruneBlocks,
+		MaxForwardRetries:                 maxForwardRetries,
+		MaxRelativePacketTimeoutTimestamp: maxRelativePacketTimeoutTimestamp,
+		FeeCollector:                      feeCollector,
+		SourceDomainFees:                  sourceDomainFees,
+		ChannelFees:                       channelFees,
 	}
 }
 
-// DefaultParams returns a default set of parameters
+// DefaultParams returns a default set of parameters, no fees are charged by default
 func DefaultParams() Params {
-	return NewParams(DefaultMintPruneBlocks)
+	return NewParams(DefaultMintPruneBlocks, DefaultMaxForwardRetries, DefaultMaxRelativePacketTimeoutTimestamp, "", nil, nil)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMintPruneBlocks, &p.MintPruneBlocks, validateMintPruneBlocks),
+		paramtypes.NewParamSetPair(KeyMaxForwardRetries, &p.MaxForwardRetries, validateMaxForwardRetries),
+		paramtypes.NewParamSetPair(KeyMaxRelativePacketTimeoutTimestamp, &p.MaxRelativePacketTimeoutTimestamp, validateMaxRelativePacketTimeoutTimestamp),
+		paramtypes.NewParamSetPair(KeyFeeCollector, &p.FeeCollector, validateFeeCollector),
+		paramtypes.NewParamSetPair(KeySourceDomainFees, &p.SourceDomainFees, validateSourceDomainFees),
+		paramtypes.NewParamSetPair(KeyChannelFees, &p.ChannelFees, validateChannelFees),
 	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateMintPruneBlocks(p.MintPruneBlocks); err != nil {
+		return err
+	}
+	if err := validateMaxForwardRetries(p.MaxForwardRetries); err != nil {
+		return err
+	}
+	if err := validateMaxRelativePacketTimeoutTimestamp(p.MaxRelativePacketTimeoutTimestamp); err != nil {
+		return err
+	}
+	if err := validateFeeCollector(p.FeeCollector); err != nil {
+		return err
+	}
+	if err := validateSourceDomainFees(p.SourceDomainFees); err != nil {
+		return err
+	}
+	if err := validateChannelFees(p.ChannelFees); err != nil {
+		return err
+	}
+	if p.FeeCollector == "" && (len(p.SourceDomainFees) > 0 || len(p.ChannelFees) > 0) {
+		return fmt.Errorf("fee collector must be set when fees are configured")
+	}
 	return nil
 }
 
@@ -52,9 +119,40 @@ func (p Params) String() string {
 }
 
 func validateMintPruneBlocks(i interface{}) error {
-	_, ok := i.(uint64)
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("mint prune blocks must be positive")
+	}
+	if v > MaxMintPruneBlocks {
+		return fmt.Errorf("mint prune blocks %d exceeds the maximum of %d", v, MaxMintPruneBlocks)
+	}
+	return nil
+}
+
+func validateMaxForwardRetries(i interface{}) error {
+	v, ok := i.(uint64)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if v > MaxMaxForwardRetries {
+		return fmt.Errorf("max forward retries %d exceeds the maximum of %d", v, MaxMaxForwardRetries)
+	}
+	return nil
+}
+
+func validateMaxRelativePacketTimeoutTimestamp(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < MinimumRelativePacketTimeoutTimestamp {
+		return fmt.Errorf("max relative packet timeout timestamp %d is below the minimum of %d", v, MinimumRelativePacketTimeoutTimestamp)
+	}
+	if v > MaxMaxRelativePacketTimeoutTimestamp {
+		return fmt.Errorf("max relative packet timeout timestamp %d exceeds the maximum of %d", v, MaxMaxRelativePacketTimeoutTimestamp)
+	}
 	return nil
 }
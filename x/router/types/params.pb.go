@@ -25,7 +25,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	MintPruneBlocks uint64 `protobuf:"varint,2,opt,name=mint_prune_blocks,json=mintPruneBlocks,proto3" json:"mint_prune_blocks,omitempty" yaml:"mint_prune_blocks"`
+	MintPruneBlocks                   uint64            `protobuf:"varint,2,opt,name=mint_prune_blocks,json=mintPruneBlocks,proto3" json:"mint_prune_blocks,omitempty" yaml:"mint_prune_blocks"`
+	MaxForwardRetries                 uint64            `protobuf:"varint,3,opt,name=max_forward_retries,json=maxForwardRetries,proto3" json:"max_forward_retries,omitempty" yaml:"max_forward_retries"`
+	MaxRelativePacketTimeoutTimestamp uint64            `protobuf:"varint,4,opt,name=max_relative_packet_timeout_timestamp,json=maxRelativePacketTimeoutTimestamp,proto3" json:"max_relative_packet_timeout_timestamp,omitempty" yaml:"max_relative_packet_timeout_timestamp"`
+	FeeCollector                      string            `protobuf:"bytes,5,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty" yaml:"fee_collector"`
+	SourceDomainFees                  []SourceDomainFee `protobuf:"bytes,6,rep,name=source_domain_fees,json=sourceDomainFees,proto3" json:"source_domain_fees,omitempty" yaml:"source_domain_fees"`
+	ChannelFees                       []ChannelFee      `protobuf:"bytes,7,rep,name=channel_fees,json=channelFees,proto3" json:"channel_fees,omitempty" yaml:"channel_fees"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -67,6 +72,41 @@ func (m *Params) GetMintPruneBlocks() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxForwardRetries() uint64 {
+	if m != nil {
+		return m.MaxForwardRetries
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRelativePacketTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.MaxRelativePacketTimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *Params) GetFeeCollector() string {
+	if m != nil {
+		return m.FeeCollector
+	}
+	return ""
+}
+
+func (m *Params) GetSourceDomainFees() []SourceDomainFee {
+	if m != nil {
+		return m.SourceDomainFees
+	}
+	return nil
+}
+
+func (m *Params) GetChannelFees() []ChannelFee {
+	if m != nil {
+		return m.ChannelFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "noble.router.Params")
 }
@@ -74,22 +114,39 @@ func init() {
 func init() { proto.RegisterFile("router/params.proto", fileDescriptor_07b581fc794c2a31) }
 
 var fileDescriptor_07b581fc794c2a31 = []byte{
-	// 230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2e, 0xca, 0x2f, 0x2d,
-	0x49, 0x2d, 0xd2, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0xe2, 0xc9, 0xcb, 0x4f, 0xca, 0x49, 0xd5, 0x83, 0x48, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83,
-	0x25, 0xf4, 0x41, 0x2c, 0x88, 0x1a, 0xa5, 0x72, 0x2e, 0xb6, 0x00, 0xb0, 0x1e, 0xa1, 0x74, 0x2e,
-	0xc1, 0xdc, 0xcc, 0xbc, 0x92, 0xf8, 0x82, 0xa2, 0xd2, 0xbc, 0xd4, 0xf8, 0xa4, 0x9c, 0xfc, 0xe4,
-	0xec, 0x62, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x16, 0x27, 0xeb, 0x57, 0xf7, 0xe4, 0xa5, 0x31, 0x24,
-	0x75, 0xf2, 0x73, 0x33, 0x4b, 0x52, 0x73, 0x0b, 0x4a, 0x2a, 0x3f, 0xdd, 0x93, 0x97, 0xa8, 0x4c,
-	0xcc, 0xcd, 0xb1, 0x52, 0xc2, 0x50, 0xa4, 0x14, 0xc4, 0x0f, 0x12, 0x0b, 0x00, 0x09, 0x39, 0x81,
-	0x45, 0xac, 0x58, 0x66, 0x2c, 0x90, 0x67, 0x70, 0x0a, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23,
-	0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6,
-	0x63, 0x39, 0x86, 0x28, 0xeb, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd,
-	0xe2, 0x92, 0xa2, 0xc4, 0xbc, 0xf4, 0xd4, 0x9c, 0xfc, 0xb2, 0x54, 0xdd, 0xb2, 0xd4, 0xbc, 0x92,
-	0xd2, 0xa2, 0xd4, 0x62, 0x7d, 0xb0, 0xb7, 0x74, 0xa1, 0x3e, 0xae, 0xd0, 0x87, 0x32, 0x4a, 0x2a,
-	0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xde, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x4c, 0x24,
-	0x4d, 0x66, 0x11, 0x01, 0x00, 0x00,
+	// 500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x63, 0x9a, 0x06, 0xe1, 0x06, 0xd1, 0x3a, 0x15, 0x98, 0x40, 0xed, 0x60, 0x09, 0x29,
+	0x43, 0x6b, 0x4b, 0x54, 0x2c, 0xed, 0x80, 0x70, 0x51, 0xe7, 0xc8, 0x14, 0x09, 0xb1, 0x58, 0x17,
+	0xf7, 0xd9, 0xb5, 0xea, 0xf3, 0x59, 0x77, 0xe7, 0x90, 0x7c, 0x09, 0xc4, 0xc8, 0xc8, 0x97, 0xe0,
+	0x3b, 0x74, 0xec, 0xc8, 0x64, 0xa1, 0x64, 0xf3, 0xc8, 0x17, 0x00, 0xf9, 0xce, 0x85, 0x58, 0x46,
+	0xa2, 0x93, 0xcf, 0xef, 0xff, 0x7b, 0xef, 0xfd, 0x86, 0x3b, 0x75, 0x40, 0x49, 0xce, 0x81, 0x3a,
+	0x19, 0xa2, 0x08, 0x33, 0x3b, 0xa3, 0x84, 0x13, 0xad, 0x9f, 0x92, 0x69, 0x02, 0xb6, 0x8c, 0x86,
+	0xbb, 0x11, 0x89, 0x88, 0x08, 0x9c, 0xea, 0x24, 0x99, 0xe1, 0x76, 0xdd, 0x18, 0x02, 0xc8, 0x8a,
+	0xf5, 0x6b, 0x53, 0xed, 0x4d, 0xc4, 0x18, 0x2d, 0x52, 0x77, 0x70, 0x9c, 0x72, 0x3f, 0xa3, 0x79,
+	0x0a, 0xfe, 0x34, 0x21, 0xc1, 0x25, 0xd3, 0xef, 0x8c, 0x94, 0x71, 0xd7, 0x3d, 0x2e, 0x0b, 0xf3,
+	0x49, 0x2b, 0xdc, 0x27, 0x38, 0xe6, 0x80, 0x33, 0xbe, 0xf8, 0x59, 0x98, 0xfa, 0x02, 0xe1, 0xe4,
+	0xc8, 0x6a, 0x41, 0x96, 0xf7, 0xa0, 0xaa, 0x4d, 0xaa, 0x92, 0x2b, 0x2a, 0x1a, 0x51, 0x07, 0x18,
+	0xcd, 0xfd, 0x90, 0xd0, 0x8f, 0x88, 0x9e, 0xfb, 0x14, 0x38, 0x8d, 0x81, 0xe9, 0x1b, 0x62, 0xd5,
+	0xab, 0xb2, 0x30, 0xf7, 0xfe, 0x11, 0x37, 0x96, 0x0d, 0xeb, 0x65, 0x6d, 0xcc, 0xf2, 0x76, 0x30,
+	0x9a, 0x9f, 0xca, 0xa2, 0x27, 0x6b, 0xda, 0x37, 0x45, 0x7d, 0x5e, 0xb1, 0x14, 0x12, 0xc4, 0xe3,
+	0x19, 0xf8, 0x19, 0x0a, 0x2e, 0x81, 0xfb, 0x3c, 0xc6, 0x40, 0x72, 0xf9, 0x65, 0x1c, 0xe1, 0x4c,
+	0xef, 0x0a, 0x87, 0xa0, 0x2c, 0x4c, 0xe7, 0x56, 0x0d, 0x0d, 0xab, 0xfd, 0xbf, 0x56, 0xff, 0x6d,
+	0xb4, 0xbc, 0x67, 0x18, 0xcd, 0xbd, 0x1a, 0x9b, 0x08, 0xea, 0x4c, 0x42, 0x67, 0x37, 0x8c, 0xf6,
+	0x5e, 0xbd, 0x1f, 0x02, 0xf8, 0x01, 0x49, 0x12, 0x08, 0x38, 0xa1, 0xfa, 0xe6, 0x48, 0x19, 0xdf,
+	0x73, 0x0f, 0xcb, 0xc2, 0x7c, 0xd4, 0x08, 0x1a, 0x1a, 0xbb, 0x52, 0xa3, 0x01, 0x58, 0x5e, 0x3f,
+	0x04, 0x38, 0xb9, 0xf9, 0xd5, 0x3e, 0x29, 0xaa, 0xc6, 0x48, 0x4e, 0x03, 0xf0, 0xcf, 0x09, 0x46,
+	0x71, 0xea, 0x87, 0x00, 0x4c, 0xef, 0x8d, 0x36, 0xc6, 0x5b, 0x2f, 0xf6, 0xec, 0xf5, 0xab, 0x64,
+	0xbf, 0x15, 0xdc, 0x1b, 0x81, 0x9d, 0x02, 0xb8, 0xaf, 0xaf, 0x0a, 0xb3, 0x53, 0x16, 0xe6, 0xd3,
+	0xf6, 0x80, 0x86, 0xc7, 0x63, 0xe9, 0xd1, 0xa6, 0x2c, 0x6f, 0x9b, 0x35, 0x67, 0x32, 0x8d, 0xaa,
+	0xfd, 0xe0, 0x02, 0xa5, 0x29, 0x24, 0xd2, 0xe4, 0xae, 0x30, 0xd1, 0x9b, 0x26, 0x27, 0x92, 0xa8,
+	0x24, 0x5e, 0xd6, 0x12, 0x0f, 0xd7, 0xbb, 0x1a, 0xeb, 0x07, 0x72, 0xfd, 0x7a, 0x6e, 0x79, 0x5b,
+	0xc1, 0x9f, 0x11, 0xec, 0xa8, 0xfb, 0xe5, 0xab, 0xd9, 0x71, 0xdf, 0x5d, 0x2d, 0x0d, 0xe5, 0x7a,
+	0x69, 0x28, 0x3f, 0x96, 0x86, 0xf2, 0x79, 0x65, 0x74, 0xae, 0x57, 0x46, 0xe7, 0xfb, 0xca, 0xe8,
+	0x7c, 0x38, 0x8e, 0x62, 0x7e, 0x91, 0x4f, 0xed, 0x80, 0x60, 0x87, 0x71, 0x8a, 0xd2, 0x08, 0x12,
+	0x32, 0x83, 0x83, 0x19, 0xa4, 0x3c, 0xa7, 0xc0, 0x1c, 0x21, 0x77, 0x50, 0xbf, 0xa9, 0xb9, 0x53,
+	0x1f, 0xf8, 0x22, 0x03, 0x36, 0xed, 0x89, 0xf7, 0x75, 0xf8, 0x7b, 0x00, 0x2f, 0xe6, 0x65, 0x67,
+	0xac, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -112,6 +169,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChannelFees) > 0 {
+		for iNdEx := len(m.ChannelFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SourceDomainFees) > 0 {
+		for iNdEx := len(m.SourceDomainFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceDomainFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FeeCollector) > 0 {
+		i -= len(m.FeeCollector)
+		copy(dAtA[i:], m.FeeCollector)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeCollector)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxRelativePacketTimeoutTimestamp != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRelativePacketTimeoutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxForwardRetries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxForwardRetries))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.MintPruneBlocks != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MintPruneBlocks))
 		i--
@@ -140,6 +242,28 @@ func (m *Params) Size() (n int) {
 	if m.MintPruneBlocks != 0 {
 		n += 1 + sovParams(uint64(m.MintPruneBlocks))
 	}
+	if m.MaxForwardRetries != 0 {
+		n += 1 + sovParams(uint64(m.MaxForwardRetries))
+	}
+	if m.MaxRelativePacketTimeoutTimestamp != 0 {
+		n += 1 + sovParams(uint64(m.MaxRelativePacketTimeoutTimestamp))
+	}
+	l = len(m.FeeCollector)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.SourceDomainFees) > 0 {
+		for _, e := range m.SourceDomainFees {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ChannelFees) > 0 {
+		for _, e := range m.ChannelFees {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -197,6 +321,144 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxForwardRetries", wireType)
+			}
+			m.MaxForwardRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxForwardRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRelativePacketTimeoutTimestamp", wireType)
+			}
+			m.MaxRelativePacketTimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRelativePacketTimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomainFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceDomainFees = append(m.SourceDomainFees, SourceDomainFee{})
+			if err := m.SourceDomainFees[len(m.SourceDomainFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelFees = append(m.ChannelFees, ChannelFee{})
+			if err := m.ChannelFees[len(m.ChannelFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
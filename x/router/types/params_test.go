@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		params func(p *Params)
+		valid  bool
+	}{
+		{
+			name:   "default",
+			params: func(p *Params) {},
+			valid:  true,
+		},
+		{
+			name:   "zero mint prune blocks",
+			params: func(p *Params) { p.MintPruneBlocks = 0 },
+		},
+		{
+			name:   "mint prune blocks above the maximum",
+			params: func(p *Params) { p.MintPruneBlocks = MaxMintPruneBlocks + 1 },
+		},
+		{
+			name:   "no forward retries",
+			params: func(p *Params) { p.MaxForwardRetries = 0 },
+			valid:  true,
+		},
+		{
+			name:   "max forward retries above the maximum",
+			params: func(p *Params) { p.MaxForwardRetries = MaxMaxForwardRetries + 1 },
+		},
+		{
+			name:   "zero max relative packet timeout",
+			params: func(p *Params) { p.MaxRelativePacketTimeoutTimestamp = 0 },
+		},
+		{
+			name:   "max relative packet timeout below the minimum packet timeout",
+			params: func(p *Params) { p.MaxRelativePacketTimeoutTimestamp = MinimumRelativePacketTimeoutTimestamp - 1 },
+		},
+		{
+			name:   "max relative packet timeout at the minimum packet timeout",
+			params: func(p *Params) { p.MaxRelativePacketTimeoutTimestamp = MinimumRelativePacketTimeoutTimestamp },
+			valid:  true,
+		},
+		{
+			name:   "max relative packet timeout above the maximum",
+			params: func(p *Params) { p.MaxRelativePacketTimeoutTimestamp = MaxMaxRelativePacketTimeoutTimestamp + 1 },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultParams()
+			tt.params(&params)
+
+			err := params.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+}
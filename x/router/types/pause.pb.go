@@ -0,0 +1,340 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: router/pause.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// HeldForward identifies a matched IBC forward that is held back until
+// routing is unpaused for its source domain
+// @param source_domain
+// @param nonce
+type HeldForward struct {
+	SourceDomain uint32 `protobuf:"varint,1,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
+	Nonce        uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *HeldForward) Reset()         { *m = HeldForward{} }
+func (m *HeldForward) String() string { return proto.CompactTextString(m) }
+func (*HeldForward) ProtoMessage()    {}
+func (*HeldForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_facdc7cb4071f4c3, []int{0}
+}
+func (m *HeldForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeldForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeldForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeldForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeldForward.Merge(m, src)
+}
+func (m *HeldForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeldForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeldForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeldForward proto.InternalMessageInfo
+
+func (m *HeldForward) GetSourceDomain() uint32 {
+	if m != nil {
+		return m.SourceDomain
+	}
+	return 0
+}
+
+func (m *HeldForward) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*HeldForward)(nil), "noble.router.HeldForward")
+}
+
+func init() { proto.RegisterFile("router/pause.proto", fileDescriptor_facdc7cb4071f4c3) }
+
+var fileDescriptor_facdc7cb4071f4c3 = []byte{
+	// 194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2a, 0xca, 0x2f, 0x2d,
+	0x49, 0x2d, 0xd2, 0x2f, 0x48, 0x2c, 0x2d, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
+	0xc9, 0xcb, 0x4f, 0xca, 0x49, 0xd5, 0x83, 0xc8, 0x28, 0x79, 0x70, 0x71, 0x7b, 0xa4, 0xe6, 0xa4,
+	0xb8, 0xe5, 0x17, 0x95, 0x27, 0x16, 0xa5, 0x08, 0x29, 0x73, 0xf1, 0x16, 0xe7, 0x97, 0x16, 0x25,
+	0xa7, 0xc6, 0xa7, 0xe4, 0xe7, 0x26, 0x66, 0xe6, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x06, 0xf1,
+	0x40, 0x04, 0x5d, 0xc0, 0x62, 0x42, 0x22, 0x5c, 0xac, 0x79, 0xf9, 0x79, 0xc9, 0xa9, 0x12, 0x4c,
+	0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x10, 0x8e, 0x53, 0xe8, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9,
+	0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e,
+	0xcb, 0x31, 0x44, 0x59, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17,
+	0x97, 0x14, 0x25, 0xe6, 0xa5, 0xa7, 0xe6, 0xe4, 0x97, 0xa5, 0xea, 0x96, 0xa5, 0xe6, 0x95, 0x94,
+	0x16, 0xa5, 0x16, 0xeb, 0x83, 0x5d, 0xa4, 0x0b, 0x75, 0x6b, 0x85, 0x3e, 0x94, 0x51, 0x52, 0x59,
+	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0xb5, 0x31, 0x60, 0x00, 0xc5, 0xd6, 0x44, 0xf7, 0xcb, 0x00,
+	0x00, 0x00,
+}
+
+func (m *HeldForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeldForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeldForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintPause(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SourceDomain != 0 {
+		i = encodeVarintPause(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPause(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPause(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HeldForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceDomain != 0 {
+		n += 1 + sovPause(uint64(m.SourceDomain))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovPause(uint64(m.Nonce))
+	}
+	return n
+}
+
+func sovPause(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPause(x uint64) (n int) {
+	return sovPause(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HeldForward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPause
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeldForward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeldForward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPause(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPause
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPause(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPause
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPause
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPause
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPause
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPause        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPause          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPause = fmt.Errorf("proto: unexpected end of group")
+)
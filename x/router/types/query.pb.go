@@ -695,6 +695,102 @@ func (m *QueryAllInFlightPacketsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryInFlightPacketByNonceRequest struct {
+	SourceDomain uint32 `protobuf:"varint,1,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
+	Nonce        uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *QueryInFlightPacketByNonceRequest) Reset()         { *m = QueryInFlightPacketByNonceRequest{} }
+func (m *QueryInFlightPacketByNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInFlightPacketByNonceRequest) ProtoMessage()    {}
+func (*QueryInFlightPacketByNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{14}
+}
+func (m *QueryInFlightPacketByNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInFlightPacketByNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInFlightPacketByNonceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInFlightPacketByNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInFlightPacketByNonceRequest.Merge(m, src)
+}
+func (m *QueryInFlightPacketByNonceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInFlightPacketByNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInFlightPacketByNonceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInFlightPacketByNonceRequest proto.InternalMessageInfo
+
+func (m *QueryInFlightPacketByNonceRequest) GetSourceDomain() uint32 {
+	if m != nil {
+		return m.SourceDomain
+	}
+	return 0
+}
+
+func (m *QueryInFlightPacketByNonceRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+type QueryInFlightPacketByNonceResponse struct {
+	InFlightPacket InFlightPacket `protobuf:"bytes,1,opt,name=inFlightPacket,proto3" json:"inFlightPacket"`
+}
+
+func (m *QueryInFlightPacketByNonceResponse) Reset()         { *m = QueryInFlightPacketByNonceResponse{} }
+func (m *QueryInFlightPacketByNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInFlightPacketByNonceResponse) ProtoMessage()    {}
+func (*QueryInFlightPacketByNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{15}
+}
+func (m *QueryInFlightPacketByNonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInFlightPacketByNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInFlightPacketByNonceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInFlightPacketByNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInFlightPacketByNonceResponse.Merge(m, src)
+}
+func (m *QueryInFlightPacketByNonceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInFlightPacketByNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInFlightPacketByNonceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInFlightPacketByNonceResponse proto.InternalMessageInfo
+
+func (m *QueryInFlightPacketByNonceResponse) GetInFlightPacket() InFlightPacket {
+	if m != nil {
+		return m.InFlightPacket
+	}
+	return InFlightPacket{}
+}
+
 type QueryAllowedSourceDomainSenderRequest struct {
 	DomainId uint32 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	Address  []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -704,7 +800,7 @@ func (m *QueryAllowedSourceDomainSenderRequest) Reset()         { *m = QueryAllo
 func (m *QueryAllowedSourceDomainSenderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllowedSourceDomainSenderRequest) ProtoMessage()    {}
 func (*QueryAllowedSourceDomainSenderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_248fc3ff0338b430, []int{14}
+	return fileDescriptor_248fc3ff0338b430, []int{16}
 }
 func (m *QueryAllowedSourceDomainSenderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -757,7 +853,7 @@ func (m *QueryAllowedSourceDomainSenderResponse) Reset() {
 func (m *QueryAllowedSourceDomainSenderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllowedSourceDomainSenderResponse) ProtoMessage()    {}
 func (*QueryAllowedSourceDomainSenderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_248fc3ff0338b430, []int{15}
+	return fileDescriptor_248fc3ff0338b430, []int{17}
 }
 func (m *QueryAllowedSourceDomainSenderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +899,7 @@ func (m *QueryAllowedSourceDomainSendersRequest) Reset() {
 func (m *QueryAllowedSourceDomainSendersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllowedSourceDomainSendersRequest) ProtoMessage()    {}
 func (*QueryAllowedSourceDomainSendersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_248fc3ff0338b430, []int{16}
+	return fileDescriptor_248fc3ff0338b430, []int{18}
 }
 func (m *QueryAllowedSourceDomainSendersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -850,7 +946,7 @@ func (m *QueryAllowedSourceDomainSendersResponse) Reset() {
 func (m *QueryAllowedSourceDomainSendersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllowedSourceDomainSendersResponse) ProtoMessage()    {}
 func (*QueryAllowedSourceDomainSendersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_248fc3ff0338b430, []int{17}
+	return fileDescriptor_248fc3ff0338b430, []int{19}
 }
 func (m *QueryAllowedSourceDomainSendersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -893,1430 +989,6852 @@ func (m *QueryAllowedSourceDomainSendersResponse) GetPagination() *query.PageRes
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "noble.router.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "noble.router.QueryParamsResponse")
-	proto.RegisterType((*QueryGetMintRequest)(nil), "noble.router.QueryGetMintRequest")
-	proto.RegisterType((*QueryGetMintResponse)(nil), "noble.router.QueryGetMintResponse")
-	proto.RegisterType((*QueryAllMintsRequest)(nil), "noble.router.QueryAllMintsRequest")
-	proto.RegisterType((*QueryAllMintsResponse)(nil), "noble.router.QueryAllMintsResponse")
-	proto.RegisterType((*QueryGetIBCForwardRequest)(nil), "noble.router.QueryGetIBCForwardRequest")
-	proto.RegisterType((*QueryGetIBCForwardResponse)(nil), "noble.router.QueryGetIBCForwardResponse")
-	proto.RegisterType((*QueryAllIBCForwardsRequest)(nil), "noble.router.QueryAllIBCForwardsRequest")
-	proto.RegisterType((*QueryAllIBCForwardsResponse)(nil), "noble.router.QueryAllIBCForwardsResponse")
-	proto.RegisterType((*QueryGetInFlightPacketRequest)(nil), "noble.router.QueryGetInFlightPacketRequest")
-	proto.RegisterType((*QueryGetInFlightPacketResponse)(nil), "noble.router.QueryGetInFlightPacketResponse")
-	proto.RegisterType((*QueryAllInFlightPacketsRequest)(nil), "noble.router.QueryAllInFlightPacketsRequest")
-	proto.RegisterType((*QueryAllInFlightPacketsResponse)(nil), "noble.router.QueryAllInFlightPacketsResponse")
-	proto.RegisterType((*QueryAllowedSourceDomainSenderRequest)(nil), "noble.router.QueryAllowedSourceDomainSenderRequest")
-	proto.RegisterType((*QueryAllowedSourceDomainSenderResponse)(nil), "noble.router.QueryAllowedSourceDomainSenderResponse")
-	proto.RegisterType((*QueryAllowedSourceDomainSendersRequest)(nil), "noble.router.QueryAllowedSourceDomainSendersRequest")
-	proto.RegisterType((*QueryAllowedSourceDomainSendersResponse)(nil), "noble.router.QueryAllowedSourceDomainSendersResponse")
+type QueryAllowedChannelRequest struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
-func init() { proto.RegisterFile("router/query.proto", fileDescriptor_248fc3ff0338b430) }
-
-var fileDescriptor_248fc3ff0338b430 = []byte{
-	// 1083 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x4f, 0x1b, 0x47,
-	0x14, 0x67, 0x09, 0x90, 0xf0, 0x80, 0x44, 0x7d, 0x71, 0x5b, 0x58, 0xc0, 0xc0, 0x46, 0x89, 0x49,
-	0x02, 0xbb, 0x02, 0xda, 0x43, 0xd5, 0x13, 0x24, 0x22, 0x72, 0x5b, 0x24, 0x6a, 0xd4, 0x1e, 0x7a,
-	0x88, 0x35, 0xf6, 0x4e, 0xcc, 0x8a, 0xf5, 0x8e, 0xb3, 0xbb, 0x26, 0x8d, 0x2c, 0x1f, 0x5a, 0xa9,
-	0xf7, 0x48, 0x55, 0x55, 0xa9, 0xdf, 0xa3, 0x55, 0x4f, 0x3d, 0xe7, 0x48, 0xd5, 0x4b, 0x4f, 0x55,
-	0x0b, 0xfd, 0x06, 0xfd, 0x02, 0xd5, 0xce, 0xbe, 0xc5, 0x1e, 0xb3, 0x6b, 0x63, 0xc9, 0x37, 0xf6,
-	0xcd, 0x7b, 0xef, 0xf7, 0x7b, 0x7f, 0x98, 0xdf, 0x18, 0xd0, 0x17, 0xcd, 0x90, 0xfb, 0xd6, 0xcb,
-	0x26, 0xf7, 0x5f, 0x9b, 0x0d, 0x5f, 0x84, 0x02, 0x67, 0x3d, 0x51, 0x71, 0xb9, 0x19, 0x9f, 0xe8,
-	0x8f, 0xaa, 0x22, 0xa8, 0x8b, 0xc0, 0xaa, 0xb0, 0x80, 0xc7, 0x6e, 0xd6, 0xe9, 0x56, 0x85, 0x87,
-	0x6c, 0xcb, 0x6a, 0xb0, 0x9a, 0xe3, 0xb1, 0xd0, 0x11, 0x5e, 0x1c, 0xa9, 0xe7, 0x6a, 0xa2, 0x26,
-	0xe4, 0x9f, 0x56, 0xf4, 0x17, 0x59, 0x97, 0x6a, 0x42, 0xd4, 0x5c, 0x6e, 0xb1, 0x86, 0x63, 0x31,
-	0xcf, 0x13, 0xa1, 0x0c, 0x09, 0xe8, 0x74, 0x8d, 0x18, 0x38, 0x95, 0x6a, 0xf9, 0x85, 0xf0, 0x5f,
-	0x31, 0xdf, 0x2e, 0xd7, 0x79, 0xc8, 0x6c, 0x16, 0x32, 0x72, 0x59, 0x4e, 0x5c, 0xbc, 0xf2, 0x0b,
-	0xd7, 0xa9, 0x1d, 0x87, 0xe5, 0x06, 0xab, 0x9e, 0xf0, 0x90, 0x8e, 0xdf, 0xa1, 0xe3, 0xba, 0xe3,
-	0x25, 0xa6, 0xbb, 0x64, 0x6a, 0x30, 0x9f, 0xd5, 0x13, 0xa4, 0x87, 0x64, 0x64, 0xae, 0x2b, 0x5e,
-	0x71, 0xbb, 0x1c, 0x88, 0xa6, 0x5f, 0xe5, 0x65, 0x5b, 0xd4, 0x99, 0xe3, 0x95, 0x03, 0xee, 0xd9,
-	0xdc, 0x8f, 0x5d, 0x8d, 0x1c, 0xe0, 0xe7, 0x51, 0xa9, 0x87, 0x32, 0xbe, 0xc4, 0x5f, 0x36, 0x79,
-	0x10, 0x1a, 0x45, 0xb8, 0xab, 0x58, 0x83, 0x86, 0xf0, 0x02, 0x8e, 0xdb, 0x30, 0x15, 0xe3, 0xcc,
-	0x6b, 0xab, 0xda, 0xfa, 0xcc, 0x76, 0xce, 0xec, 0x6e, 0xa0, 0x19, 0x7b, 0xef, 0x4d, 0xbc, 0xfd,
-	0x6b, 0x65, 0xac, 0x44, 0x9e, 0xc6, 0x21, 0xa5, 0x7a, 0xc6, 0xc3, 0x03, 0xc7, 0x0b, 0x09, 0x01,
-	0xef, 0xc1, 0x9c, 0xc2, 0x4a, 0x66, 0x9c, 0x2b, 0xcd, 0xc6, 0xc6, 0xa7, 0xd2, 0x86, 0x39, 0x98,
-	0xf4, 0x84, 0x57, 0xe5, 0xf3, 0x37, 0x56, 0xb5, 0xf5, 0x89, 0x52, 0xfc, 0x61, 0x3c, 0x85, 0x9c,
-	0x9a, 0x91, 0xd8, 0x6d, 0xc0, 0x44, 0xd4, 0x18, 0xe2, 0x86, 0x2a, 0xb7, 0xc8, 0x93, 0x98, 0x49,
-	0x2f, 0xe3, 0x39, 0x65, 0xd9, 0x75, 0xdd, 0xe8, 0x2c, 0x29, 0x1d, 0xf7, 0x01, 0x3a, 0xd3, 0xa6,
-	0x5c, 0x0f, 0xcc, 0x78, 0x35, 0xcc, 0x68, 0x35, 0xcc, 0x78, 0x83, 0x68, 0x35, 0xcc, 0x43, 0x56,
-	0xe3, 0x14, 0x5b, 0xea, 0x8a, 0x34, 0xde, 0x68, 0xf0, 0x6e, 0x0f, 0x00, 0xf1, 0x34, 0x61, 0x32,
-	0x62, 0x10, 0x35, 0xf1, 0x46, 0x5f, 0xa2, 0xb1, 0x1b, 0x3e, 0x53, 0x18, 0x8d, 0x4b, 0x46, 0x85,
-	0x81, 0x8c, 0x62, 0x30, 0x85, 0xd2, 0x97, 0xb0, 0x90, 0x34, 0xae, 0xb8, 0xf7, 0x64, 0x3f, 0x5e,
-	0xc1, 0x11, 0x0c, 0xc4, 0x01, 0x3d, 0x2d, 0x2f, 0x95, 0xfb, 0x29, 0x80, 0x53, 0xa9, 0x92, 0x95,
-	0x1a, 0x7a, 0x5f, 0xad, 0xf9, 0x28, 0x14, 0x3e, 0xef, 0x84, 0x1e, 0xd0, 0x3f, 0x05, 0xb5, 0xa1,
-	0x2b, 0xdc, 0xb0, 0x09, 0x6a, 0xd7, 0x75, 0x3b, 0xfe, 0x23, 0x9f, 0xdd, 0xcf, 0x1a, 0x2c, 0xa6,
-	0xc2, 0x50, 0x49, 0x07, 0x30, 0xd3, 0xe1, 0x94, 0xcc, 0x71, 0xa8, 0x9a, 0xba, 0xe3, 0x47, 0x37,
-	0xe0, 0x00, 0x96, 0x2f, 0x07, 0xe1, 0xed, 0xcb, 0x0b, 0xe4, 0x50, 0xde, 0x1f, 0x49, 0x83, 0x96,
-	0x01, 0xaa, 0xc7, 0xcc, 0xf3, 0xb8, 0x5b, 0x76, 0xe2, 0x59, 0x4c, 0x97, 0xa6, 0xc9, 0x52, 0xb4,
-	0xf1, 0x7d, 0xb8, 0xd9, 0x10, 0x7e, 0x18, 0x9d, 0x8d, 0xcb, 0xb3, 0xa9, 0xe8, 0xb3, 0x68, 0xa3,
-	0x0e, 0xb7, 0x82, 0x28, 0x45, 0x67, 0xf4, 0x97, 0xdf, 0x86, 0x0b, 0xf9, 0x2c, 0x50, 0x6a, 0xd7,
-	0x27, 0x70, 0xdb, 0x51, 0x4e, 0x68, 0x34, 0x4b, 0x6a, 0xc7, 0xd4, 0x68, 0x6a, 0x54, 0x4f, 0xa4,
-	0x71, 0x4c, 0x68, 0xd1, 0x64, 0x94, 0x93, 0x91, 0x2f, 0xc1, 0xaf, 0x1a, 0xac, 0x64, 0x42, 0x51,
-	0x65, 0x9f, 0xc1, 0x1d, 0x95, 0x5f, 0xb2, 0x0c, 0xd7, 0x29, 0xad, 0x37, 0x74, 0x74, 0x7b, 0xf0,
-	0x1c, 0xee, 0x27, 0xcc, 0xa3, 0xfb, 0xff, 0xa8, 0xeb, 0x5f, 0xf8, 0x48, 0x5e, 0xfe, 0x49, 0xaf,
-	0x16, 0x61, 0x9a, 0x44, 0x81, 0xd6, 0x61, 0xae, 0x74, 0x2b, 0x36, 0x14, 0x6d, 0x9c, 0x87, 0x9b,
-	0xcc, 0xb6, 0x7d, 0x1e, 0x04, 0x92, 0xcb, 0x6c, 0x29, 0xf9, 0x34, 0x7e, 0xd0, 0xe0, 0xc1, 0x20,
-	0x00, 0xea, 0xd0, 0x09, 0x2c, 0xb0, 0x2c, 0x27, 0x1a, 0x4e, 0x41, 0xed, 0x55, 0x66, 0x4e, 0x6a,
-	0x5b, 0x76, 0x3e, 0xa3, 0x31, 0x88, 0xd6, 0xc8, 0x97, 0xe4, 0x1f, 0x0d, 0x0a, 0x03, 0x21, 0xa9,
-	0x15, 0x75, 0xd0, 0x33, 0xa9, 0x27, 0x7b, 0x33, 0x64, 0x2f, 0xfa, 0x24, 0x1c, 0xd9, 0x36, 0x6d,
-	0xff, 0x07, 0x30, 0x29, 0x6b, 0xc4, 0x13, 0x98, 0x8a, 0x35, 0x1e, 0x57, 0x55, 0x9e, 0x57, 0x9f,
-	0x10, 0xfa, 0x5a, 0x1f, 0x8f, 0x18, 0xc4, 0x58, 0xfa, 0xf6, 0x8f, 0x7f, 0xbf, 0x1f, 0x7f, 0x0f,
-	0x73, 0x96, 0x74, 0xb5, 0x94, 0xa7, 0x0c, 0x7e, 0xa3, 0xc1, 0x44, 0x24, 0x86, 0x98, 0x96, 0x49,
-	0x7d, 0x4d, 0xe8, 0x46, 0x3f, 0x17, 0x42, 0xdb, 0x96, 0x68, 0x1b, 0xf8, 0x48, 0x45, 0x8b, 0x34,
-	0xd6, 0x6a, 0x29, 0xd2, 0xd7, 0xb6, 0x5a, 0x52, 0xd8, 0xda, 0xe8, 0xc2, 0xa4, 0xd4, 0x6e, 0x4c,
-	0x03, 0xe8, 0x79, 0x39, 0xe8, 0xf7, 0xfa, 0xfa, 0x10, 0x0b, 0x5d, 0xb2, 0xc8, 0x21, 0x5e, 0x65,
-	0x81, 0x3f, 0x69, 0x00, 0x1d, 0xc5, 0xc0, 0x42, 0x7a, 0x51, 0x57, 0xa4, 0x5b, 0x5f, 0x1f, 0xec,
-	0x48, 0xe8, 0x1f, 0x49, 0xf4, 0x1d, 0xdc, 0x52, 0xd1, 0xbb, 0x5e, 0xa4, 0x99, 0xad, 0xf8, 0x4e,
-	0x83, 0x99, 0x2e, 0x2d, 0xc4, 0xf5, 0xf4, 0x6a, 0xaf, 0xaa, 0xb2, 0xfe, 0xf0, 0x1a, 0x9e, 0xc4,
-	0x6f, 0x4d, 0xf2, 0x5b, 0xc4, 0x85, 0x4c, 0x7e, 0xf8, 0x8b, 0x06, 0xb7, 0xd5, 0xeb, 0x14, 0x1f,
-	0x67, 0xd4, 0x9f, 0x26, 0x81, 0xfa, 0xc6, 0xf5, 0x9c, 0x89, 0x50, 0x51, 0x12, 0x7a, 0x82, 0xbb,
-	0x3d, 0x84, 0x7a, 0xde, 0xe7, 0x81, 0xd5, 0xea, 0xe8, 0x6a, 0xdb, 0x6a, 0x91, 0x8a, 0xb6, 0xad,
-	0x56, 0x22, 0x93, 0x6d, 0xfc, 0x51, 0x83, 0x3b, 0x3d, 0x3a, 0x82, 0x1b, 0x19, 0xad, 0x49, 0x55,
-	0x36, 0x7d, 0xf3, 0x9a, 0xde, 0xc4, 0xbd, 0x20, 0xb9, 0xaf, 0xe1, 0xca, 0x00, 0xee, 0xf8, 0xbb,
-	0x06, 0x0b, 0x99, 0x37, 0x0d, 0xee, 0xa4, 0xa3, 0xf6, 0x15, 0x16, 0xfd, 0x83, 0xe1, 0x82, 0xfa,
-	0x77, 0xbb, 0xdf, 0xcf, 0x98, 0xc0, 0x6a, 0x5d, 0x2a, 0x58, 0xdb, 0x6a, 0x91, 0x42, 0xb5, 0xf1,
-	0x37, 0x0d, 0xf4, 0xec, 0x3b, 0x19, 0x87, 0xe2, 0x77, 0x39, 0x80, 0x0f, 0x87, 0x8c, 0xa2, 0xb2,
-	0x76, 0x64, 0x59, 0x9b, 0xf8, 0x78, 0x88, 0xb2, 0xf6, 0xbe, 0x78, 0x7b, 0x9e, 0xd7, 0xce, 0xce,
-	0xf3, 0xda, 0xdf, 0xe7, 0x79, 0xed, 0xcd, 0x45, 0x7e, 0xec, 0xec, 0x22, 0x3f, 0xf6, 0xe7, 0x45,
-	0x7e, 0xec, 0xab, 0x8f, 0x6b, 0x4e, 0x78, 0xdc, 0xac, 0x98, 0x55, 0x51, 0xb7, 0x82, 0xd0, 0x67,
-	0x5e, 0x8d, 0xbb, 0xe2, 0x94, 0x6f, 0x9e, 0x72, 0x2f, 0x6c, 0xfa, 0x3c, 0x88, 0x51, 0x36, 0x09,
-	0xe5, 0xeb, 0x04, 0x2e, 0x7c, 0xdd, 0xe0, 0x41, 0x65, 0x4a, 0xfe, 0xec, 0xdb, 0xf9, 0x3f, 0x00,
-	0x00, 0xff, 0xff, 0x19, 0xb2, 0x0d, 0x0f, 0x0f, 0x0f, 0x00, 0x00,
+func (m *QueryAllowedChannelRequest) Reset()         { *m = QueryAllowedChannelRequest{} }
+func (m *QueryAllowedChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedChannelRequest) ProtoMessage()    {}
+func (*QueryAllowedChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{20}
+}
+func (m *QueryAllowedChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedChannelRequest.Merge(m, src)
+}
+func (m *QueryAllowedChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedChannelRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryAllowedChannelRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a Mint by source_contract_address and nonce
-	Mint(ctx context.Context, in *QueryGetMintRequest, opts ...grpc.CallOption) (*QueryGetMintResponse, error)
-	// Queries a list of Mints
-	Mints(ctx context.Context, in *QueryAllMintsRequest, opts ...grpc.CallOption) (*QueryAllMintsResponse, error)
-	// Queries an IBCForward by source_contract_address and nonce
-	IBCForward(ctx context.Context, in *QueryGetIBCForwardRequest, opts ...grpc.CallOption) (*QueryGetIBCForwardResponse, error)
-	// Queries a list of IBCForwards
-	IBCForwards(ctx context.Context, in *QueryAllIBCForwardsRequest, opts ...grpc.CallOption) (*QueryAllIBCForwardsResponse, error)
-	// Queries an InFlightPacket by source_contract_address and nonce
-	InFlightPacket(ctx context.Context, in *QueryGetInFlightPacketRequest, opts ...grpc.CallOption) (*QueryGetInFlightPacketResponse, error)
-	// Queries a list of InFlightPackets
-	InFlightPackets(ctx context.Context, in *QueryAllInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryAllInFlightPacketsResponse, error)
-	// Query a specific AllowedSourceDomainSender
-	AllowedSourceDomainSender(ctx context.Context, in *QueryAllowedSourceDomainSenderRequest, opts ...grpc.CallOption) (*QueryAllowedSourceDomainSenderResponse, error)
-	// Query all AllowedSourceDomainSender's.
-	AllowedSourceDomainSenders(ctx context.Context, in *QueryAllowedSourceDomainSendersRequest, opts ...grpc.CallOption) (*QueryAllowedSourceDomainSendersResponse, error)
+func (m *QueryAllowedChannelRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryAllowedChannelResponse struct {
+	AllowedChannel AllowedChannel `protobuf:"bytes,1,opt,name=allowedChannel,proto3" json:"allowedChannel"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAllowedChannelResponse) Reset()         { *m = QueryAllowedChannelResponse{} }
+func (m *QueryAllowedChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedChannelResponse) ProtoMessage()    {}
+func (*QueryAllowedChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{21}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/noble.router.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllowedChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllowedChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedChannelResponse.Merge(m, src)
+}
+func (m *QueryAllowedChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedChannelResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Mint(ctx context.Context, in *QueryGetMintRequest, opts ...grpc.CallOption) (*QueryGetMintResponse, error) {
-	out := new(QueryGetMintResponse)
-	err := c.cc.Invoke(ctx, "/noble.router.Query/Mint", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllowedChannelResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedChannelResponse) GetAllowedChannel() AllowedChannel {
+	if m != nil {
+		return m.AllowedChannel
 	}
-	return out, nil
+	return AllowedChannel{}
 }
 
-func (c *queryClient) Mints(ctx context.Context, in *QueryAllMintsRequest, opts ...grpc.CallOption) (*QueryAllMintsResponse, error) {
-	out := new(QueryAllMintsResponse)
-	err := c.cc.Invoke(ctx, "/noble.router.Query/Mints", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllowedChannelsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) IBCForward(ctx context.Context, in *QueryGetIBCForwardRequest, opts ...grpc.CallOption) (*QueryGetIBCForwardResponse, error) {
-	out := new(QueryGetIBCForwardResponse)
-	err := c.cc.Invoke(ctx, "/noble.router.Query/IBCForward", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllowedChannelsRequest) Reset()         { *m = QueryAllowedChannelsRequest{} }
+func (m *QueryAllowedChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedChannelsRequest) ProtoMessage()    {}
+func (*QueryAllowedChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{22}
+}
+func (m *QueryAllowedChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllowedChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedChannelsRequest.Merge(m, src)
+}
+func (m *QueryAllowedChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedChannelsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) IBCForwards(ctx context.Context, in *QueryAllIBCForwardsRequest, opts ...grpc.CallOption) (*QueryAllIBCForwardsResponse, error) {
-	out := new(QueryAllIBCForwardsResponse)
-	err := c.cc.Invoke(ctx, "/noble.router.Query/IBCForwards", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllowedChannelsRequest proto.InternalMessageInfo
+
+func (m *QueryAllowedChannelsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) InFlightPacket(ctx context.Context, in *QueryGetInFlightPacketRequest, opts ...grpc.CallOption) (*QueryGetInFlightPacketResponse, error) {
-	out := new(QueryGetInFlightPacketResponse)
-	err := c.cc.Invoke(ctx, "/noble.router.Query/InFlightPacket", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryAllowedChannelsResponse struct {
+	AllowedChannels []AllowedChannel    `protobuf:"bytes,1,rep,name=allowedChannels,proto3" json:"allowedChannels"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowedChannelsResponse) Reset()         { *m = QueryAllowedChannelsResponse{} }
+func (m *QueryAllowedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedChannelsResponse) ProtoMessage()    {}
+func (*QueryAllowedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{23}
+}
+func (m *QueryAllowedChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllowedChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedChannelsResponse.Merge(m, src)
+}
+func (m *QueryAllowedChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedChannelsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) InFlightPackets(ctx context.Context, in *QueryAllInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryAllInFlightPacketsResponse, error) {
-	out := new(QueryAllInFlightPacketsResponse)
-	err := c.cc.Invoke(ctx, "/noble.router.Query/InFlightPackets", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllowedChannelsResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedChannelsResponse) GetAllowedChannels() []AllowedChannel {
+	if m != nil {
+		return m.AllowedChannels
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AllowedSourceDomainSender(ctx context.Context, in *QueryAllowedSourceDomainSenderRequest, opts ...grpc.CallOption) (*QueryAllowedSourceDomainSenderResponse, error) {
-	out := new(QueryAllowedSourceDomainSenderResponse)
-	err := c.cc.Invoke(ctx, "/noble.router.Query/AllowedSourceDomainSender", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllowedChannelsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AllowedSourceDomainSenders(ctx context.Context, in *QueryAllowedSourceDomainSendersRequest, opts ...grpc.CallOption) (*QueryAllowedSourceDomainSendersResponse, error) {
-	out := new(QueryAllowedSourceDomainSendersResponse)
-	err := c.cc.Invoke(ctx, "/noble.router.Query/AllowedSourceDomainSenders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryForwardStatusRequest struct {
+	SourceDomain uint32 `protobuf:"varint,1,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
+	Nonce        uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a Mint by source_contract_address and nonce
-	Mint(context.Context, *QueryGetMintRequest) (*QueryGetMintResponse, error)
-	// Queries a list of Mints
-	Mints(context.Context, *QueryAllMintsRequest) (*QueryAllMintsResponse, error)
-	// Queries an IBCForward by source_contract_address and nonce
-	IBCForward(context.Context, *QueryGetIBCForwardRequest) (*QueryGetIBCForwardResponse, error)
-	// Queries a list of IBCForwards
-	IBCForwards(context.Context, *QueryAllIBCForwardsRequest) (*QueryAllIBCForwardsResponse, error)
-	// Queries an InFlightPacket by source_contract_address and nonce
-	InFlightPacket(context.Context, *QueryGetInFlightPacketRequest) (*QueryGetInFlightPacketResponse, error)
-	// Queries a list of InFlightPackets
-	InFlightPackets(context.Context, *QueryAllInFlightPacketsRequest) (*QueryAllInFlightPacketsResponse, error)
-	// Query a specific AllowedSourceDomainSender
-	AllowedSourceDomainSender(context.Context, *QueryAllowedSourceDomainSenderRequest) (*QueryAllowedSourceDomainSenderResponse, error)
-	// Query all AllowedSourceDomainSender's.
-	AllowedSourceDomainSenders(context.Context, *QueryAllowedSourceDomainSendersRequest) (*QueryAllowedSourceDomainSendersResponse, error)
+func (m *QueryForwardStatusRequest) Reset()         { *m = QueryForwardStatusRequest{} }
+func (m *QueryForwardStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardStatusRequest) ProtoMessage()    {}
+func (*QueryForwardStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{24}
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryForwardStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForwardStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForwardStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForwardStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardStatusRequest.Merge(m, src)
+}
+func (m *QueryForwardStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForwardStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardStatusRequest.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryForwardStatusRequest proto.InternalMessageInfo
+
+func (m *QueryForwardStatusRequest) GetSourceDomain() uint32 {
+	if m != nil {
+		return m.SourceDomain
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) Mint(ctx context.Context, req *QueryGetMintRequest) (*QueryGetMintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+
+func (m *QueryForwardStatusRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) Mints(ctx context.Context, req *QueryAllMintsRequest) (*QueryAllMintsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mints not implemented")
+
+type QueryForwardStatusResponse struct {
+	Status ForwardStatus `protobuf:"varint,1,opt,name=status,proto3,enum=noble.router.ForwardStatus" json:"status,omitempty"`
+	// set when the status is in flight
+	InFlightPacket *InFlightPacket `protobuf:"bytes,2,opt,name=inFlightPacket,proto3" json:"inFlightPacket,omitempty"`
+	// set when the status is final
+	Receipt *ForwardReceipt `protobuf:"bytes,3,opt,name=receipt,proto3" json:"receipt,omitempty"`
 }
-func (*UnimplementedQueryServer) IBCForward(ctx context.Context, req *QueryGetIBCForwardRequest) (*QueryGetIBCForwardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IBCForward not implemented")
+
+func (m *QueryForwardStatusResponse) Reset()         { *m = QueryForwardStatusResponse{} }
+func (m *QueryForwardStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardStatusResponse) ProtoMessage()    {}
+func (*QueryForwardStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{25}
 }
-func (*UnimplementedQueryServer) IBCForwards(ctx context.Context, req *QueryAllIBCForwardsRequest) (*QueryAllIBCForwardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IBCForwards not implemented")
+func (m *QueryForwardStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) InFlightPacket(ctx context.Context, req *QueryGetInFlightPacketRequest) (*QueryGetInFlightPacketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InFlightPacket not implemented")
+func (m *QueryForwardStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForwardStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) InFlightPackets(ctx context.Context, req *QueryAllInFlightPacketsRequest) (*QueryAllInFlightPacketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InFlightPackets not implemented")
+func (m *QueryForwardStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardStatusResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AllowedSourceDomainSender(ctx context.Context, req *QueryAllowedSourceDomainSenderRequest) (*QueryAllowedSourceDomainSenderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllowedSourceDomainSender not implemented")
+func (m *QueryForwardStatusResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AllowedSourceDomainSenders(ctx context.Context, req *QueryAllowedSourceDomainSendersRequest) (*QueryAllowedSourceDomainSendersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllowedSourceDomainSenders not implemented")
+func (m *QueryForwardStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardStatusResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryForwardStatusResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.router.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryForwardStatusResponse) GetStatus() ForwardStatus {
+	if m != nil {
+		return m.Status
 	}
-	return interceptor(ctx, in, info, handler)
+	return FORWARD_STATUS_UNSPECIFIED
 }
 
-func _Query_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMintRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Mint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.router.Query/Mint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Mint(ctx, req.(*QueryGetMintRequest))
+func (m *QueryForwardStatusResponse) GetInFlightPacket() *InFlightPacket {
+	if m != nil {
+		return m.InFlightPacket
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Mints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMintsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Mints(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.router.Query/Mints",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Mints(ctx, req.(*QueryAllMintsRequest))
+func (m *QueryForwardStatusResponse) GetReceipt() *ForwardReceipt {
+	if m != nil {
+		return m.Receipt
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_IBCForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetIBCForwardRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IBCForward(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.router.Query/IBCForward",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IBCForward(ctx, req.(*QueryGetIBCForwardRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryOwnerRequest struct {
 }
 
-func _Query_IBCForwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllIBCForwardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IBCForwards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.router.Query/IBCForwards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IBCForwards(ctx, req.(*QueryAllIBCForwardsRequest))
+func (m *QueryOwnerRequest) Reset()         { *m = QueryOwnerRequest{} }
+func (m *QueryOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnerRequest) ProtoMessage()    {}
+func (*QueryOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{26}
+}
+func (m *QueryOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnerRequest.Merge(m, src)
+}
+func (m *QueryOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnerRequest.DiscardUnknown(m)
 }
 
-func _Query_InFlightPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetInFlightPacketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InFlightPacket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.router.Query/InFlightPacket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InFlightPacket(ctx, req.(*QueryGetInFlightPacketRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryOwnerRequest proto.InternalMessageInfo
+
+type QueryOwnerResponse struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func _Query_InFlightPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllInFlightPacketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InFlightPackets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.router.Query/InFlightPackets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InFlightPackets(ctx, req.(*QueryAllInFlightPacketsRequest))
+func (m *QueryOwnerResponse) Reset()         { *m = QueryOwnerResponse{} }
+func (m *QueryOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnerResponse) ProtoMessage()    {}
+func (*QueryOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{27}
+}
+func (m *QueryOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnerResponse.Merge(m, src)
+}
+func (m *QueryOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnerResponse.DiscardUnknown(m)
 }
 
-func _Query_AllowedSourceDomainSender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllowedSourceDomainSenderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllowedSourceDomainSender(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.router.Query/AllowedSourceDomainSender",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllowedSourceDomainSender(ctx, req.(*QueryAllowedSourceDomainSenderRequest))
+var xxx_messageInfo_QueryOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryOwnerResponse) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AllowedSourceDomainSenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllowedSourceDomainSendersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllowedSourceDomainSenders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.router.Query/AllowedSourceDomainSenders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllowedSourceDomainSenders(ctx, req.(*QueryAllowedSourceDomainSendersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPendingOwnerRequest struct {
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.router.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Mint",
-			Handler:    _Query_Mint_Handler,
-		},
-		{
-			MethodName: "Mints",
-			Handler:    _Query_Mints_Handler,
-		},
-		{
-			MethodName: "IBCForward",
-			Handler:    _Query_IBCForward_Handler,
-		},
-		{
-			MethodName: "IBCForwards",
-			Handler:    _Query_IBCForwards_Handler,
-		},
-		{
-			MethodName: "InFlightPacket",
-			Handler:    _Query_InFlightPacket_Handler,
-		},
-		{
-			MethodName: "InFlightPackets",
-			Handler:    _Query_InFlightPackets_Handler,
-		},
-		{
-			MethodName: "AllowedSourceDomainSender",
-			Handler:    _Query_AllowedSourceDomainSender_Handler,
-		},
-		{
-			MethodName: "AllowedSourceDomainSenders",
-			Handler:    _Query_AllowedSourceDomainSenders_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "router/query.proto",
+func (m *QueryPendingOwnerRequest) Reset()         { *m = QueryPendingOwnerRequest{} }
+func (m *QueryPendingOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingOwnerRequest) ProtoMessage()    {}
+func (*QueryPendingOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{28}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingOwnerRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPendingOwnerRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPendingOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingOwnerRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPendingOwnerRequest proto.InternalMessageInfo
+
+type QueryPendingOwnerResponse struct {
+	PendingOwner string `protobuf:"bytes,1,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPendingOwnerResponse) Reset()         { *m = QueryPendingOwnerResponse{} }
+func (m *QueryPendingOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingOwnerResponse) ProtoMessage()    {}
+func (*QueryPendingOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{29}
+}
+func (m *QueryPendingOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingOwnerResponse.Merge(m, src)
+}
+func (m *QueryPendingOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingOwnerResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMintRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryPendingOwnerResponse) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetMintRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPauserRequest struct {
 }
 
-func (m *QueryGetMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SourceDomain != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SourceDomain))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryPauserRequest) Reset()         { *m = QueryPauserRequest{} }
+func (m *QueryPauserRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPauserRequest) ProtoMessage()    {}
+func (*QueryPauserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{30}
 }
-
-func (m *QueryGetMintResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPauserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPauserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauserRequest.Merge(m, src)
+}
+func (m *QueryPauserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauserRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetMintResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPauserRequest proto.InternalMessageInfo
+
+type QueryPauserResponse struct {
+	Pauser string `protobuf:"bytes,1,opt,name=pauser,proto3" json:"pauser,omitempty"`
 }
 
-func (m *QueryGetMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Mint.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPauserResponse) Reset()         { *m = QueryPauserResponse{} }
+func (m *QueryPauserResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauserResponse) ProtoMessage()    {}
+func (*QueryPauserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{31}
+}
+func (m *QueryPauserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPauserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauserResponse.Merge(m, src)
+}
+func (m *QueryPauserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauserResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllMintsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPauserResponse proto.InternalMessageInfo
+
+func (m *QueryPauserResponse) GetPauser() string {
+	if m != nil {
+		return m.Pauser
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllMintsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRoutingPauseStateRequest struct {
 }
 
-func (m *QueryAllMintsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRoutingPauseStateRequest) Reset()         { *m = QueryRoutingPauseStateRequest{} }
+func (m *QueryRoutingPauseStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoutingPauseStateRequest) ProtoMessage()    {}
+func (*QueryRoutingPauseStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{32}
+}
+func (m *QueryRoutingPauseStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoutingPauseStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoutingPauseStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRoutingPauseStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoutingPauseStateRequest.Merge(m, src)
+}
+func (m *QueryRoutingPauseStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoutingPauseStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoutingPauseStateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllMintsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_QueryRoutingPauseStateRequest proto.InternalMessageInfo
+
+type QueryRoutingPauseStateResponse struct {
+	Global              bool     `protobuf:"varint,1,opt,name=global,proto3" json:"global,omitempty"`
+	PausedSourceDomains []uint32 `protobuf:"varint,2,rep,packed,name=paused_source_domains,json=pausedSourceDomains,proto3" json:"paused_source_domains,omitempty"`
 }
 
-func (m *QueryAllMintsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRoutingPauseStateResponse) Reset()         { *m = QueryRoutingPauseStateResponse{} }
+func (m *QueryRoutingPauseStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoutingPauseStateResponse) ProtoMessage()    {}
+func (*QueryRoutingPauseStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{33}
 }
-
-func (m *QueryAllMintsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRoutingPauseStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoutingPauseStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoutingPauseStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Mints) > 0 {
-		for iNdEx := len(m.Mints) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Mints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryRoutingPauseStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoutingPauseStateResponse.Merge(m, src)
+}
+func (m *QueryRoutingPauseStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoutingPauseStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoutingPauseStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoutingPauseStateResponse proto.InternalMessageInfo
+
+func (m *QueryRoutingPauseStateResponse) GetGlobal() bool {
+	if m != nil {
+		return m.Global
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryGetIBCForwardRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRoutingPauseStateResponse) GetPausedSourceDomains() []uint32 {
+	if m != nil {
+		return m.PausedSourceDomains
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetIBCForwardRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllHeldForwardsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetIBCForwardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SourceDomain != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SourceDomain))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryAllHeldForwardsRequest) Reset()         { *m = QueryAllHeldForwardsRequest{} }
+func (m *QueryAllHeldForwardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllHeldForwardsRequest) ProtoMessage()    {}
+func (*QueryAllHeldForwardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{34}
+}
+func (m *QueryAllHeldForwardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllHeldForwardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllHeldForwardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllHeldForwardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllHeldForwardsRequest.Merge(m, src)
+}
+func (m *QueryAllHeldForwardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllHeldForwardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllHeldForwardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetIBCForwardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllHeldForwardsRequest proto.InternalMessageInfo
+
+func (m *QueryAllHeldForwardsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetIBCForwardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllHeldForwardsResponse struct {
+	HeldForwards []HeldForward       `protobuf:"bytes,1,rep,name=heldForwards,proto3" json:"heldForwards"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetIBCForwardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.IbcForward.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllHeldForwardsResponse) Reset()         { *m = QueryAllHeldForwardsResponse{} }
+func (m *QueryAllHeldForwardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllHeldForwardsResponse) ProtoMessage()    {}
+func (*QueryAllHeldForwardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{35}
+}
+func (m *QueryAllHeldForwardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllHeldForwardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllHeldForwardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllHeldForwardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllHeldForwardsResponse.Merge(m, src)
+}
+func (m *QueryAllHeldForwardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllHeldForwardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllHeldForwardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllIBCForwardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllHeldForwardsResponse proto.InternalMessageInfo
+
+func (m *QueryAllHeldForwardsResponse) GetHeldForwards() []HeldForward {
+	if m != nil {
+		return m.HeldForwards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllIBCForwardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllHeldForwardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllIBCForwardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QuerySourceDomainRateLimitRequest struct {
+	SourceDomain uint32 `protobuf:"varint,1,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
+}
+
+func (m *QuerySourceDomainRateLimitRequest) Reset()         { *m = QuerySourceDomainRateLimitRequest{} }
+func (m *QuerySourceDomainRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySourceDomainRateLimitRequest) ProtoMessage()    {}
+func (*QuerySourceDomainRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{36}
+}
+func (m *QuerySourceDomainRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySourceDomainRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySourceDomainRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySourceDomainRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySourceDomainRateLimitRequest.Merge(m, src)
+}
+func (m *QuerySourceDomainRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySourceDomainRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySourceDomainRateLimitRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllIBCForwardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySourceDomainRateLimitRequest proto.InternalMessageInfo
+
+func (m *QuerySourceDomainRateLimitRequest) GetSourceDomain() uint32 {
+	if m != nil {
+		return m.SourceDomain
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllIBCForwardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySourceDomainRateLimitResponse struct {
+	RateLimit SourceDomainRateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	// usage in the current window
+	Usage RateLimitUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage"`
 }
 
-func (m *QueryAllIBCForwardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySourceDomainRateLimitResponse) Reset()         { *m = QuerySourceDomainRateLimitResponse{} }
+func (m *QuerySourceDomainRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySourceDomainRateLimitResponse) ProtoMessage()    {}
+func (*QuerySourceDomainRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{37}
+}
+func (m *QuerySourceDomainRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySourceDomainRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySourceDomainRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.IbcForwards) > 0 {
-		for iNdEx := len(m.IbcForwards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.IbcForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QuerySourceDomainRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySourceDomainRateLimitResponse.Merge(m, src)
+}
+func (m *QuerySourceDomainRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySourceDomainRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySourceDomainRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySourceDomainRateLimitResponse proto.InternalMessageInfo
+
+func (m *QuerySourceDomainRateLimitResponse) GetRateLimit() SourceDomainRateLimit {
+	if m != nil {
+		return m.RateLimit
 	}
-	return len(dAtA) - i, nil
+	return SourceDomainRateLimit{}
 }
 
-func (m *QueryGetInFlightPacketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySourceDomainRateLimitResponse) GetUsage() RateLimitUsage {
+	if m != nil {
+		return m.Usage
 	}
-	return dAtA[:n], nil
+	return RateLimitUsage{}
 }
 
-func (m *QueryGetInFlightPacketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryChannelRateLimitRequest struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
-func (m *QueryGetInFlightPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryChannelRateLimitRequest) Reset()         { *m = QueryChannelRateLimitRequest{} }
+func (m *QueryChannelRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelRateLimitRequest) ProtoMessage()    {}
+func (*QueryChannelRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{38}
+}
+func (m *QueryChannelRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryChannelRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelRateLimitRequest.Merge(m, src)
+}
+func (m *QueryChannelRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelRateLimitRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetInFlightPacketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryChannelRateLimitRequest proto.InternalMessageInfo
+
+func (m *QueryChannelRateLimitRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetInFlightPacketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryChannelRateLimitResponse struct {
+	RateLimit ChannelRateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	// usage in the current window
+	Usage RateLimitUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage"`
 }
 
-func (m *QueryGetInFlightPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.InFlightPacket.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryChannelRateLimitResponse) Reset()         { *m = QueryChannelRateLimitResponse{} }
+func (m *QueryChannelRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelRateLimitResponse) ProtoMessage()    {}
+func (*QueryChannelRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{39}
+}
+func (m *QueryChannelRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllInFlightPacketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryChannelRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelRateLimitResponse.Merge(m, src)
 }
-
-func (m *QueryAllInFlightPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryChannelRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelRateLimitResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllInFlightPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryChannelRateLimitResponse proto.InternalMessageInfo
+
+func (m *QueryChannelRateLimitResponse) GetRateLimit() ChannelRateLimit {
+	if m != nil {
+		return m.RateLimit
 	}
-	return len(dAtA) - i, nil
+	return ChannelRateLimit{}
 }
 
-func (m *QueryAllInFlightPacketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryChannelRateLimitResponse) GetUsage() RateLimitUsage {
+	if m != nil {
+		return m.Usage
 	}
-	return dAtA[:n], nil
+	return RateLimitUsage{}
 }
 
-func (m *QueryAllInFlightPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRateLimitsRequest struct {
 }
 
-func (m *QueryAllInFlightPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.InFlightPackets) > 0 {
-		for iNdEx := len(m.InFlightPackets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InFlightPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRateLimitsRequest) Reset()         { *m = QueryRateLimitsRequest{} }
+func (m *QueryRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsRequest) ProtoMessage()    {}
+func (*QueryRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{40}
+}
+func (m *QueryRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllowedSourceDomainSenderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllowedSourceDomainSenderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryRateLimitsRequest proto.InternalMessageInfo
+
+type QueryRateLimitsResponse struct {
+	SourceDomainRateLimits []SourceDomainRateLimit `protobuf:"bytes,1,rep,name=source_domain_rate_limits,json=sourceDomainRateLimits,proto3" json:"source_domain_rate_limits"`
+	ChannelRateLimits      []ChannelRateLimit      `protobuf:"bytes,2,rep,name=channel_rate_limits,json=channelRateLimits,proto3" json:"channel_rate_limits"`
 }
 
-func (m *QueryAllowedSourceDomainSenderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryRateLimitsResponse) Reset()         { *m = QueryRateLimitsResponse{} }
+func (m *QueryRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsResponse) ProtoMessage()    {}
+func (*QueryRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{41}
+}
+func (m *QueryRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.DomainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DomainId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitsResponse) GetSourceDomainRateLimits() []SourceDomainRateLimit {
+	if m != nil {
+		return m.SourceDomainRateLimits
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllowedSourceDomainSenderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitsResponse) GetChannelRateLimits() []ChannelRateLimit {
+	if m != nil {
+		return m.ChannelRateLimits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllowedSourceDomainSenderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllRateLimitedForwardsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllowedSourceDomainSenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AllowedSourceDomainSender.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllRateLimitedForwardsRequest) Reset()         { *m = QueryAllRateLimitedForwardsRequest{} }
+func (m *QueryAllRateLimitedForwardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRateLimitedForwardsRequest) ProtoMessage()    {}
+func (*QueryAllRateLimitedForwardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{42}
+}
+func (m *QueryAllRateLimitedForwardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRateLimitedForwardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRateLimitedForwardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllowedSourceDomainSendersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllRateLimitedForwardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRateLimitedForwardsRequest.Merge(m, src)
+}
+func (m *QueryAllRateLimitedForwardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRateLimitedForwardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRateLimitedForwardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllowedSourceDomainSendersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryAllRateLimitedForwardsRequest proto.InternalMessageInfo
 
-func (m *QueryAllowedSourceDomainSendersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAllRateLimitedForwardsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllowedSourceDomainSendersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QueryAllRateLimitedForwardsResponse struct {
+	RateLimitedForwards []RateLimitedForward `protobuf:"bytes,1,rep,name=rateLimitedForwards,proto3" json:"rateLimitedForwards"`
+	Pagination          *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllowedSourceDomainSendersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllRateLimitedForwardsResponse) Reset()         { *m = QueryAllRateLimitedForwardsResponse{} }
+func (m *QueryAllRateLimitedForwardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRateLimitedForwardsResponse) ProtoMessage()    {}
+func (*QueryAllRateLimitedForwardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248fc3ff0338b430, []int{43}
 }
-
-func (m *QueryAllowedSourceDomainSendersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.AllowedSourceDomainSenders) > 0 {
-		for iNdEx := len(m.AllowedSourceDomainSenders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AllowedSourceDomainSenders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllRateLimitedForwardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRateLimitedForwardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRateLimitedForwardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllRateLimitedForwardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRateLimitedForwardsResponse.Merge(m, src)
+}
+func (m *QueryAllRateLimitedForwardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRateLimitedForwardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRateLimitedForwardsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryAllRateLimitedForwardsResponse proto.InternalMessageInfo
+
+func (m *QueryAllRateLimitedForwardsResponse) GetRateLimitedForwards() []RateLimitedForward {
+	if m != nil {
+		return m.RateLimitedForwards
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryAllRateLimitedForwardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "noble.router.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "noble.router.QueryParamsResponse")
+	proto.RegisterType((*QueryGetMintRequest)(nil), "noble.router.QueryGetMintRequest")
+	proto.RegisterType((*QueryGetMintResponse)(nil), "noble.router.QueryGetMintResponse")
+	proto.RegisterType((*QueryAllMintsRequest)(nil), "noble.router.QueryAllMintsRequest")
+	proto.RegisterType((*QueryAllMintsResponse)(nil), "noble.router.QueryAllMintsResponse")
+	proto.RegisterType((*QueryGetIBCForwardRequest)(nil), "noble.router.QueryGetIBCForwardRequest")
+	proto.RegisterType((*QueryGetIBCForwardResponse)(nil), "noble.router.QueryGetIBCForwardResponse")
+	proto.RegisterType((*QueryAllIBCForwardsRequest)(nil), "noble.router.QueryAllIBCForwardsRequest")
+	proto.RegisterType((*QueryAllIBCForwardsResponse)(nil), "noble.router.QueryAllIBCForwardsResponse")
+	proto.RegisterType((*QueryGetInFlightPacketRequest)(nil), "noble.router.QueryGetInFlightPacketRequest")
+	proto.RegisterType((*QueryGetInFlightPacketResponse)(nil), "noble.router.QueryGetInFlightPacketResponse")
+	proto.RegisterType((*QueryAllInFlightPacketsRequest)(nil), "noble.router.QueryAllInFlightPacketsRequest")
+	proto.RegisterType((*QueryAllInFlightPacketsResponse)(nil), "noble.router.QueryAllInFlightPacketsResponse")
+	proto.RegisterType((*QueryInFlightPacketByNonceRequest)(nil), "noble.router.QueryInFlightPacketByNonceRequest")
+	proto.RegisterType((*QueryInFlightPacketByNonceResponse)(nil), "noble.router.QueryInFlightPacketByNonceResponse")
+	proto.RegisterType((*QueryAllowedSourceDomainSenderRequest)(nil), "noble.router.QueryAllowedSourceDomainSenderRequest")
+	proto.RegisterType((*QueryAllowedSourceDomainSenderResponse)(nil), "noble.router.QueryAllowedSourceDomainSenderResponse")
+	proto.RegisterType((*QueryAllowedSourceDomainSendersRequest)(nil), "noble.router.QueryAllowedSourceDomainSendersRequest")
+	proto.RegisterType((*QueryAllowedSourceDomainSendersResponse)(nil), "noble.router.QueryAllowedSourceDomainSendersResponse")
+	proto.RegisterType((*QueryAllowedChannelRequest)(nil), "noble.router.QueryAllowedChannelRequest")
+	proto.RegisterType((*QueryAllowedChannelResponse)(nil), "noble.router.QueryAllowedChannelResponse")
+	proto.RegisterType((*QueryAllowedChannelsRequest)(nil), "noble.router.QueryAllowedChannelsRequest")
+	proto.RegisterType((*QueryAllowedChannelsResponse)(nil), "noble.router.QueryAllowedChannelsResponse")
+	proto.RegisterType((*QueryForwardStatusRequest)(nil), "noble.router.QueryForwardStatusRequest")
+	proto.RegisterType((*QueryForwardStatusResponse)(nil), "noble.router.QueryForwardStatusResponse")
+	proto.RegisterType((*QueryOwnerRequest)(nil), "noble.router.QueryOwnerRequest")
+	proto.RegisterType((*QueryOwnerResponse)(nil), "noble.router.QueryOwnerResponse")
+	proto.RegisterType((*QueryPendingOwnerRequest)(nil), "noble.router.QueryPendingOwnerRequest")
+	proto.RegisterType((*QueryPendingOwnerResponse)(nil), "noble.router.QueryPendingOwnerResponse")
+	proto.RegisterType((*QueryPauserRequest)(nil), "noble.router.QueryPauserRequest")
+	proto.RegisterType((*QueryPauserResponse)(nil), "noble.router.QueryPauserResponse")
+	proto.RegisterType((*QueryRoutingPauseStateRequest)(nil), "noble.router.QueryRoutingPauseStateRequest")
+	proto.RegisterType((*QueryRoutingPauseStateResponse)(nil), "noble.router.QueryRoutingPauseStateResponse")
+	proto.RegisterType((*QueryAllHeldForwardsRequest)(nil), "noble.router.QueryAllHeldForwardsRequest")
+	proto.RegisterType((*QueryAllHeldForwardsResponse)(nil), "noble.router.QueryAllHeldForwardsResponse")
+	proto.RegisterType((*QuerySourceDomainRateLimitRequest)(nil), "noble.router.QuerySourceDomainRateLimitRequest")
+	proto.RegisterType((*QuerySourceDomainRateLimitResponse)(nil), "noble.router.QuerySourceDomainRateLimitResponse")
+	proto.RegisterType((*QueryChannelRateLimitRequest)(nil), "noble.router.QueryChannelRateLimitRequest")
+	proto.RegisterType((*QueryChannelRateLimitResponse)(nil), "noble.router.QueryChannelRateLimitResponse")
+	proto.RegisterType((*QueryRateLimitsRequest)(nil), "noble.router.QueryRateLimitsRequest")
+	proto.RegisterType((*QueryRateLimitsResponse)(nil), "noble.router.QueryRateLimitsResponse")
+	proto.RegisterType((*QueryAllRateLimitedForwardsRequest)(nil), "noble.router.QueryAllRateLimitedForwardsRequest")
+	proto.RegisterType((*QueryAllRateLimitedForwardsResponse)(nil), "noble.router.QueryAllRateLimitedForwardsResponse")
 }
 
-func (m *QueryGetMintRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SourceDomain != 0 {
-		n += 1 + sovQuery(uint64(m.SourceDomain))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
-	}
-	return n
+func init() { proto.RegisterFile("router/query.proto", fileDescriptor_248fc3ff0338b430) }
+
+var fileDescriptor_248fc3ff0338b430 = []byte{
+	// 1998 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x4f, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xd9, 0xb1, 0x93, 0xbc, 0xd8, 0x09, 0xa9, 0x19, 0x27, 0x76, 0x3b, 0x1e, 0xdb, 0x3d,
+	0x24, 0x76, 0x1c, 0x7b, 0x3a, 0xb1, 0x97, 0xb0, 0x2b, 0x90, 0x58, 0x27, 0x51, 0x36, 0x86, 0x0d,
+	0x84, 0x31, 0x8b, 0x10, 0x87, 0x8c, 0x7a, 0x66, 0x6a, 0xc7, 0xad, 0xf4, 0x74, 0xcf, 0x76, 0xf5,
+	0x24, 0x58, 0x66, 0x0e, 0x20, 0xb8, 0x70, 0x8a, 0x84, 0x10, 0x5a, 0x0e, 0x68, 0x6f, 0x70, 0xe0,
+	0x0a, 0x42, 0x42, 0xe2, 0xc4, 0x21, 0x37, 0x16, 0x21, 0x24, 0x4e, 0x08, 0x12, 0x3e, 0x08, 0xea,
+	0xea, 0xd7, 0xd3, 0x5d, 0x3d, 0xd5, 0x3d, 0x33, 0x68, 0x7c, 0x9b, 0x7e, 0xf5, 0xfe, 0xfc, 0xde,
+	0xab, 0xf7, 0xaa, 0xea, 0x3d, 0x1b, 0xa8, 0xe7, 0x76, 0x7d, 0xe6, 0x19, 0x9f, 0x74, 0x99, 0x77,
+	0x5c, 0xe9, 0x78, 0xae, 0xef, 0xd2, 0x39, 0xc7, 0xad, 0xdb, 0xac, 0x12, 0xae, 0x68, 0x5b, 0x0d,
+	0x97, 0xb7, 0x5d, 0x6e, 0xd4, 0x4d, 0xce, 0x42, 0x36, 0xe3, 0xc5, 0xdd, 0x3a, 0xf3, 0xcd, 0xbb,
+	0x46, 0xc7, 0x6c, 0x59, 0x8e, 0xe9, 0x5b, 0xae, 0x13, 0x4a, 0x6a, 0xc5, 0x96, 0xdb, 0x72, 0xc5,
+	0x4f, 0x23, 0xf8, 0x85, 0xd4, 0xeb, 0x2d, 0xd7, 0x6d, 0xd9, 0xcc, 0x30, 0x3b, 0x96, 0x61, 0x3a,
+	0x8e, 0xeb, 0x0b, 0x11, 0x1e, 0xad, 0x22, 0x82, 0x8f, 0x5d, 0xef, 0xa5, 0xe9, 0x35, 0x6b, 0x1e,
+	0x6b, 0x30, 0xab, 0xe3, 0xe3, 0xea, 0x3a, 0xae, 0x5a, 0xf5, 0x46, 0x2d, 0xe2, 0x68, 0x33, 0xdf,
+	0x6c, 0x9a, 0xbe, 0x89, 0x2c, 0x2b, 0x11, 0x8b, 0x53, 0xfb, 0xd8, 0xb6, 0x5a, 0x47, 0x7e, 0xad,
+	0x63, 0x36, 0x9e, 0xb3, 0x48, 0x43, 0xe4, 0x61, 0xc7, 0xec, 0x72, 0x86, 0xb4, 0x6b, 0x48, 0xf3,
+	0x4c, 0x9f, 0xd5, 0x6c, 0xab, 0x6d, 0x45, 0xcc, 0x57, 0x70, 0xa1, 0x6d, 0x39, 0x11, 0xa9, 0xd0,
+	0x97, 0xf7, 0xcc, 0x76, 0x1a, 0xb4, 0x69, 0xdb, 0xee, 0x4b, 0xd6, 0xac, 0x35, 0x8e, 0x4c, 0xc7,
+	0x61, 0x36, 0xae, 0xde, 0x4a, 0xad, 0x72, 0xb7, 0xeb, 0x35, 0x58, 0xad, 0xe9, 0xb6, 0x4d, 0xcb,
+	0xa9, 0x71, 0xe6, 0x34, 0x99, 0x17, 0xb2, 0xea, 0x45, 0xa0, 0xdf, 0x0e, 0x62, 0xfa, 0x54, 0x68,
+	0xaf, 0xb2, 0x4f, 0xba, 0x8c, 0xfb, 0xfa, 0x01, 0x14, 0x24, 0x2a, 0xef, 0xb8, 0x0e, 0x67, 0x74,
+	0x17, 0x66, 0x43, 0x14, 0x8b, 0x64, 0x8d, 0x6c, 0x5e, 0xdc, 0x2d, 0x56, 0x92, 0x3b, 0x55, 0x09,
+	0xb9, 0xef, 0x9f, 0x7d, 0xfd, 0xaf, 0xd5, 0x33, 0x55, 0xe4, 0xd4, 0x9f, 0xa2, 0xaa, 0x0f, 0x98,
+	0xff, 0xc4, 0x72, 0x7c, 0xb4, 0x40, 0xcb, 0x30, 0x2f, 0xa1, 0x12, 0x1a, 0xe7, 0xab, 0x73, 0x21,
+	0xf1, 0xa1, 0xa0, 0xd1, 0x22, 0xcc, 0x38, 0xae, 0xd3, 0x60, 0x8b, 0xd3, 0x6b, 0x64, 0xf3, 0x6c,
+	0x35, 0xfc, 0xd0, 0x1f, 0x42, 0x51, 0xd6, 0x88, 0xe8, 0xb6, 0xe1, 0x6c, 0x10, 0x36, 0xc4, 0x46,
+	0x65, 0x6c, 0x01, 0x27, 0x22, 0x13, 0x5c, 0xfa, 0x33, 0xd4, 0xb2, 0x6f, 0xdb, 0xc1, 0x5a, 0xe4,
+	0x3a, 0x7d, 0x04, 0x10, 0xa7, 0x15, 0xea, 0xba, 0x59, 0x09, 0x73, 0xb0, 0x12, 0xe4, 0x60, 0x25,
+	0x4c, 0x55, 0xcc, 0xc1, 0xca, 0x53, 0xb3, 0xc5, 0x50, 0xb6, 0x9a, 0x90, 0xd4, 0x5f, 0x11, 0x58,
+	0x48, 0x19, 0x40, 0x9c, 0x15, 0x98, 0x09, 0x10, 0x04, 0x41, 0x9c, 0xce, 0x05, 0x1a, 0xb2, 0xd1,
+	0x0f, 0x24, 0x44, 0x53, 0x02, 0xd1, 0xc6, 0x50, 0x44, 0xa1, 0x31, 0x09, 0xd2, 0x77, 0x61, 0x29,
+	0x0a, 0xdc, 0xc1, 0xfd, 0x07, 0x8f, 0xc2, 0x6c, 0x9e, 0xc0, 0x86, 0x58, 0xa0, 0xa9, 0xf4, 0xa2,
+	0xbb, 0xdf, 0x00, 0xb0, 0xea, 0x0d, 0xa4, 0x62, 0x40, 0x6f, 0xc8, 0x3e, 0x1f, 0xfa, 0xae, 0xc7,
+	0x62, 0xd1, 0x27, 0x58, 0x5f, 0x18, 0x86, 0x84, 0xb8, 0xde, 0x44, 0x53, 0xfb, 0xb6, 0x1d, 0xf3,
+	0x4f, 0x7c, 0xef, 0x7e, 0x4f, 0x60, 0x59, 0x69, 0x06, 0x5d, 0x7a, 0x02, 0x17, 0x63, 0x4c, 0xd1,
+	0x3e, 0x8e, 0xe5, 0x53, 0x52, 0x7e, 0x72, 0x1b, 0xcc, 0x61, 0xa5, 0xbf, 0x11, 0xce, 0x23, 0x71,
+	0x16, 0x3d, 0x15, 0x47, 0x51, 0x14, 0xa0, 0x15, 0x00, 0x3c, 0x29, 0x6a, 0x56, 0xb8, 0x17, 0x17,
+	0xaa, 0x17, 0x90, 0x72, 0xd0, 0xa4, 0xd7, 0xe0, 0x5c, 0xc7, 0xf5, 0xfc, 0x60, 0x6d, 0x4a, 0xac,
+	0xcd, 0x06, 0x9f, 0x07, 0x4d, 0xaa, 0xc1, 0x79, 0x1e, 0xa8, 0x88, 0xb7, 0xbe, 0xff, 0xad, 0xdb,
+	0x50, 0xca, 0x32, 0x8a, 0xe1, 0xfa, 0x3a, 0x5c, 0xb2, 0xa4, 0x15, 0xdc, 0x9a, 0xeb, 0x72, 0xc4,
+	0x64, 0x69, 0x0c, 0x54, 0x4a, 0x52, 0x3f, 0x82, 0x52, 0x7f, 0x67, 0xa4, 0x95, 0x89, 0x27, 0xc1,
+	0x1f, 0x09, 0xac, 0x66, 0x9a, 0x42, 0xcf, 0x3e, 0x84, 0xcb, 0x32, 0xbe, 0x28, 0x19, 0x46, 0x71,
+	0x2d, 0x2d, 0x3a, 0xb9, 0x3c, 0x78, 0x06, 0xeb, 0x02, 0x79, 0xca, 0xec, 0xf1, 0x37, 0x83, 0x72,
+	0xfd, 0xff, 0x0a, 0x7e, 0x2a, 0x59, 0xf0, 0x1d, 0xd0, 0xf3, 0xf4, 0x9f, 0xc2, 0xb6, 0x3f, 0x83,
+	0x1b, 0xd1, 0x5e, 0x04, 0x37, 0xda, 0x61, 0x02, 0xe3, 0xa1, 0xb8, 0xce, 0x22, 0xaf, 0x96, 0xe1,
+	0x02, 0x5e, 0x73, 0x98, 0xe0, 0xf3, 0xd5, 0xf3, 0x21, 0xe1, 0xa0, 0x49, 0x17, 0xe1, 0x9c, 0xd9,
+	0x6c, 0x7a, 0x8c, 0x73, 0xe1, 0xcf, 0x5c, 0x35, 0xfa, 0xd4, 0x7f, 0x41, 0xe0, 0xe6, 0x30, 0x03,
+	0xe8, 0xd6, 0x73, 0x58, 0x32, 0xb3, 0x98, 0xd0, 0xc3, 0x0d, 0xd9, 0xc3, 0x4c, 0x9d, 0xe8, 0x6c,
+	0xb6, 0x3e, 0xbd, 0x33, 0x0c, 0xd6, 0xc4, 0xd3, 0xfe, 0x3f, 0x04, 0x36, 0x86, 0x9a, 0xc4, 0x50,
+	0xb4, 0x41, 0xcb, 0x84, 0x1e, 0x55, 0xc2, 0x98, 0xb1, 0xc8, 0x51, 0x38, 0xb9, 0xfa, 0xb8, 0x17,
+	0xdf, 0x22, 0x81, 0xad, 0x07, 0xe1, 0x01, 0x18, 0x45, 0x72, 0x11, 0xce, 0xe1, 0x91, 0x88, 0x27,
+	0x64, 0xf4, 0xa9, 0x5b, 0xb0, 0xac, 0x94, 0x8b, 0x13, 0xde, 0x94, 0x56, 0xd4, 0x09, 0x2f, 0x4b,
+	0x47, 0x09, 0x2f, 0x4b, 0xea, 0x4c, 0x69, 0xea, 0x34, 0x6e, 0xba, 0xeb, 0x6a, 0x3b, 0xf1, 0x09,
+	0x27, 0x23, 0xcb, 0x38, 0xe1, 0x94, 0x4e, 0xa5, 0x45, 0x27, 0xff, 0x94, 0xc1, 0x3b, 0xf4, 0xd0,
+	0x37, 0xfd, 0x2e, 0x9f, 0xc0, 0xc9, 0xf6, 0x57, 0x02, 0x9a, 0x4a, 0x31, 0x46, 0x63, 0x0f, 0x66,
+	0xb9, 0xa0, 0x08, 0x95, 0x97, 0x76, 0x97, 0xe5, 0x20, 0xc8, 0x42, 0xc8, 0x4a, 0x1f, 0x0e, 0x9c,
+	0x83, 0x53, 0xc3, 0xcf, 0xc1, 0xf4, 0x09, 0x48, 0xef, 0xc1, 0x39, 0xec, 0x4c, 0x16, 0xa7, 0x55,
+	0xe2, 0xfd, 0x67, 0x97, 0xe0, 0xa9, 0x46, 0xcc, 0x7a, 0x01, 0xae, 0x08, 0x87, 0xbe, 0xf5, 0xd2,
+	0xe9, 0x9f, 0x92, 0xfa, 0x16, 0xd0, 0x24, 0x11, 0xbd, 0x2b, 0xc2, 0x8c, 0x1b, 0x10, 0x30, 0xed,
+	0xc3, 0x0f, 0x5d, 0x83, 0xc5, 0xb0, 0x17, 0x60, 0x4e, 0xd3, 0x72, 0x5a, 0x92, 0x9e, 0xf7, 0x61,
+	0x49, 0xb1, 0x86, 0xea, 0xca, 0x30, 0xdf, 0x09, 0xe9, 0xb5, 0xa4, 0xda, 0xb9, 0x4e, 0x82, 0x39,
+	0xd1, 0x7f, 0x74, 0x79, 0xac, 0x77, 0x07, 0x0a, 0x12, 0x15, 0x35, 0x5e, 0x0d, 0xfa, 0x8f, 0x2e,
+	0xef, 0xab, 0xc2, 0x2f, 0x7d, 0x15, 0xdf, 0x3d, 0x55, 0xb7, 0xeb, 0x5b, 0x4e, 0x4b, 0x48, 0x05,
+	0x9b, 0x10, 0xa5, 0x7c, 0xff, 0x8d, 0xa2, 0x60, 0x88, 0x55, 0xb7, 0x6c, 0xb7, 0x6e, 0x86, 0x35,
+	0x7b, 0xbe, 0x8a, 0x5f, 0x74, 0x17, 0x16, 0x84, 0x91, 0x54, 0x13, 0x15, 0x5c, 0x20, 0xd3, 0x9b,
+	0xf3, 0xd5, 0x42, 0xb8, 0x98, 0x3c, 0xad, 0x78, 0xb2, 0x76, 0x1f, 0x33, 0xbb, 0x79, 0x5a, 0xaf,
+	0xd4, 0xdf, 0x25, 0x6a, 0x57, 0xb6, 0x83, 0x3e, 0x3d, 0x80, 0xb9, 0xa3, 0x04, 0x1d, 0x0b, 0x77,
+	0x49, 0xce, 0x9b, 0x84, 0x24, 0x56, 0xad, 0x24, 0x34, 0xb9, 0x92, 0x7d, 0x8c, 0x8f, 0x92, 0x64,
+	0xac, 0xaa, 0xa6, 0xcf, 0x3e, 0x0c, 0xda, 0xdf, 0x71, 0x4a, 0x57, 0xff, 0x2d, 0x01, 0x3d, 0x4f,
+	0x15, 0xba, 0xff, 0x18, 0x20, 0xee, 0xaf, 0x31, 0xce, 0xe5, 0xd4, 0x23, 0x5d, 0xa5, 0x00, 0xc3,
+	0x70, 0xc1, 0x8b, 0x08, 0xf4, 0x5d, 0x98, 0xe9, 0x72, 0xb3, 0xc5, 0xd4, 0x85, 0xdb, 0x17, 0xfc,
+	0x28, 0xe0, 0x89, 0x7a, 0x37, 0x21, 0xa0, 0xbf, 0x8b, 0x5b, 0x14, 0x5d, 0x15, 0x69, 0x7f, 0xb3,
+	0xef, 0x9a, 0x5f, 0x13, 0x58, 0xc9, 0x10, 0xed, 0x6f, 0xef, 0xa0, 0x7f, 0x25, 0x19, 0x5a, 0x5a,
+	0x76, 0x92, 0xae, 0x2d, 0xc2, 0xd5, 0xb0, 0xa6, 0x22, 0x9e, 0xfe, 0xf4, 0xe0, 0x1f, 0x04, 0xae,
+	0x0d, 0x2c, 0x21, 0xe8, 0x26, 0x2c, 0xc9, 0xd3, 0x88, 0xd8, 0x85, 0x28, 0x41, 0xc7, 0xd8, 0xa3,
+	0xab, 0x5c, 0xb5, 0xc8, 0xe9, 0x77, 0xa0, 0x10, 0xf5, 0x39, 0x49, 0xfd, 0x53, 0x6b, 0xd3, 0x23,
+	0xc7, 0xe8, 0x4a, 0x23, 0x45, 0xe7, 0xba, 0x8d, 0x69, 0xb7, 0x6f, 0xc7, 0x54, 0x76, 0x6a, 0xe5,
+	0xfd, 0x9a, 0x40, 0x39, 0xd7, 0x1c, 0x46, 0xf4, 0x7b, 0x50, 0xf0, 0x06, 0x97, 0x31, 0x96, 0x6b,
+	0x19, 0xfb, 0xc9, 0x52, 0x35, 0xaf, 0x52, 0x31, 0xb1, 0xd2, 0xdf, 0xfd, 0x54, 0x83, 0x19, 0xe1,
+	0x0a, 0x7d, 0x0e, 0xb3, 0xe1, 0x94, 0x88, 0xa6, 0x90, 0x0d, 0x0e, 0xa1, 0xb4, 0xf5, 0x1c, 0x8e,
+	0xd0, 0x88, 0x7e, 0xfd, 0xc7, 0x7f, 0xff, 0xef, 0xcf, 0xa7, 0xae, 0xd2, 0xa2, 0x21, 0x58, 0x0d,
+	0x69, 0x54, 0x46, 0x7f, 0x44, 0xe0, 0x6c, 0x30, 0x4e, 0xa1, 0x2a, 0x4d, 0xf2, 0x3c, 0x4a, 0xd3,
+	0xf3, 0x58, 0xd0, 0xda, 0xae, 0xb0, 0xb6, 0x4d, 0xb7, 0x64, 0x6b, 0xc1, 0x94, 0xc6, 0x38, 0x91,
+	0xb2, 0xba, 0x67, 0x9c, 0x88, 0xf7, 0x44, 0x8f, 0xda, 0x30, 0xf3, 0x44, 0x4c, 0x71, 0x54, 0x06,
+	0x52, 0xb3, 0x27, 0xad, 0x9c, 0xcb, 0x83, 0x28, 0x34, 0x81, 0xa2, 0x48, 0xe9, 0x20, 0x0a, 0xfa,
+	0x2b, 0x02, 0x10, 0xcf, 0x1c, 0xe8, 0x86, 0xda, 0xa9, 0x81, 0xe1, 0x8f, 0xb6, 0x39, 0x9c, 0x11,
+	0xad, 0xbf, 0x27, 0xac, 0xef, 0xd1, 0xbb, 0xb2, 0xf5, 0xc4, 0x78, 0x34, 0x33, 0x14, 0x3f, 0x25,
+	0x70, 0x31, 0xd6, 0xc8, 0xe9, 0xa6, 0xda, 0xdb, 0xc1, 0xb9, 0x8e, 0x76, 0x6b, 0x04, 0x4e, 0xc4,
+	0xb7, 0x2e, 0xf0, 0x2d, 0xd3, 0xa5, 0x4c, 0x7c, 0xf4, 0x0f, 0x04, 0x2e, 0xc9, 0x8f, 0x2d, 0x7a,
+	0x3b, 0xc3, 0x7f, 0xd5, 0x10, 0x45, 0xdb, 0x1e, 0x8d, 0x19, 0x01, 0x1d, 0x08, 0x40, 0x0f, 0xe8,
+	0x7e, 0x0a, 0x50, 0x6a, 0x58, 0xcc, 0x8d, 0x93, 0x78, 0x32, 0xd3, 0x33, 0x4e, 0x70, 0x0e, 0xd3,
+	0x33, 0x4e, 0xa2, 0x41, 0x4b, 0x8f, 0xfe, 0x92, 0xc0, 0xe5, 0x83, 0xd4, 0xcc, 0x60, 0x3b, 0x23,
+	0x34, 0xca, 0xd9, 0x88, 0xb6, 0x33, 0x22, 0x37, 0x62, 0xdf, 0x10, 0xd8, 0xd7, 0xe9, 0xea, 0x10,
+	0xec, 0xf4, 0x2f, 0x04, 0x16, 0x94, 0xc3, 0x00, 0x6a, 0x28, 0x2c, 0xe6, 0x8d, 0x25, 0xb4, 0x3b,
+	0xa3, 0x0b, 0x20, 0xca, 0xc7, 0x02, 0xe5, 0x7d, 0xfa, 0xfe, 0x10, 0x94, 0xb5, 0xfa, 0x71, 0x4d,
+	0xa4, 0x62, 0x66, 0x86, 0xfe, 0x8d, 0xc0, 0x52, 0x66, 0x83, 0x4a, 0xf7, 0xd4, 0xc1, 0xcb, 0x9d,
+	0x47, 0x68, 0xef, 0x8c, 0x27, 0x94, 0x9f, 0x34, 0x79, 0xf3, 0x7c, 0x6e, 0x9c, 0xf4, 0x07, 0x1f,
+	0x3d, 0xe3, 0x04, 0x07, 0x1b, 0x3d, 0xfa, 0x67, 0x02, 0xda, 0x7e, 0x76, 0x4f, 0x3d, 0x16, 0xbe,
+	0x7e, 0x1e, 0x7d, 0x69, 0x4c, 0x29, 0x74, 0x6b, 0x4f, 0xb8, 0xb5, 0x43, 0x6f, 0x8f, 0xe1, 0x56,
+	0x90, 0xf5, 0x97, 0xe4, 0xee, 0x32, 0xeb, 0xe4, 0x18, 0xec, 0xe5, 0xb5, 0x5b, 0x23, 0x70, 0x22,
+	0xb8, 0x3b, 0x02, 0xdc, 0x16, 0xdd, 0x54, 0x83, 0xc3, 0xea, 0x8c, 0xeb, 0xb4, 0x47, 0x5f, 0x11,
+	0xb8, 0xbc, 0x9f, 0xea, 0x70, 0x87, 0x1b, 0xec, 0x07, 0x71, 0x6b, 0x14, 0x56, 0x04, 0x77, 0x53,
+	0x80, 0x5b, 0xa3, 0xa5, 0x7c, 0x70, 0xb4, 0x05, 0x33, 0xa2, 0xaf, 0xa2, 0xab, 0x0a, 0xe5, 0xc9,
+	0xd6, 0x4d, 0x5b, 0xcb, 0x66, 0x40, 0x9b, 0xcb, 0xc2, 0xe6, 0x02, 0x2d, 0xc8, 0x36, 0x45, 0x2f,
+	0x47, 0x7f, 0x42, 0x60, 0x2e, 0xd9, 0xf5, 0xd1, 0x9b, 0xaa, 0xdb, 0x7a, 0xb0, 0x65, 0xd4, 0x36,
+	0x86, 0xf2, 0xa1, 0xf9, 0xb2, 0x30, 0xbf, 0x42, 0x97, 0x53, 0x77, 0x7b, 0xb2, 0xa5, 0x0c, 0xdf,
+	0x13, 0x5d, 0xce, 0xbc, 0x8c, 0xf7, 0x44, 0xa2, 0xa9, 0xd4, 0xd6, 0x73, 0x38, 0x86, 0xbd, 0x27,
+	0x84, 0x89, 0x4f, 0x09, 0x5c, 0x19, 0xe8, 0x20, 0x95, 0x77, 0x47, 0x56, 0x23, 0xaa, 0x6d, 0x8f,
+	0xc6, 0x8c, 0x70, 0x6e, 0x09, 0x38, 0x65, 0xba, 0x2e, 0xc3, 0xf1, 0x42, 0x81, 0x9a, 0x80, 0x55,
+	0xe3, 0x02, 0xc5, 0xcf, 0x08, 0xcc, 0x25, 0x9b, 0xc0, 0xac, 0x44, 0x54, 0x34, 0xa4, 0xda, 0xd6,
+	0x28, 0xac, 0xf9, 0xbb, 0x12, 0xb4, 0x8c, 0xd1, 0x05, 0xcb, 0xe9, 0x9f, 0x08, 0x2c, 0x28, 0x9f,
+	0xed, 0xca, 0xeb, 0x20, 0xaf, 0x21, 0xd4, 0xee, 0x8c, 0x2e, 0x80, 0x08, 0xbf, 0x26, 0x10, 0xbe,
+	0x47, 0xbf, 0x9c, 0x0a, 0x5a, 0xdc, 0x07, 0x18, 0xd2, 0x41, 0x93, 0xbe, 0x0d, 0xe8, 0x67, 0x04,
+	0xbe, 0x90, 0x6e, 0x0a, 0xa8, 0x2a, 0x46, 0x19, 0x4d, 0x9d, 0x76, 0x7b, 0x24, 0xde, 0xfc, 0x33,
+	0x31, 0x09, 0x17, 0xab, 0x3b, 0x71, 0xf2, 0xfc, 0x10, 0x20, 0xd1, 0xed, 0x7c, 0x51, 0x95, 0x54,
+	0xe9, 0xae, 0x4c, 0xbb, 0x31, 0x84, 0x2b, 0xff, 0x01, 0x95, 0xc0, 0x43, 0x7f, 0x43, 0xa0, 0xa0,
+	0xe8, 0x48, 0xe8, 0x1d, 0x75, 0x1e, 0x65, 0xf7, 0x4a, 0xda, 0xdd, 0x31, 0x24, 0x10, 0xdf, 0x6d,
+	0x81, 0xef, 0x06, 0x2d, 0x67, 0xe1, 0x63, 0x89, 0x44, 0xfc, 0x8c, 0xc0, 0xbc, 0x34, 0x94, 0x53,
+	0x3e, 0x89, 0x55, 0x43, 0x44, 0x6d, 0x73, 0x38, 0x23, 0x22, 0xfa, 0xaa, 0x40, 0x74, 0x8f, 0xbe,
+	0x23, 0x23, 0x8a, 0xfe, 0x5b, 0x20, 0x9c, 0x02, 0x66, 0xbd, 0x39, 0xee, 0x7f, 0xf4, 0xfa, 0x4d,
+	0x89, 0x7c, 0xfe, 0xa6, 0x44, 0xfe, 0xfd, 0xa6, 0x44, 0x5e, 0xbd, 0x2d, 0x9d, 0xf9, 0xfc, 0x6d,
+	0xe9, 0xcc, 0x3f, 0xdf, 0x96, 0xce, 0x7c, 0xff, 0x2b, 0x2d, 0xcb, 0x3f, 0xea, 0xd6, 0x2b, 0x0d,
+	0xb7, 0x6d, 0x70, 0xdf, 0x33, 0x9d, 0x16, 0xb3, 0xdd, 0x17, 0x6c, 0xe7, 0x05, 0x73, 0xfc, 0xae,
+	0xc7, 0x78, 0x68, 0x6e, 0x07, 0xcd, 0xfd, 0x20, 0xb2, 0xeb, 0x1f, 0x77, 0x18, 0xaf, 0xcf, 0x8a,
+	0x3f, 0xef, 0xef, 0xfd, 0x6f, 0x00, 0x19, 0xf1, 0x57, 0x97, 0x60, 0x21, 0x00, 0x00,
 }
 
-func (m *QueryGetMintResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Mint.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a Mint by source_contract_address and nonce
+	Mint(ctx context.Context, in *QueryGetMintRequest, opts ...grpc.CallOption) (*QueryGetMintResponse, error)
+	// Queries a list of Mints
+	Mints(ctx context.Context, in *QueryAllMintsRequest, opts ...grpc.CallOption) (*QueryAllMintsResponse, error)
+	// Queries an IBCForward by source_contract_address and nonce
+	IBCForward(ctx context.Context, in *QueryGetIBCForwardRequest, opts ...grpc.CallOption) (*QueryGetIBCForwardResponse, error)
+	// Queries a list of IBCForwards
+	IBCForwards(ctx context.Context, in *QueryAllIBCForwardsRequest, opts ...grpc.CallOption) (*QueryAllIBCForwardsResponse, error)
+	// Queries an InFlightPacket by source_contract_address and nonce
+	InFlightPacket(ctx context.Context, in *QueryGetInFlightPacketRequest, opts ...grpc.CallOption) (*QueryGetInFlightPacketResponse, error)
+	// Queries a list of InFlightPackets
+	InFlightPackets(ctx context.Context, in *QueryAllInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryAllInFlightPacketsResponse, error)
+	// Queries an InFlightPacket by source_domain and nonce
+	InFlightPacketByNonce(ctx context.Context, in *QueryInFlightPacketByNonceRequest, opts ...grpc.CallOption) (*QueryInFlightPacketByNonceResponse, error)
+	// Query a specific AllowedSourceDomainSender
+	AllowedSourceDomainSender(ctx context.Context, in *QueryAllowedSourceDomainSenderRequest, opts ...grpc.CallOption) (*QueryAllowedSourceDomainSenderResponse, error)
+	// Query all AllowedSourceDomainSender's.
+	AllowedSourceDomainSenders(ctx context.Context, in *QueryAllowedSourceDomainSendersRequest, opts ...grpc.CallOption) (*QueryAllowedSourceDomainSendersResponse, error)
+	// Query a specific AllowedChannel
+	AllowedChannel(ctx context.Context, in *QueryAllowedChannelRequest, opts ...grpc.CallOption) (*QueryAllowedChannelResponse, error)
+	// Query all AllowedChannel's.
+	AllowedChannels(ctx context.Context, in *QueryAllowedChannelsRequest, opts ...grpc.CallOption) (*QueryAllowedChannelsResponse, error)
+	// Queries the owner
+	Owner(ctx context.Context, in *QueryOwnerRequest, opts ...grpc.CallOption) (*QueryOwnerResponse, error)
+	// Queries the pending owner, set until they accept ownership
+	PendingOwner(ctx context.Context, in *QueryPendingOwnerRequest, opts ...grpc.CallOption) (*QueryPendingOwnerResponse, error)
+	// Queries the pauser
+	Pauser(ctx context.Context, in *QueryPauserRequest, opts ...grpc.CallOption) (*QueryPauserResponse, error)
+	// Queries whether routing is paused globally and the paused source domains
+	RoutingPauseState(ctx context.Context, in *QueryRoutingPauseStateRequest, opts ...grpc.CallOption) (*QueryRoutingPauseStateResponse, error)
+	// Queries a list of HeldForwards
+	HeldForwards(ctx context.Context, in *QueryAllHeldForwardsRequest, opts ...grpc.CallOption) (*QueryAllHeldForwardsResponse, error)
+	// Queries the rate limit of a source domain and its current usage
+	SourceDomainRateLimit(ctx context.Context, in *QuerySourceDomainRateLimitRequest, opts ...grpc.CallOption) (*QuerySourceDomainRateLimitResponse, error)
+	// Queries the rate limit of a channel and its current usage
+	ChannelRateLimit(ctx context.Context, in *QueryChannelRateLimitRequest, opts ...grpc.CallOption) (*QueryChannelRateLimitResponse, error)
+	// Queries all source domain and channel rate limits
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	// Queries a list of RateLimitedForwards
+	RateLimitedForwards(ctx context.Context, in *QueryAllRateLimitedForwardsRequest, opts ...grpc.CallOption) (*QueryAllRateLimitedForwardsResponse, error)
+	// Queries the status of a transfer by source_domain and nonce
+	ForwardStatus(ctx context.Context, in *QueryForwardStatusRequest, opts ...grpc.CallOption) (*QueryForwardStatusResponse, error)
 }
 
-func (m *QueryAllMintsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryAllMintsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Mints) > 0 {
-		for _, e := range m.Mints {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryGetIBCForwardRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SourceDomain != 0 {
-		n += 1 + sovQuery(uint64(m.SourceDomain))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetIBCForwardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Mint(ctx context.Context, in *QueryGetMintRequest, opts ...grpc.CallOption) (*QueryGetMintResponse, error) {
+	out := new(QueryGetMintResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/Mint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.IbcForward.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryAllIBCForwardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Mints(ctx context.Context, in *QueryAllMintsRequest, opts ...grpc.CallOption) (*QueryAllMintsResponse, error) {
+	out := new(QueryAllMintsResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/Mints", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllIBCForwardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.IbcForwards) > 0 {
-		for _, e := range m.IbcForwards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) IBCForward(ctx context.Context, in *QueryGetIBCForwardRequest, opts ...grpc.CallOption) (*QueryGetIBCForwardResponse, error) {
+	out := new(QueryGetIBCForwardResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/IBCForward", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) IBCForwards(ctx context.Context, in *QueryAllIBCForwardsRequest, opts ...grpc.CallOption) (*QueryAllIBCForwardsResponse, error) {
+	out := new(QueryAllIBCForwardsResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/IBCForwards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetInFlightPacketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) InFlightPacket(ctx context.Context, in *QueryGetInFlightPacketRequest, opts ...grpc.CallOption) (*QueryGetInFlightPacketResponse, error) {
+	out := new(QueryGetInFlightPacketResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/InFlightPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) InFlightPackets(ctx context.Context, in *QueryAllInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryAllInFlightPacketsResponse, error) {
+	out := new(QueryAllInFlightPacketsResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/InFlightPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) InFlightPacketByNonce(ctx context.Context, in *QueryInFlightPacketByNonceRequest, opts ...grpc.CallOption) (*QueryInFlightPacketByNonceResponse, error) {
+	out := new(QueryInFlightPacketByNonceResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/InFlightPacketByNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	return out, nil
+}
+
+func (c *queryClient) AllowedSourceDomainSender(ctx context.Context, in *QueryAllowedSourceDomainSenderRequest, opts ...grpc.CallOption) (*QueryAllowedSourceDomainSenderResponse, error) {
+	out := new(QueryAllowedSourceDomainSenderResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/AllowedSourceDomainSender", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetInFlightPacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) AllowedSourceDomainSenders(ctx context.Context, in *QueryAllowedSourceDomainSendersRequest, opts ...grpc.CallOption) (*QueryAllowedSourceDomainSendersResponse, error) {
+	out := new(QueryAllowedSourceDomainSendersResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/AllowedSourceDomainSenders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.InFlightPacket.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryAllInFlightPacketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) AllowedChannel(ctx context.Context, in *QueryAllowedChannelRequest, opts ...grpc.CallOption) (*QueryAllowedChannelResponse, error) {
+	out := new(QueryAllowedChannelResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/AllowedChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) AllowedChannels(ctx context.Context, in *QueryAllowedChannelsRequest, opts ...grpc.CallOption) (*QueryAllowedChannelsResponse, error) {
+	out := new(QueryAllowedChannelsResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/AllowedChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllInFlightPacketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Owner(ctx context.Context, in *QueryOwnerRequest, opts ...grpc.CallOption) (*QueryOwnerResponse, error) {
+	out := new(QueryOwnerResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/Owner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.InFlightPackets) > 0 {
-		for _, e := range m.InFlightPackets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) PendingOwner(ctx context.Context, in *QueryPendingOwnerRequest, opts ...grpc.CallOption) (*QueryPendingOwnerResponse, error) {
+	out := new(QueryPendingOwnerResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/PendingOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Pauser(ctx context.Context, in *QueryPauserRequest, opts ...grpc.CallOption) (*QueryPauserResponse, error) {
+	out := new(QueryPauserResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/Pauser", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllowedSourceDomainSenderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) RoutingPauseState(ctx context.Context, in *QueryRoutingPauseStateRequest, opts ...grpc.CallOption) (*QueryRoutingPauseStateResponse, error) {
+	out := new(QueryRoutingPauseStateResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/RoutingPauseState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.DomainId != 0 {
-		n += 1 + sovQuery(uint64(m.DomainId))
+	return out, nil
+}
+
+func (c *queryClient) HeldForwards(ctx context.Context, in *QueryAllHeldForwardsRequest, opts ...grpc.CallOption) (*QueryAllHeldForwardsResponse, error) {
+	out := new(QueryAllHeldForwardsResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/HeldForwards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) SourceDomainRateLimit(ctx context.Context, in *QuerySourceDomainRateLimitRequest, opts ...grpc.CallOption) (*QuerySourceDomainRateLimitResponse, error) {
+	out := new(QuerySourceDomainRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/SourceDomainRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllowedSourceDomainSenderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ChannelRateLimit(ctx context.Context, in *QueryChannelRateLimitRequest, opts ...grpc.CallOption) (*QueryChannelRateLimitResponse, error) {
+	out := new(QueryChannelRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/ChannelRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AllowedSourceDomainSender.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryAllowedSourceDomainSendersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) RateLimitedForwards(ctx context.Context, in *QueryAllRateLimitedForwardsRequest, opts ...grpc.CallOption) (*QueryAllRateLimitedForwardsResponse, error) {
+	out := new(QueryAllRateLimitedForwardsResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/RateLimitedForwards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllowedSourceDomainSendersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ForwardStatus(ctx context.Context, in *QueryForwardStatusRequest, opts ...grpc.CallOption) (*QueryForwardStatusResponse, error) {
+	out := new(QueryForwardStatusResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Query/ForwardStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.AllowedSourceDomainSenders) > 0 {
-		for _, e := range m.AllowedSourceDomainSenders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a Mint by source_contract_address and nonce
+	Mint(context.Context, *QueryGetMintRequest) (*QueryGetMintResponse, error)
+	// Queries a list of Mints
+	Mints(context.Context, *QueryAllMintsRequest) (*QueryAllMintsResponse, error)
+	// Queries an IBCForward by source_contract_address and nonce
+	IBCForward(context.Context, *QueryGetIBCForwardRequest) (*QueryGetIBCForwardResponse, error)
+	// Queries a list of IBCForwards
+	IBCForwards(context.Context, *QueryAllIBCForwardsRequest) (*QueryAllIBCForwardsResponse, error)
+	// Queries an InFlightPacket by source_contract_address and nonce
+	InFlightPacket(context.Context, *QueryGetInFlightPacketRequest) (*QueryGetInFlightPacketResponse, error)
+	// Queries a list of InFlightPackets
+	InFlightPackets(context.Context, *QueryAllInFlightPacketsRequest) (*QueryAllInFlightPacketsResponse, error)
+	// Queries an InFlightPacket by source_domain and nonce
+	InFlightPacketByNonce(context.Context, *QueryInFlightPacketByNonceRequest) (*QueryInFlightPacketByNonceResponse, error)
+	// Query a specific AllowedSourceDomainSender
+	AllowedSourceDomainSender(context.Context, *QueryAllowedSourceDomainSenderRequest) (*QueryAllowedSourceDomainSenderResponse, error)
+	// Query all AllowedSourceDomainSender's.
+	AllowedSourceDomainSenders(context.Context, *QueryAllowedSourceDomainSendersRequest) (*QueryAllowedSourceDomainSendersResponse, error)
+	// Query a specific AllowedChannel
+	AllowedChannel(context.Context, *QueryAllowedChannelRequest) (*QueryAllowedChannelResponse, error)
+	// Query all AllowedChannel's.
+	AllowedChannels(context.Context, *QueryAllowedChannelsRequest) (*QueryAllowedChannelsResponse, error)
+	// Queries the owner
+	Owner(context.Context, *QueryOwnerRequest) (*QueryOwnerResponse, error)
+	// Queries the pending owner, set until they accept ownership
+	PendingOwner(context.Context, *QueryPendingOwnerRequest) (*QueryPendingOwnerResponse, error)
+	// Queries the pauser
+	Pauser(context.Context, *QueryPauserRequest) (*QueryPauserResponse, error)
+	// Queries whether routing is paused globally and the paused source domains
+	RoutingPauseState(context.Context, *QueryRoutingPauseStateRequest) (*QueryRoutingPauseStateResponse, error)
+	// Queries a list of HeldForwards
+	HeldForwards(context.Context, *QueryAllHeldForwardsRequest) (*QueryAllHeldForwardsResponse, error)
+	// Queries the rate limit of a source domain and its current usage
+	SourceDomainRateLimit(context.Context, *QuerySourceDomainRateLimitRequest) (*QuerySourceDomainRateLimitResponse, error)
+	// Queries the rate limit of a channel and its current usage
+	ChannelRateLimit(context.Context, *QueryChannelRateLimitRequest) (*QueryChannelRateLimitResponse, error)
+	// Queries all source domain and channel rate limits
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error)
+	// Queries a list of RateLimitedForwards
+	RateLimitedForwards(context.Context, *QueryAllRateLimitedForwardsRequest) (*QueryAllRateLimitedForwardsResponse, error)
+	// Queries the status of a transfer by source_domain and nonce
+	ForwardStatus(context.Context, *QueryForwardStatusRequest) (*QueryForwardStatusResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Mint(ctx context.Context, req *QueryGetMintRequest) (*QueryGetMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (*UnimplementedQueryServer) Mints(ctx context.Context, req *QueryAllMintsRequest) (*QueryAllMintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mints not implemented")
+}
+func (*UnimplementedQueryServer) IBCForward(ctx context.Context, req *QueryGetIBCForwardRequest) (*QueryGetIBCForwardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCForward not implemented")
+}
+func (*UnimplementedQueryServer) IBCForwards(ctx context.Context, req *QueryAllIBCForwardsRequest) (*QueryAllIBCForwardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCForwards not implemented")
+}
+func (*UnimplementedQueryServer) InFlightPacket(ctx context.Context, req *QueryGetInFlightPacketRequest) (*QueryGetInFlightPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InFlightPacket not implemented")
+}
+func (*UnimplementedQueryServer) InFlightPackets(ctx context.Context, req *QueryAllInFlightPacketsRequest) (*QueryAllInFlightPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InFlightPackets not implemented")
+}
+func (*UnimplementedQueryServer) InFlightPacketByNonce(ctx context.Context, req *QueryInFlightPacketByNonceRequest) (*QueryInFlightPacketByNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InFlightPacketByNonce not implemented")
+}
+func (*UnimplementedQueryServer) AllowedSourceDomainSender(ctx context.Context, req *QueryAllowedSourceDomainSenderRequest) (*QueryAllowedSourceDomainSenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedSourceDomainSender not implemented")
+}
+func (*UnimplementedQueryServer) AllowedSourceDomainSenders(ctx context.Context, req *QueryAllowedSourceDomainSendersRequest) (*QueryAllowedSourceDomainSendersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedSourceDomainSenders not implemented")
+}
+func (*UnimplementedQueryServer) AllowedChannel(ctx context.Context, req *QueryAllowedChannelRequest) (*QueryAllowedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedChannel not implemented")
+}
+func (*UnimplementedQueryServer) AllowedChannels(ctx context.Context, req *QueryAllowedChannelsRequest) (*QueryAllowedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedChannels not implemented")
+}
+func (*UnimplementedQueryServer) Owner(ctx context.Context, req *QueryOwnerRequest) (*QueryOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Owner not implemented")
+}
+func (*UnimplementedQueryServer) PendingOwner(ctx context.Context, req *QueryPendingOwnerRequest) (*QueryPendingOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingOwner not implemented")
+}
+func (*UnimplementedQueryServer) Pauser(ctx context.Context, req *QueryPauserRequest) (*QueryPauserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pauser not implemented")
+}
+func (*UnimplementedQueryServer) RoutingPauseState(ctx context.Context, req *QueryRoutingPauseStateRequest) (*QueryRoutingPauseStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoutingPauseState not implemented")
+}
+func (*UnimplementedQueryServer) HeldForwards(ctx context.Context, req *QueryAllHeldForwardsRequest) (*QueryAllHeldForwardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeldForwards not implemented")
+}
+func (*UnimplementedQueryServer) SourceDomainRateLimit(ctx context.Context, req *QuerySourceDomainRateLimitRequest) (*QuerySourceDomainRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SourceDomainRateLimit not implemented")
+}
+func (*UnimplementedQueryServer) ChannelRateLimit(ctx context.Context, req *QueryChannelRateLimitRequest) (*QueryChannelRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelRateLimit not implemented")
+}
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitedForwards(ctx context.Context, req *QueryAllRateLimitedForwardsRequest) (*QueryAllRateLimitedForwardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitedForwards not implemented")
+}
+func (*UnimplementedQueryServer) ForwardStatus(ctx context.Context, req *QueryForwardStatusRequest) (*QueryForwardStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardStatus not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/Mint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Mint(ctx, req.(*QueryGetMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Mints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Mints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/Mints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Mints(ctx, req.(*QueryAllMintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetIBCForwardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCForward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/IBCForward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCForward(ctx, req.(*QueryGetIBCForwardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCForwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllIBCForwardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCForwards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/IBCForwards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCForwards(ctx, req.(*QueryAllIBCForwardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InFlightPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetInFlightPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InFlightPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/InFlightPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InFlightPacket(ctx, req.(*QueryGetInFlightPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InFlightPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllInFlightPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InFlightPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/InFlightPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InFlightPackets(ctx, req.(*QueryAllInFlightPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InFlightPacketByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInFlightPacketByNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InFlightPacketByNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/InFlightPacketByNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InFlightPacketByNonce(ctx, req.(*QueryInFlightPacketByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedSourceDomainSender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedSourceDomainSenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedSourceDomainSender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/AllowedSourceDomainSender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedSourceDomainSender(ctx, req.(*QueryAllowedSourceDomainSenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedSourceDomainSenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedSourceDomainSendersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedSourceDomainSenders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/AllowedSourceDomainSenders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedSourceDomainSenders(ctx, req.(*QueryAllowedSourceDomainSendersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/AllowedChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedChannel(ctx, req.(*QueryAllowedChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/AllowedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedChannels(ctx, req.(*QueryAllowedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Owner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Owner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/Owner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Owner(ctx, req.(*QueryOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/PendingOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingOwner(ctx, req.(*QueryPendingOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pauser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/Pauser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pauser(ctx, req.(*QueryPauserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RoutingPauseState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoutingPauseStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoutingPauseState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/RoutingPauseState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoutingPauseState(ctx, req.(*QueryRoutingPauseStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HeldForwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllHeldForwardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeldForwards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/HeldForwards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeldForwards(ctx, req.(*QueryAllHeldForwardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SourceDomainRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySourceDomainRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SourceDomainRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/SourceDomainRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SourceDomainRateLimit(ctx, req.(*QuerySourceDomainRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/ChannelRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelRateLimit(ctx, req.(*QueryChannelRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitedForwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRateLimitedForwardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitedForwards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/RateLimitedForwards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitedForwards(ctx, req.(*QueryAllRateLimitedForwardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForwardStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForwardStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Query/ForwardStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForwardStatus(ctx, req.(*QueryForwardStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.router.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Query_Mint_Handler,
+		},
+		{
+			MethodName: "Mints",
+			Handler:    _Query_Mints_Handler,
+		},
+		{
+			MethodName: "IBCForward",
+			Handler:    _Query_IBCForward_Handler,
+		},
+		{
+			MethodName: "IBCForwards",
+			Handler:    _Query_IBCForwards_Handler,
+		},
+		{
+			MethodName: "InFlightPacket",
+			Handler:    _Query_InFlightPacket_Handler,
+		},
+		{
+			MethodName: "InFlightPackets",
+			Handler:    _Query_InFlightPackets_Handler,
+		},
+		{
+			MethodName: "InFlightPacketByNonce",
+			Handler:    _Query_InFlightPacketByNonce_Handler,
+		},
+		{
+			MethodName: "AllowedSourceDomainSender",
+			Handler:    _Query_AllowedSourceDomainSender_Handler,
+		},
+		{
+			MethodName: "AllowedSourceDomainSenders",
+			Handler:    _Query_AllowedSourceDomainSenders_Handler,
+		},
+		{
+			MethodName: "AllowedChannel",
+			Handler:    _Query_AllowedChannel_Handler,
+		},
+		{
+			MethodName: "AllowedChannels",
+			Handler:    _Query_AllowedChannels_Handler,
+		},
+		{
+			MethodName: "Owner",
+			Handler:    _Query_Owner_Handler,
+		},
+		{
+			MethodName: "PendingOwner",
+			Handler:    _Query_PendingOwner_Handler,
+		},
+		{
+			MethodName: "Pauser",
+			Handler:    _Query_Pauser_Handler,
+		},
+		{
+			MethodName: "RoutingPauseState",
+			Handler:    _Query_RoutingPauseState_Handler,
+		},
+		{
+			MethodName: "HeldForwards",
+			Handler:    _Query_HeldForwards_Handler,
+		},
+		{
+			MethodName: "SourceDomainRateLimit",
+			Handler:    _Query_SourceDomainRateLimit_Handler,
+		},
+		{
+			MethodName: "ChannelRateLimit",
+			Handler:    _Query_ChannelRateLimit_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "RateLimitedForwards",
+			Handler:    _Query_RateLimitedForwards_Handler,
+		},
+		{
+			MethodName: "ForwardStatus",
+			Handler:    _Query_ForwardStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "router/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SourceDomain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Mint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMintsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMintsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMintsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMintsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMintsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMintsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Mints) > 0 {
+		for iNdEx := len(m.Mints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetIBCForwardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetIBCForwardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetIBCForwardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SourceDomain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetIBCForwardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetIBCForwardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetIBCForwardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.IbcForward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllIBCForwardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllIBCForwardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllIBCForwardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllIBCForwardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllIBCForwardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllIBCForwardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IbcForwards) > 0 {
+		for iNdEx := len(m.IbcForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetInFlightPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetInFlightPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetInFlightPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetInFlightPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetInFlightPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetInFlightPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InFlightPacket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllInFlightPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllInFlightPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllInFlightPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllInFlightPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllInFlightPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllInFlightPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InFlightPackets) > 0 {
+		for iNdEx := len(m.InFlightPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInFlightPacketByNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInFlightPacketByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInFlightPacketByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SourceDomain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInFlightPacketByNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInFlightPacketByNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInFlightPacketByNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InFlightPacket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedSourceDomainSenderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedSourceDomainSenderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedSourceDomainSenderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DomainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DomainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedSourceDomainSenderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedSourceDomainSenderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedSourceDomainSenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AllowedSourceDomainSender.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedSourceDomainSendersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedSourceDomainSendersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedSourceDomainSendersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedSourceDomainSendersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedSourceDomainSendersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedSourceDomainSendersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AllowedSourceDomainSenders) > 0 {
+		for iNdEx := len(m.AllowedSourceDomainSenders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedSourceDomainSenders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AllowedChannel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SourceDomain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Receipt != nil {
+		{
+			size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InFlightPacket != nil {
+		{
+			size, err := m.InFlightPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pauser) > 0 {
+		i -= len(m.Pauser)
+		copy(dAtA[i:], m.Pauser)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pauser)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoutingPauseStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoutingPauseStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoutingPauseStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoutingPauseStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoutingPauseStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoutingPauseStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedSourceDomains) > 0 {
+		dAtA21 := make([]byte, len(m.PausedSourceDomains)*10)
+		var j20 int
+		for _, num := range m.PausedSourceDomains {
+			for num >= 1<<7 {
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j20++
+			}
+			dAtA21[j20] = uint8(num)
+			j20++
+		}
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintQuery(dAtA, i, uint64(j20))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Global {
+		i--
+		if m.Global {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllHeldForwardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllHeldForwardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllHeldForwardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllHeldForwardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllHeldForwardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllHeldForwardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HeldForwards) > 0 {
+		for iNdEx := len(m.HeldForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeldForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySourceDomainRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySourceDomainRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySourceDomainRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SourceDomain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySourceDomainRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySourceDomainRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySourceDomainRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelRateLimits) > 0 {
+		for iNdEx := len(m.ChannelRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SourceDomainRateLimits) > 0 {
+		for iNdEx := len(m.SourceDomainRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceDomainRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRateLimitedForwardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRateLimitedForwardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRateLimitedForwardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRateLimitedForwardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRateLimitedForwardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRateLimitedForwardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RateLimitedForwards) > 0 {
+		for iNdEx := len(m.RateLimitedForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitedForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetMintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceDomain != 0 {
+		n += 1 + sovQuery(uint64(m.SourceDomain))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryGetMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Mint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMintsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMintsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mints) > 0 {
+		for _, e := range m.Mints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetIBCForwardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceDomain != 0 {
+		n += 1 + sovQuery(uint64(m.SourceDomain))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryGetIBCForwardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IbcForward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllIBCForwardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllIBCForwardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IbcForwards) > 0 {
+		for _, e := range m.IbcForwards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetInFlightPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryGetInFlightPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InFlightPacket.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllInFlightPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInFlightPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InFlightPackets) > 0 {
+		for _, e := range m.InFlightPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInFlightPacketByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceDomain != 0 {
+		n += 1 + sovQuery(uint64(m.SourceDomain))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryInFlightPacketByNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InFlightPacket.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllowedSourceDomainSenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DomainId != 0 {
+		n += 1 + sovQuery(uint64(m.DomainId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedSourceDomainSenderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AllowedSourceDomainSender.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllowedSourceDomainSendersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedSourceDomainSendersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedSourceDomainSenders) > 0 {
+		for _, e := range m.AllowedSourceDomainSenders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AllowedChannel.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllowedChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedChannels) > 0 {
+		for _, e := range m.AllowedChannels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForwardStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceDomain != 0 {
+		n += 1 + sovQuery(uint64(m.SourceDomain))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryForwardStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.InFlightPacket != nil {
+		l = m.InFlightPacket.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Receipt != nil {
+		l = m.Receipt.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPauserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPauserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pauser)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoutingPauseStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRoutingPauseStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Global {
+		n += 2
+	}
+	if len(m.PausedSourceDomains) > 0 {
+		l = 0
+		for _, e := range m.PausedSourceDomains {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryAllHeldForwardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllHeldForwardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HeldForwards) > 0 {
+		for _, e := range m.HeldForwards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySourceDomainRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceDomain != 0 {
+		n += 1 + sovQuery(uint64(m.SourceDomain))
+	}
+	return n
+}
+
+func (m *QuerySourceDomainRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChannelRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SourceDomainRateLimits) > 0 {
+		for _, e := range m.SourceDomainRateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ChannelRateLimits) > 0 {
+		for _, e := range m.ChannelRateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllRateLimitedForwardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRateLimitedForwardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimitedForwards) > 0 {
+		for _, e := range m.RateLimitedForwards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Mint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMintsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMintsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMintsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMintsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMintsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMintsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mints = append(m.Mints, Mint{})
+			if err := m.Mints[len(m.Mints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetIBCForwardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetIBCForwardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetIBCForwardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetIBCForwardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetIBCForwardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetIBCForwardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcForward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IbcForward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllIBCForwardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllIBCForwardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllIBCForwardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllIBCForwardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllIBCForwardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllIBCForwardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcForwards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcForwards = append(m.IbcForwards, StoreIBCForwardMetadata{})
+			if err := m.IbcForwards[len(m.IbcForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetInFlightPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetInFlightPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetInFlightPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetInFlightPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetInFlightPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetInFlightPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InFlightPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInFlightPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInFlightPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInFlightPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInFlightPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightPackets = append(m.InFlightPackets, InFlightPacket{})
+			if err := m.InFlightPackets[len(m.InFlightPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInFlightPacketByNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInFlightPacketByNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInFlightPacketByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInFlightPacketByNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInFlightPacketByNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInFlightPacketByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InFlightPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedSourceDomainSenderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedSourceDomainSenderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedSourceDomainSenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			m.DomainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DomainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedSourceDomainSenderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedSourceDomainSenderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedSourceDomainSenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSourceDomainSender", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AllowedSourceDomainSender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedSourceDomainSendersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedSourceDomainSendersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedSourceDomainSendersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedSourceDomainSendersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedSourceDomainSendersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedSourceDomainSendersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSourceDomainSenders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSourceDomainSenders = append(m.AllowedSourceDomainSenders, AllowedSourceDomainSender{})
+			if err := m.AllowedSourceDomainSenders[len(m.AllowedSourceDomainSenders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AllowedChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, AllowedChannel{})
+			if err := m.AllowedChannels[len(m.AllowedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ForwardStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InFlightPacket == nil {
+				m.InFlightPacket = &InFlightPacket{}
+			}
+			if err := m.InFlightPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Receipt == nil {
+				m.Receipt = &ForwardReceipt{}
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2339,10 +7857,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2366,7 +7884,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2389,17 +7907,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2409,24 +7927,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2449,7 +7966,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMintRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2472,50 +7989,12 @@ func (m *QueryGetMintRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMintRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
-			}
-			m.SourceDomain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SourceDomain |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2537,7 +8016,7 @@ func (m *QueryGetMintRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMintResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2560,17 +8039,17 @@ func (m *QueryGetMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2580,24 +8059,23 @@ func (m *QueryGetMintResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Mint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2620,7 +8098,7 @@ func (m *QueryGetMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMintsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPauserRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2643,48 +8121,12 @@ func (m *QueryAllMintsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMintsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPauserRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMintsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPauserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2706,7 +8148,7 @@ func (m *QueryAllMintsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMintsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPauserResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2729,51 +8171,17 @@ func (m *QueryAllMintsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMintsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPauserResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMintsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPauserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Mints = append(m.Mints, Mint{})
-			if err := m.Mints[len(m.Mints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2783,27 +8191,23 @@ func (m *QueryAllMintsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pauser = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2826,7 +8230,7 @@ func (m *QueryAllMintsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetIBCForwardRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRoutingPauseStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2849,50 +8253,12 @@ func (m *QueryGetIBCForwardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetIBCForwardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoutingPauseStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetIBCForwardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoutingPauseStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
-			}
-			m.SourceDomain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SourceDomain |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2914,7 +8280,7 @@ func (m *QueryGetIBCForwardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetIBCForwardResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRoutingPauseStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2937,17 +8303,17 @@ func (m *QueryGetIBCForwardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetIBCForwardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoutingPauseStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetIBCForwardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoutingPauseStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcForward", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Global", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2957,25 +8323,88 @@ func (m *QueryGetIBCForwardResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.IbcForward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Global = bool(v != 0)
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PausedSourceDomains = append(m.PausedSourceDomains, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PausedSourceDomains) == 0 {
+					m.PausedSourceDomains = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PausedSourceDomains = append(m.PausedSourceDomains, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedSourceDomains", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2997,7 +8426,7 @@ func (m *QueryGetIBCForwardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllIBCForwardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllHeldForwardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3020,10 +8449,10 @@ func (m *QueryAllIBCForwardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllIBCForwardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllHeldForwardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllIBCForwardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllHeldForwardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3083,7 +8512,7 @@ func (m *QueryAllIBCForwardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllIBCForwardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllHeldForwardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3106,15 +8535,15 @@ func (m *QueryAllIBCForwardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllIBCForwardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllHeldForwardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllIBCForwardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllHeldForwardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcForwards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldForwards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3141,8 +8570,8 @@ func (m *QueryAllIBCForwardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcForwards = append(m.IbcForwards, StoreIBCForwardMetadata{})
-			if err := m.IbcForwards[len(m.IbcForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HeldForwards = append(m.HeldForwards, HeldForward{})
+			if err := m.HeldForwards[len(m.HeldForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3203,7 +8632,7 @@ func (m *QueryAllIBCForwardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInFlightPacketRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySourceDomainRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3226,81 +8655,17 @@ func (m *QueryGetInFlightPacketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInFlightPacketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySourceDomainRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInFlightPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySourceDomainRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
 			}
-			m.Sequence = 0
+			m.SourceDomain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3310,7 +8675,7 @@ func (m *QueryGetInFlightPacketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.SourceDomain |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3336,7 +8701,7 @@ func (m *QueryGetInFlightPacketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInFlightPacketResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySourceDomainRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3359,15 +8724,15 @@ func (m *QueryGetInFlightPacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInFlightPacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySourceDomainRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInFlightPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySourceDomainRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPacket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3394,7 +8759,40 @@ func (m *QueryGetInFlightPacketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InFlightPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3419,7 +8817,7 @@ func (m *QueryGetInFlightPacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3442,17 +8840,17 @@ func (m *QueryAllInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInFlightPacketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInFlightPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3462,27 +8860,23 @@ func (m *QueryAllInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3505,7 +8899,7 @@ func (m *QueryAllInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3528,15 +8922,15 @@ func (m *QueryAllInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInFlightPacketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInFlightPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3563,14 +8957,13 @@ func (m *QueryAllInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InFlightPackets = append(m.InFlightPackets, InFlightPacket{})
-			if err := m.InFlightPackets[len(m.InFlightPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3597,10 +8990,7 @@ func (m *QueryAllInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3625,7 +9015,7 @@ func (m *QueryAllInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowedSourceDomainSenderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3648,65 +9038,12 @@ func (m *QueryAllowedSourceDomainSenderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowedSourceDomainSenderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowedSourceDomainSenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
-			}
-			m.DomainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DomainId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3728,7 +9065,7 @@ func (m *QueryAllowedSourceDomainSenderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowedSourceDomainSenderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3751,15 +9088,15 @@ func (m *QueryAllowedSourceDomainSenderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowedSourceDomainSenderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowedSourceDomainSenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSourceDomainSender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomainRateLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3786,7 +9123,42 @@ func (m *QueryAllowedSourceDomainSenderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AllowedSourceDomainSender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SourceDomainRateLimits = append(m.SourceDomainRateLimits, SourceDomainRateLimit{})
+			if err := m.SourceDomainRateLimits[len(m.SourceDomainRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelRateLimits = append(m.ChannelRateLimits, ChannelRateLimit{})
+			if err := m.ChannelRateLimits[len(m.ChannelRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3811,7 +9183,7 @@ func (m *QueryAllowedSourceDomainSenderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowedSourceDomainSendersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRateLimitedForwardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3834,10 +9206,10 @@ func (m *QueryAllowedSourceDomainSendersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowedSourceDomainSendersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRateLimitedForwardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowedSourceDomainSendersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRateLimitedForwardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3897,7 +9269,7 @@ func (m *QueryAllowedSourceDomainSendersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowedSourceDomainSendersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRateLimitedForwardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3920,15 +9292,15 @@ func (m *QueryAllowedSourceDomainSendersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowedSourceDomainSendersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRateLimitedForwardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowedSourceDomainSendersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRateLimitedForwardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSourceDomainSenders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitedForwards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3955,8 +9327,8 @@ func (m *QueryAllowedSourceDomainSendersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedSourceDomainSenders = append(m.AllowedSourceDomainSenders, AllowedSourceDomainSender{})
-			if err := m.AllowedSourceDomainSenders[len(m.AllowedSourceDomainSenders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RateLimitedForwards = append(m.RateLimitedForwards, RateLimitedForward{})
+			if err := m.RateLimitedForwards[len(m.RateLimitedForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+func (r SourceDomainRateLimit) Validate() error {
+	return validateRateLimit(r.WindowBlocks, r.MaxAmount)
+}
+
+func (r ChannelRateLimit) Validate() error {
+	if err := host.ChannelIdentifierValidator(r.Channel); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel: %s", err)
+	}
+	return validateRateLimit(r.WindowBlocks, r.MaxAmount)
+}
+
+func validateRateLimit(windowBlocks uint64, maxAmount sdk.Int) error {
+	if windowBlocks == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "rate limit window must be at least one block")
+	}
+	if maxAmount.IsNil() || maxAmount.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "rate limit max amount cannot be negative")
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type relayerContextKey struct{}
+
+// ContextWithRelayer returns a context that records the relayer that submitted the current transaction.
+func ContextWithRelayer(ctx sdk.Context, relayer sdk.AccAddress) sdk.Context {
+	return ctx.WithValue(relayerContextKey{}, relayer)
+}
+
+// RelayerFromContext returns the relayer that submitted the current transaction, if it is known.
+func RelayerFromContext(ctx sdk.Context) (sdk.AccAddress, bool) {
+	relayer, ok := ctx.Value(relayerContextKey{}).(sdk.AccAddress)
+	if !ok || relayer.Empty() {
+		return nil, false
+	}
+	return relayer, true
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -27,6 +29,8 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MsgUpdateOwner proposes a new owner, who becomes the owner once they accept
+// with MsgAcceptOwner
 type MsgUpdateOwner struct {
 	From     string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	NewOwner string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
@@ -195,6 +199,187 @@ func (m *MsgAcceptOwnerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAcceptOwnerResponse proto.InternalMessageInfo
 
+// MsgSetOwner sets the owner immediately, without it having to accept, and
+// clears the pending owner. Only the authority can set the owner, so that a
+// lost owner key can be replaced.
+type MsgSetOwner struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	NewOwner  string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *MsgSetOwner) Reset()         { *m = MsgSetOwner{} }
+func (m *MsgSetOwner) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOwner) ProtoMessage()    {}
+func (*MsgSetOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{4}
+}
+func (m *MsgSetOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOwner.Merge(m, src)
+}
+func (m *MsgSetOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOwner proto.InternalMessageInfo
+
+func (m *MsgSetOwner) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetOwner) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+type MsgSetOwnerResponse struct {
+}
+
+func (m *MsgSetOwnerResponse) Reset()         { *m = MsgSetOwnerResponse{} }
+func (m *MsgSetOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOwnerResponse) ProtoMessage()    {}
+func (*MsgSetOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{5}
+}
+func (m *MsgSetOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOwnerResponse.Merge(m, src)
+}
+func (m *MsgSetOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOwnerResponse proto.InternalMessageInfo
+
+// MsgUpdateParams replaces the params of the module, the owner or the
+// authority can update them
+type MsgUpdateParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{6}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{7}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 type MsgAddAllowedSourceDomainSender struct {
 	From     string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	DomainId uint32 `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -205,7 +390,7 @@ func (m *MsgAddAllowedSourceDomainSender) Reset()         { *m = MsgAddAllowedSo
 func (m *MsgAddAllowedSourceDomainSender) String() string { return proto.CompactTextString(m) }
 func (*MsgAddAllowedSourceDomainSender) ProtoMessage()    {}
 func (*MsgAddAllowedSourceDomainSender) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beacb3e51b9928bf, []int{4}
+	return fileDescriptor_beacb3e51b9928bf, []int{8}
 }
 func (m *MsgAddAllowedSourceDomainSender) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,7 +449,7 @@ func (m *MsgAddAllowedSourceDomainSenderResponse) Reset() {
 func (m *MsgAddAllowedSourceDomainSenderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddAllowedSourceDomainSenderResponse) ProtoMessage()    {}
 func (*MsgAddAllowedSourceDomainSenderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beacb3e51b9928bf, []int{5}
+	return fileDescriptor_beacb3e51b9928bf, []int{9}
 }
 func (m *MsgAddAllowedSourceDomainSenderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -303,7 +488,7 @@ func (m *MsgRemoveAllowedSourceDomainSender) Reset()         { *m = MsgRemoveAll
 func (m *MsgRemoveAllowedSourceDomainSender) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveAllowedSourceDomainSender) ProtoMessage()    {}
 func (*MsgRemoveAllowedSourceDomainSender) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beacb3e51b9928bf, []int{6}
+	return fileDescriptor_beacb3e51b9928bf, []int{10}
 }
 func (m *MsgRemoveAllowedSourceDomainSender) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -364,7 +549,7 @@ func (m *MsgRemoveAllowedSourceDomainSenderResponse) String() string {
 }
 func (*MsgRemoveAllowedSourceDomainSenderResponse) ProtoMessage() {}
 func (*MsgRemoveAllowedSourceDomainSenderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beacb3e51b9928bf, []int{7}
+	return fileDescriptor_beacb3e51b9928bf, []int{11}
 }
 func (m *MsgRemoveAllowedSourceDomainSenderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,567 +578,5568 @@ func (m *MsgRemoveAllowedSourceDomainSenderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveAllowedSourceDomainSenderResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateOwner)(nil), "noble.router.MsgUpdateOwner")
-	proto.RegisterType((*MsgUpdateOwnerResponse)(nil), "noble.router.MsgUpdateOwnerResponse")
-	proto.RegisterType((*MsgAcceptOwner)(nil), "noble.router.MsgAcceptOwner")
-	proto.RegisterType((*MsgAcceptOwnerResponse)(nil), "noble.router.MsgAcceptOwnerResponse")
-	proto.RegisterType((*MsgAddAllowedSourceDomainSender)(nil), "noble.router.MsgAddAllowedSourceDomainSender")
-	proto.RegisterType((*MsgAddAllowedSourceDomainSenderResponse)(nil), "noble.router.MsgAddAllowedSourceDomainSenderResponse")
-	proto.RegisterType((*MsgRemoveAllowedSourceDomainSender)(nil), "noble.router.MsgRemoveAllowedSourceDomainSender")
-	proto.RegisterType((*MsgRemoveAllowedSourceDomainSenderResponse)(nil), "noble.router.MsgRemoveAllowedSourceDomainSenderResponse")
+type MsgClaimFailedForward struct {
+	From         string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	SourceDomain uint32 `protobuf:"varint,2,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
+	Nonce        uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func init() { proto.RegisterFile("router/tx.proto", fileDescriptor_beacb3e51b9928bf) }
-
-var fileDescriptor_beacb3e51b9928bf = []byte{
-	// 391 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x6b, 0xe2, 0x40,
-	0x14, 0xc7, 0x1d, 0x5d, 0x76, 0x75, 0xd6, 0xdd, 0x85, 0x1c, 0x96, 0xe0, 0x4a, 0x94, 0x20, 0xac,
-	0xbb, 0xac, 0xc9, 0xd2, 0x52, 0x28, 0xf4, 0x64, 0xe9, 0xa5, 0x07, 0x29, 0x8d, 0x78, 0xe9, 0x45,
-	0x62, 0xe6, 0x35, 0x15, 0x92, 0x99, 0x30, 0x33, 0x31, 0xf6, 0xde, 0x6b, 0xa1, 0x1f, 0xab, 0x47,
-	0x8f, 0x3d, 0x16, 0xfd, 0x00, 0xfd, 0x0a, 0xc5, 0xd1, 0x88, 0x22, 0x1a, 0xa4, 0xb7, 0x64, 0xde,
-	0xff, 0xff, 0x7f, 0x3f, 0xde, 0xe3, 0xe1, 0x1f, 0x9c, 0xc5, 0x12, 0xb8, 0x2d, 0xc7, 0x56, 0xc4,
-	0x99, 0x64, 0x5a, 0x99, 0xb2, 0x41, 0x00, 0xd6, 0xe2, 0xd9, 0x6c, 0xe3, 0xef, 0x1d, 0xe1, 0xf7,
-	0x22, 0xe2, 0x4a, 0xb8, 0x4a, 0x28, 0x70, 0x4d, 0xc3, 0x9f, 0x6e, 0x39, 0x0b, 0x75, 0x54, 0x47,
-	0xcd, 0x92, 0xa3, 0xbe, 0xb5, 0x5f, 0xb8, 0x44, 0x21, 0xe9, 0xb3, 0xb9, 0x40, 0xcf, 0xab, 0x42,
-	0x91, 0x42, 0xa2, 0x0c, 0xa6, 0x8e, 0x7f, 0x6e, 0x46, 0x38, 0x20, 0x22, 0x46, 0x05, 0x98, 0x0d,
-	0x15, 0xde, 0xf6, 0x3c, 0x88, 0xe4, 0xce, 0xf0, 0xa5, 0x7f, 0x4d, 0xb5, 0xf2, 0x07, 0xb8, 0x36,
-	0xaf, 0x10, 0xd2, 0x0e, 0x02, 0x96, 0x00, 0xe9, 0xb2, 0x98, 0x7b, 0x70, 0xc1, 0x42, 0x77, 0x48,
-	0xbb, 0x40, 0xc9, 0x6e, 0x5a, 0xa2, 0x34, 0xfd, 0x21, 0x51, 0xb4, 0xdf, 0x9c, 0xe2, 0xe2, 0xe1,
-	0x92, 0x68, 0x3a, 0xfe, 0xe2, 0x12, 0xc2, 0x41, 0x08, 0xbd, 0x50, 0x47, 0xcd, 0xb2, 0x93, 0xfe,
-	0x9a, 0x7f, 0xf0, 0xef, 0x8c, 0x6e, 0x2b, 0x30, 0x86, 0xcd, 0x8e, 0xf0, 0x1d, 0x08, 0xd9, 0x08,
-	0x3e, 0xc0, 0x56, 0xd8, 0xcd, 0x96, 0xdf, 0x64, 0xfb, 0x87, 0xff, 0x66, 0x37, 0x4c, 0xf1, 0x8e,
-	0xde, 0xf2, 0xb8, 0xd0, 0x11, 0xbe, 0x76, 0x8d, 0xbf, 0xae, 0x0f, 0xbf, 0x6a, 0xad, 0xaf, 0xde,
-	0xda, 0x1c, 0x7a, 0xa5, 0xb1, 0xaf, 0x9a, 0x46, 0x6b, 0x0f, 0x08, 0x57, 0xf7, 0x2e, 0xa4, 0xb5,
-	0x1d, 0xb3, 0x47, 0x5e, 0x39, 0x39, 0x48, 0xbe, 0xc2, 0x78, 0x44, 0xb8, 0x96, 0x35, 0xfe, 0xff,
-	0x5b, 0xd1, 0x19, 0x8e, 0xca, 0xe9, 0xa1, 0x8e, 0x94, 0xe7, 0xbc, 0xf7, 0x3c, 0x35, 0xd0, 0x64,
-	0x6a, 0xa0, 0xd7, 0xa9, 0x81, 0x9e, 0x66, 0x46, 0x6e, 0x32, 0x33, 0x72, 0x2f, 0x33, 0x23, 0x77,
-	0x73, 0xe6, 0x0f, 0xe5, 0x5d, 0x3c, 0xb0, 0x3c, 0x16, 0xda, 0x42, 0x72, 0x97, 0xfa, 0x10, 0xb0,
-	0x11, 0xb4, 0x46, 0x40, 0x65, 0xcc, 0x41, 0xd8, 0xaa, 0x65, 0x6b, 0x79, 0xa5, 0x63, 0x3b, 0x3d,
-	0xd7, 0xfb, 0x08, 0xc4, 0xe0, 0xb3, 0x3a, 0xd9, 0xe3, 0xf7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6f,
-	0xe4, 0x3c, 0xec, 0xc5, 0x03, 0x00, 0x00,
+func (m *MsgClaimFailedForward) Reset()         { *m = MsgClaimFailedForward{} }
+func (m *MsgClaimFailedForward) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimFailedForward) ProtoMessage()    {}
+func (*MsgClaimFailedForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{12}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	AcceptOwner(ctx context.Context, in *MsgAcceptOwner, opts ...grpc.CallOption) (*MsgAcceptOwnerResponse, error)
-	AddAllowedSourceDomainSender(ctx context.Context, in *MsgAddAllowedSourceDomainSender, opts ...grpc.CallOption) (*MsgAddAllowedSourceDomainSenderResponse, error)
-	RemoveAllowedSourceDomainSender(ctx context.Context, in *MsgRemoveAllowedSourceDomainSender, opts ...grpc.CallOption) (*MsgRemoveAllowedSourceDomainSenderResponse, error)
+func (m *MsgClaimFailedForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgClaimFailedForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimFailedForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgClaimFailedForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimFailedForward.Merge(m, src)
+}
+func (m *MsgClaimFailedForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimFailedForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimFailedForward.DiscardUnknown(m)
 }
 
-func (c *msgClient) AcceptOwner(ctx context.Context, in *MsgAcceptOwner, opts ...grpc.CallOption) (*MsgAcceptOwnerResponse, error) {
-	out := new(MsgAcceptOwnerResponse)
-	err := c.cc.Invoke(ctx, "/noble.router.Msg/AcceptOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimFailedForward proto.InternalMessageInfo
+
+func (m *MsgClaimFailedForward) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddAllowedSourceDomainSender(ctx context.Context, in *MsgAddAllowedSourceDomainSender, opts ...grpc.CallOption) (*MsgAddAllowedSourceDomainSenderResponse, error) {
-	out := new(MsgAddAllowedSourceDomainSenderResponse)
-	err := c.cc.Invoke(ctx, "/noble.router.Msg/AddAllowedSourceDomainSender", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgClaimFailedForward) GetSourceDomain() uint32 {
+	if m != nil {
+		return m.SourceDomain
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RemoveAllowedSourceDomainSender(ctx context.Context, in *MsgRemoveAllowedSourceDomainSender, opts ...grpc.CallOption) (*MsgRemoveAllowedSourceDomainSenderResponse, error) {
-	out := new(MsgRemoveAllowedSourceDomainSenderResponse)
-	err := c.cc.Invoke(ctx, "/noble.router.Msg/RemoveAllowedSourceDomainSender", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgClaimFailedForward) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	AcceptOwner(context.Context, *MsgAcceptOwner) (*MsgAcceptOwnerResponse, error)
-	AddAllowedSourceDomainSender(context.Context, *MsgAddAllowedSourceDomainSender) (*MsgAddAllowedSourceDomainSenderResponse, error)
-	RemoveAllowedSourceDomainSender(context.Context, *MsgRemoveAllowedSourceDomainSender) (*MsgRemoveAllowedSourceDomainSenderResponse, error)
+type MsgClaimFailedForwardResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgClaimFailedForwardResponse) Reset()         { *m = MsgClaimFailedForwardResponse{} }
+func (m *MsgClaimFailedForwardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimFailedForwardResponse) ProtoMessage()    {}
+func (*MsgClaimFailedForwardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{13}
 }
-
-func (*UnimplementedMsgServer) AcceptOwner(ctx context.Context, req *MsgAcceptOwner) (*MsgAcceptOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwner not implemented")
+func (m *MsgClaimFailedForwardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) AddAllowedSourceDomainSender(ctx context.Context, req *MsgAddAllowedSourceDomainSender) (*MsgAddAllowedSourceDomainSenderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedSourceDomainSender not implemented")
+func (m *MsgClaimFailedForwardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimFailedForwardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RemoveAllowedSourceDomainSender(ctx context.Context, req *MsgRemoveAllowedSourceDomainSender) (*MsgRemoveAllowedSourceDomainSenderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedSourceDomainSender not implemented")
+func (m *MsgClaimFailedForwardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimFailedForwardResponse.Merge(m, src)
+}
+func (m *MsgClaimFailedForwardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimFailedForwardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimFailedForwardResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgClaimFailedForwardResponse proto.InternalMessageInfo
+
+// MsgPairForward pairs an unpaired IBC forward with its mint, only the
+// required submitter of the forward can pair it
+type MsgPairForward struct {
+	From         string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	SourceDomain uint32 `protobuf:"varint,2,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
+	Nonce        uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func _Msg_AcceptOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAcceptOwner)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgPairForward) Reset()         { *m = MsgPairForward{} }
+func (m *MsgPairForward) String() string { return proto.CompactTextString(m) }
+func (*MsgPairForward) ProtoMessage()    {}
+func (*MsgPairForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{14}
+}
+func (m *MsgPairForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPairForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPairForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AcceptOwner(ctx, in)
+}
+func (m *MsgPairForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPairForward.Merge(m, src)
+}
+func (m *MsgPairForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPairForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPairForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPairForward proto.InternalMessageInfo
+
+func (m *MsgPairForward) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.router.Msg/AcceptOwner",
+	return ""
+}
+
+func (m *MsgPairForward) GetSourceDomain() uint32 {
+	if m != nil {
+		return m.SourceDomain
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AcceptOwner(ctx, req.(*MsgAcceptOwner))
+	return 0
+}
+
+func (m *MsgPairForward) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_AddAllowedSourceDomainSender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddAllowedSourceDomainSender)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddAllowedSourceDomainSender(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.router.Msg/AddAllowedSourceDomainSender",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddAllowedSourceDomainSender(ctx, req.(*MsgAddAllowedSourceDomainSender))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgPairForwardResponse struct {
 }
 
-func _Msg_RemoveAllowedSourceDomainSender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveAllowedSourceDomainSender)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveAllowedSourceDomainSender(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.router.Msg/RemoveAllowedSourceDomainSender",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveAllowedSourceDomainSender(ctx, req.(*MsgRemoveAllowedSourceDomainSender))
+func (m *MsgPairForwardResponse) Reset()         { *m = MsgPairForwardResponse{} }
+func (m *MsgPairForwardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPairForwardResponse) ProtoMessage()    {}
+func (*MsgPairForwardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{15}
+}
+func (m *MsgPairForwardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPairForwardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPairForwardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgPairForwardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPairForwardResponse.Merge(m, src)
+}
+func (m *MsgPairForwardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPairForwardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPairForwardResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.router.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AcceptOwner",
-			Handler:    _Msg_AcceptOwner_Handler,
-		},
-		{
-			MethodName: "AddAllowedSourceDomainSender",
-			Handler:    _Msg_AddAllowedSourceDomainSender_Handler,
-		},
-		{
-			MethodName: "RemoveAllowedSourceDomainSender",
-			Handler:    _Msg_RemoveAllowedSourceDomainSender_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "router/tx.proto",
+var xxx_messageInfo_MsgPairForwardResponse proto.InternalMessageInfo
+
+type MsgUpdatePauser struct {
+	From      string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	NewPauser string `protobuf:"bytes,2,opt,name=new_pauser,json=newPauser,proto3" json:"new_pauser,omitempty"`
 }
 
-func (m *MsgUpdateOwner) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdatePauser) Reset()         { *m = MsgUpdatePauser{} }
+func (m *MsgUpdatePauser) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePauser) ProtoMessage()    {}
+func (*MsgUpdatePauser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{16}
+}
+func (m *MsgUpdatePauser) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePauser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePauser.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateOwner) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdatePauser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePauser.Merge(m, src)
+}
+func (m *MsgUpdatePauser) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePauser) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePauser.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewOwner) > 0 {
-		i -= len(m.NewOwner)
-		copy(dAtA[i:], m.NewOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgUpdatePauser proto.InternalMessageInfo
+
+func (m *MsgUpdatePauser) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdatePauser) GetNewPauser() string {
+	if m != nil {
+		return m.NewPauser
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUpdatePauserResponse struct {
 }
 
-func (m *MsgUpdateOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdatePauserResponse) Reset()         { *m = MsgUpdatePauserResponse{} }
+func (m *MsgUpdatePauserResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePauserResponse) ProtoMessage()    {}
+func (*MsgUpdatePauserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{17}
 }
-
-func (m *MsgAcceptOwner) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdatePauserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePauserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePauserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgAcceptOwner) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdatePauserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePauserResponse.Merge(m, src)
 }
-
-func (m *MsgAcceptOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgUpdatePauserResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgAcceptOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdatePauserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePauserResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAcceptOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgUpdatePauserResponse proto.InternalMessageInfo
 
-func (m *MsgAcceptOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgPauseRouting pauses routing globally, or for a single source domain if
+// global is false
+type MsgPauseRouting struct {
+	From         string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Global       bool   `protobuf:"varint,2,opt,name=global,proto3" json:"global,omitempty"`
+	SourceDomain uint32 `protobuf:"varint,3,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
 }
 
-func (m *MsgAddAllowedSourceDomainSender) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPauseRouting) Reset()         { *m = MsgPauseRouting{} }
+func (m *MsgPauseRouting) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseRouting) ProtoMessage()    {}
+func (*MsgPauseRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{18}
+}
+func (m *MsgPauseRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseRouting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgAddAllowedSourceDomainSender) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPauseRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseRouting.Merge(m, src)
+}
+func (m *MsgPauseRouting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseRouting.DiscardUnknown(m)
 }
 
-func (m *MsgAddAllowedSourceDomainSender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.DomainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DomainId))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_MsgPauseRouting proto.InternalMessageInfo
+
+func (m *MsgPauseRouting) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgPauseRouting) GetGlobal() bool {
+	if m != nil {
+		return m.Global
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *MsgAddAllowedSourceDomainSenderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPauseRouting) GetSourceDomain() uint32 {
+	if m != nil {
+		return m.SourceDomain
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgAddAllowedSourceDomainSenderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgPauseRoutingResponse struct {
 }
 
-func (m *MsgAddAllowedSourceDomainSenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgPauseRoutingResponse) Reset()         { *m = MsgPauseRoutingResponse{} }
+func (m *MsgPauseRoutingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseRoutingResponse) ProtoMessage()    {}
+func (*MsgPauseRoutingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{19}
 }
-
-func (m *MsgRemoveAllowedSourceDomainSender) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPauseRoutingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseRoutingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseRoutingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgPauseRoutingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseRoutingResponse.Merge(m, src)
+}
+func (m *MsgPauseRoutingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseRoutingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseRoutingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveAllowedSourceDomainSender) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgPauseRoutingResponse proto.InternalMessageInfo
+
+// MsgUnpauseRouting unpauses routing globally, or for a single source domain
+// if global is false, and releases the held forwards that are no longer paused
+type MsgUnpauseRouting struct {
+	From         string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Global       bool   `protobuf:"varint,2,opt,name=global,proto3" json:"global,omitempty"`
+	SourceDomain uint32 `protobuf:"varint,3,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
 }
 
-func (m *MsgRemoveAllowedSourceDomainSender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DomainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DomainId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgUnpauseRouting) Reset()         { *m = MsgUnpauseRouting{} }
+func (m *MsgUnpauseRouting) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseRouting) ProtoMessage()    {}
+func (*MsgUnpauseRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{20}
+}
+func (m *MsgUnpauseRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseRouting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUnpauseRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseRouting.Merge(m, src)
+}
+func (m *MsgUnpauseRouting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseRouting proto.InternalMessageInfo
+
+func (m *MsgUnpauseRouting) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRemoveAllowedSourceDomainSenderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnpauseRouting) GetGlobal() bool {
+	if m != nil {
+		return m.Global
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgRemoveAllowedSourceDomainSenderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnpauseRouting) GetSourceDomain() uint32 {
+	if m != nil {
+		return m.SourceDomain
+	}
+	return 0
 }
 
-func (m *MsgRemoveAllowedSourceDomainSenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgUnpauseRoutingResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgUnpauseRoutingResponse) Reset()         { *m = MsgUnpauseRoutingResponse{} }
+func (m *MsgUnpauseRoutingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseRoutingResponse) ProtoMessage()    {}
+func (*MsgUnpauseRoutingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{21}
 }
-func (m *MsgUpdateOwner) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUnpauseRoutingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseRoutingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseRoutingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUnpauseRoutingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseRoutingResponse.Merge(m, src)
+}
+func (m *MsgUnpauseRoutingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseRoutingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseRoutingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseRoutingResponse proto.InternalMessageInfo
+
+type MsgAddAllowedChannel struct {
+	From       string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Channel    string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	ChainLabel string `protobuf:"bytes,3,opt,name=chain_label,json=chainLabel,proto3" json:"chain_label,omitempty"`
+}
+
+func (m *MsgAddAllowedChannel) Reset()         { *m = MsgAddAllowedChannel{} }
+func (m *MsgAddAllowedChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAllowedChannel) ProtoMessage()    {}
+func (*MsgAddAllowedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{22}
+}
+func (m *MsgAddAllowedChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAllowedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAllowedChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgAddAllowedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAllowedChannel.Merge(m, src)
+}
+func (m *MsgAddAllowedChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAllowedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAllowedChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAllowedChannel proto.InternalMessageInfo
+
+func (m *MsgAddAllowedChannel) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return n
+	return ""
 }
 
-func (m *MsgUpdateOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddAllowedChannel) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgAcceptOwner) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddAllowedChannel) GetChainLabel() string {
+	if m != nil {
+		return m.ChainLabel
 	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+type MsgAddAllowedChannelResponse struct {
+}
+
+func (m *MsgAddAllowedChannelResponse) Reset()         { *m = MsgAddAllowedChannelResponse{} }
+func (m *MsgAddAllowedChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAllowedChannelResponse) ProtoMessage()    {}
+func (*MsgAddAllowedChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{23}
+}
+func (m *MsgAddAllowedChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAllowedChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAllowedChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgAddAllowedChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAllowedChannelResponse.Merge(m, src)
+}
+func (m *MsgAddAllowedChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAllowedChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAllowedChannelResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAcceptOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgAddAllowedChannelResponse proto.InternalMessageInfo
+
+type MsgRemoveAllowedChannel struct {
+	From    string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (m *MsgRemoveAllowedChannel) Reset()         { *m = MsgRemoveAllowedChannel{} }
+func (m *MsgRemoveAllowedChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAllowedChannel) ProtoMessage()    {}
+func (*MsgRemoveAllowedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{24}
+}
+func (m *MsgRemoveAllowedChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAllowedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAllowedChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgRemoveAllowedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAllowedChannel.Merge(m, src)
+}
+func (m *MsgRemoveAllowedChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAllowedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAllowedChannel.DiscardUnknown(m)
 }
 
-func (m *MsgAddAllowedSourceDomainSender) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRemoveAllowedChannel proto.InternalMessageInfo
+
+func (m *MsgRemoveAllowedChannel) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgRemoveAllowedChannel) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	if m.DomainId != 0 {
-		n += 1 + sovTx(uint64(m.DomainId))
+	return ""
+}
+
+type MsgRemoveAllowedChannelResponse struct {
+}
+
+func (m *MsgRemoveAllowedChannelResponse) Reset()         { *m = MsgRemoveAllowedChannelResponse{} }
+func (m *MsgRemoveAllowedChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAllowedChannelResponse) ProtoMessage()    {}
+func (*MsgRemoveAllowedChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{25}
+}
+func (m *MsgRemoveAllowedChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAllowedChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAllowedChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgRemoveAllowedChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAllowedChannelResponse.Merge(m, src)
+}
+func (m *MsgRemoveAllowedChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAllowedChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAllowedChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAllowedChannelResponse proto.InternalMessageInfo
+
+type MsgSetSourceDomainRateLimit struct {
+	From         string                                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	SourceDomain uint32                                 `protobuf:"varint,2,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
+	WindowBlocks uint64                                 `protobuf:"varint,3,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	MaxAmount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_amount,json=maxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount"`
+}
+
+func (m *MsgSetSourceDomainRateLimit) Reset()         { *m = MsgSetSourceDomainRateLimit{} }
+func (m *MsgSetSourceDomainRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSourceDomainRateLimit) ProtoMessage()    {}
+func (*MsgSetSourceDomainRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{26}
+}
+func (m *MsgSetSourceDomainRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSourceDomainRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSourceDomainRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSourceDomainRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSourceDomainRateLimit.Merge(m, src)
+}
+func (m *MsgSetSourceDomainRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSourceDomainRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSourceDomainRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSourceDomainRateLimit proto.InternalMessageInfo
+
+func (m *MsgSetSourceDomainRateLimit) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgSetSourceDomainRateLimit) GetSourceDomain() uint32 {
+	if m != nil {
+		return m.SourceDomain
+	}
+	return 0
+}
+
+func (m *MsgSetSourceDomainRateLimit) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+type MsgSetSourceDomainRateLimitResponse struct {
+}
+
+func (m *MsgSetSourceDomainRateLimitResponse) Reset()         { *m = MsgSetSourceDomainRateLimitResponse{} }
+func (m *MsgSetSourceDomainRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSourceDomainRateLimitResponse) ProtoMessage()    {}
+func (*MsgSetSourceDomainRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{27}
+}
+func (m *MsgSetSourceDomainRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSourceDomainRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSourceDomainRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSourceDomainRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSourceDomainRateLimitResponse.Merge(m, src)
+}
+func (m *MsgSetSourceDomainRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSourceDomainRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSourceDomainRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSourceDomainRateLimitResponse proto.InternalMessageInfo
+
+type MsgRemoveSourceDomainRateLimit struct {
+	From         string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	SourceDomain uint32 `protobuf:"varint,2,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
+}
+
+func (m *MsgRemoveSourceDomainRateLimit) Reset()         { *m = MsgRemoveSourceDomainRateLimit{} }
+func (m *MsgRemoveSourceDomainRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSourceDomainRateLimit) ProtoMessage()    {}
+func (*MsgRemoveSourceDomainRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{28}
+}
+func (m *MsgRemoveSourceDomainRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSourceDomainRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSourceDomainRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSourceDomainRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSourceDomainRateLimit.Merge(m, src)
+}
+func (m *MsgRemoveSourceDomainRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSourceDomainRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSourceDomainRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSourceDomainRateLimit proto.InternalMessageInfo
+
+func (m *MsgRemoveSourceDomainRateLimit) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgRemoveSourceDomainRateLimit) GetSourceDomain() uint32 {
+	if m != nil {
+		return m.SourceDomain
+	}
+	return 0
+}
+
+type MsgRemoveSourceDomainRateLimitResponse struct {
+}
+
+func (m *MsgRemoveSourceDomainRateLimitResponse) Reset() {
+	*m = MsgRemoveSourceDomainRateLimitResponse{}
+}
+func (m *MsgRemoveSourceDomainRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSourceDomainRateLimitResponse) ProtoMessage()    {}
+func (*MsgRemoveSourceDomainRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{29}
+}
+func (m *MsgRemoveSourceDomainRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSourceDomainRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSourceDomainRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSourceDomainRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSourceDomainRateLimitResponse.Merge(m, src)
+}
+func (m *MsgRemoveSourceDomainRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSourceDomainRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSourceDomainRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSourceDomainRateLimitResponse proto.InternalMessageInfo
+
+type MsgSetChannelRateLimit struct {
+	From         string                                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Channel      string                                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	WindowBlocks uint64                                 `protobuf:"varint,3,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	MaxAmount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_amount,json=maxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount"`
+}
+
+func (m *MsgSetChannelRateLimit) Reset()         { *m = MsgSetChannelRateLimit{} }
+func (m *MsgSetChannelRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChannelRateLimit) ProtoMessage()    {}
+func (*MsgSetChannelRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{30}
+}
+func (m *MsgSetChannelRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChannelRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChannelRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetChannelRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChannelRateLimit.Merge(m, src)
+}
+func (m *MsgSetChannelRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChannelRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChannelRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetChannelRateLimit proto.InternalMessageInfo
+
+func (m *MsgSetChannelRateLimit) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgSetChannelRateLimit) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *MsgSetChannelRateLimit) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+type MsgSetChannelRateLimitResponse struct {
+}
+
+func (m *MsgSetChannelRateLimitResponse) Reset()         { *m = MsgSetChannelRateLimitResponse{} }
+func (m *MsgSetChannelRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChannelRateLimitResponse) ProtoMessage()    {}
+func (*MsgSetChannelRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{31}
+}
+func (m *MsgSetChannelRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChannelRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChannelRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetChannelRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChannelRateLimitResponse.Merge(m, src)
+}
+func (m *MsgSetChannelRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChannelRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChannelRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetChannelRateLimitResponse proto.InternalMessageInfo
+
+type MsgRemoveChannelRateLimit struct {
+	From    string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (m *MsgRemoveChannelRateLimit) Reset()         { *m = MsgRemoveChannelRateLimit{} }
+func (m *MsgRemoveChannelRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveChannelRateLimit) ProtoMessage()    {}
+func (*MsgRemoveChannelRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{32}
+}
+func (m *MsgRemoveChannelRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveChannelRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveChannelRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveChannelRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveChannelRateLimit.Merge(m, src)
+}
+func (m *MsgRemoveChannelRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveChannelRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveChannelRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveChannelRateLimit proto.InternalMessageInfo
+
+func (m *MsgRemoveChannelRateLimit) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgRemoveChannelRateLimit) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+type MsgRemoveChannelRateLimitResponse struct {
+}
+
+func (m *MsgRemoveChannelRateLimitResponse) Reset()         { *m = MsgRemoveChannelRateLimitResponse{} }
+func (m *MsgRemoveChannelRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveChannelRateLimitResponse) ProtoMessage()    {}
+func (*MsgRemoveChannelRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beacb3e51b9928bf, []int{33}
+}
+func (m *MsgRemoveChannelRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveChannelRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveChannelRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveChannelRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveChannelRateLimitResponse.Merge(m, src)
+}
+func (m *MsgRemoveChannelRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveChannelRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveChannelRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveChannelRateLimitResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateOwner)(nil), "noble.router.MsgUpdateOwner")
+	proto.RegisterType((*MsgUpdateOwnerResponse)(nil), "noble.router.MsgUpdateOwnerResponse")
+	proto.RegisterType((*MsgAcceptOwner)(nil), "noble.router.MsgAcceptOwner")
+	proto.RegisterType((*MsgAcceptOwnerResponse)(nil), "noble.router.MsgAcceptOwnerResponse")
+	proto.RegisterType((*MsgSetOwner)(nil), "noble.router.MsgSetOwner")
+	proto.RegisterType((*MsgSetOwnerResponse)(nil), "noble.router.MsgSetOwnerResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "noble.router.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "noble.router.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgAddAllowedSourceDomainSender)(nil), "noble.router.MsgAddAllowedSourceDomainSender")
+	proto.RegisterType((*MsgAddAllowedSourceDomainSenderResponse)(nil), "noble.router.MsgAddAllowedSourceDomainSenderResponse")
+	proto.RegisterType((*MsgRemoveAllowedSourceDomainSender)(nil), "noble.router.MsgRemoveAllowedSourceDomainSender")
+	proto.RegisterType((*MsgRemoveAllowedSourceDomainSenderResponse)(nil), "noble.router.MsgRemoveAllowedSourceDomainSenderResponse")
+	proto.RegisterType((*MsgClaimFailedForward)(nil), "noble.router.MsgClaimFailedForward")
+	proto.RegisterType((*MsgClaimFailedForwardResponse)(nil), "noble.router.MsgClaimFailedForwardResponse")
+	proto.RegisterType((*MsgPairForward)(nil), "noble.router.MsgPairForward")
+	proto.RegisterType((*MsgPairForwardResponse)(nil), "noble.router.MsgPairForwardResponse")
+	proto.RegisterType((*MsgUpdatePauser)(nil), "noble.router.MsgUpdatePauser")
+	proto.RegisterType((*MsgUpdatePauserResponse)(nil), "noble.router.MsgUpdatePauserResponse")
+	proto.RegisterType((*MsgPauseRouting)(nil), "noble.router.MsgPauseRouting")
+	proto.RegisterType((*MsgPauseRoutingResponse)(nil), "noble.router.MsgPauseRoutingResponse")
+	proto.RegisterType((*MsgUnpauseRouting)(nil), "noble.router.MsgUnpauseRouting")
+	proto.RegisterType((*MsgUnpauseRoutingResponse)(nil), "noble.router.MsgUnpauseRoutingResponse")
+	proto.RegisterType((*MsgAddAllowedChannel)(nil), "noble.router.MsgAddAllowedChannel")
+	proto.RegisterType((*MsgAddAllowedChannelResponse)(nil), "noble.router.MsgAddAllowedChannelResponse")
+	proto.RegisterType((*MsgRemoveAllowedChannel)(nil), "noble.router.MsgRemoveAllowedChannel")
+	proto.RegisterType((*MsgRemoveAllowedChannelResponse)(nil), "noble.router.MsgRemoveAllowedChannelResponse")
+	proto.RegisterType((*MsgSetSourceDomainRateLimit)(nil), "noble.router.MsgSetSourceDomainRateLimit")
+	proto.RegisterType((*MsgSetSourceDomainRateLimitResponse)(nil), "noble.router.MsgSetSourceDomainRateLimitResponse")
+	proto.RegisterType((*MsgRemoveSourceDomainRateLimit)(nil), "noble.router.MsgRemoveSourceDomainRateLimit")
+	proto.RegisterType((*MsgRemoveSourceDomainRateLimitResponse)(nil), "noble.router.MsgRemoveSourceDomainRateLimitResponse")
+	proto.RegisterType((*MsgSetChannelRateLimit)(nil), "noble.router.MsgSetChannelRateLimit")
+	proto.RegisterType((*MsgSetChannelRateLimitResponse)(nil), "noble.router.MsgSetChannelRateLimitResponse")
+	proto.RegisterType((*MsgRemoveChannelRateLimit)(nil), "noble.router.MsgRemoveChannelRateLimit")
+	proto.RegisterType((*MsgRemoveChannelRateLimitResponse)(nil), "noble.router.MsgRemoveChannelRateLimitResponse")
+}
+
+func init() { proto.RegisterFile("router/tx.proto", fileDescriptor_beacb3e51b9928bf) }
+
+var fileDescriptor_beacb3e51b9928bf = []byte{
+	// 1066 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x35, 0x63, 0xd7, 0xb1, 0xc6, 0x72, 0x82, 0xd0, 0x8a, 0x23, 0xd3, 0xb6, 0x64, 0x53, 0x52,
+	0xec, 0xa4, 0x96, 0xd4, 0xba, 0x2d, 0x50, 0xa0, 0x27, 0x3b, 0x41, 0x1a, 0x03, 0x11, 0x9a, 0xd2,
+	0xcd, 0xa1, 0xb9, 0x08, 0x2b, 0x72, 0x4d, 0x13, 0x21, 0x77, 0x05, 0x2e, 0x65, 0x29, 0xc7, 0x02,
+	0xbd, 0x16, 0xe8, 0xef, 0xe9, 0x2f, 0x08, 0x7a, 0xca, 0xa5, 0x40, 0xd1, 0x43, 0x50, 0xd8, 0x7f,
+	0xa4, 0xe0, 0x92, 0xda, 0x90, 0xe2, 0x87, 0xe4, 0xa4, 0x46, 0x4e, 0x16, 0x67, 0xde, 0xbe, 0xf7,
+	0x66, 0x39, 0xcb, 0x1d, 0x18, 0x6e, 0xbb, 0x74, 0xe0, 0x61, 0xb7, 0xed, 0x8d, 0x5a, 0x7d, 0x97,
+	0x7a, 0x54, 0x2e, 0x12, 0xda, 0xb3, 0x71, 0x2b, 0x08, 0x2b, 0x25, 0x93, 0x9a, 0x94, 0x27, 0xda,
+	0xfe, 0xaf, 0x00, 0xa3, 0xac, 0x86, 0x8b, 0xfa, 0xc8, 0x45, 0x0e, 0x0b, 0x82, 0xea, 0x21, 0xdc,
+	0xea, 0x30, 0xf3, 0x45, 0xdf, 0x40, 0x1e, 0xfe, 0x61, 0x48, 0xb0, 0x2b, 0xcb, 0xb0, 0x70, 0xea,
+	0x52, 0xa7, 0x2c, 0x6d, 0x4b, 0x7b, 0x05, 0x8d, 0xff, 0x96, 0x37, 0xa0, 0x40, 0xf0, 0xb0, 0x4b,
+	0x7d, 0x40, 0xf9, 0x06, 0x4f, 0x2c, 0x11, 0x3c, 0xe4, 0x0b, 0xd4, 0x32, 0xac, 0xc5, 0x29, 0x34,
+	0xcc, 0xfa, 0x94, 0x30, 0xac, 0xd6, 0x39, 0xf9, 0xa1, 0xae, 0xe3, 0xbe, 0x97, 0x49, 0x1e, 0xae,
+	0x8f, 0xa0, 0xc4, 0xfa, 0xa7, 0xb0, 0xdc, 0x61, 0xe6, 0x09, 0x0e, 0x17, 0x6f, 0x42, 0x01, 0x0d,
+	0xbc, 0x33, 0xea, 0x5a, 0xde, 0xeb, 0x90, 0xe1, 0x7d, 0x20, 0xdf, 0xe3, 0x5d, 0x58, 0x8d, 0x30,
+	0x09, 0x01, 0x1d, 0x6e, 0x0b, 0xeb, 0xcf, 0xf9, 0xb6, 0x4c, 0x11, 0x39, 0x80, 0xc5, 0x60, 0xfb,
+	0xb8, 0xc2, 0xf2, 0x41, 0xa9, 0x15, 0xdd, 0xf8, 0x56, 0xc0, 0x71, 0xb4, 0xf0, 0xe6, 0x5d, 0x75,
+	0x4e, 0x0b, 0x91, 0xea, 0x3a, 0xdc, 0x9b, 0x10, 0x11, 0xfa, 0x36, 0x54, 0xfd, 0xd2, 0x0d, 0xe3,
+	0xd0, 0xb6, 0xe9, 0x10, 0x1b, 0x27, 0x74, 0xe0, 0xea, 0xf8, 0x31, 0x75, 0x90, 0x45, 0x4e, 0x30,
+	0x31, 0xb2, 0x5f, 0x87, 0xc1, 0x31, 0x5d, 0xcb, 0xe0, 0x46, 0x56, 0xb4, 0xa5, 0x20, 0x70, 0x6c,
+	0xc8, 0x65, 0xb8, 0x89, 0x0c, 0xc3, 0xc5, 0x8c, 0x95, 0xe7, 0xb7, 0xa5, 0xbd, 0xa2, 0x36, 0x7e,
+	0x54, 0x1f, 0xc0, 0xee, 0x14, 0x35, 0x61, 0x8c, 0x82, 0xda, 0x61, 0xa6, 0x86, 0x1d, 0x7a, 0x8e,
+	0x3f, 0xc2, 0xdb, 0x7c, 0xb6, 0xb7, 0x1b, 0x71, 0x6f, 0xfb, 0xf0, 0x70, 0xba, 0xa0, 0xb0, 0x77,
+	0x0a, 0x77, 0x3b, 0xcc, 0x7c, 0x64, 0x23, 0xcb, 0x79, 0x82, 0x2c, 0x1b, 0x1b, 0x4f, 0xa8, 0x3b,
+	0x44, 0xae, 0x91, 0xea, 0xa8, 0x06, 0x2b, 0x8c, 0x53, 0x75, 0x03, 0x1f, 0xe1, 0x8e, 0x15, 0x59,
+	0x84, 0x5f, 0x2e, 0xc1, 0x67, 0x84, 0x12, 0x1d, 0x73, 0xcb, 0x0b, 0x5a, 0xf0, 0xa0, 0x56, 0x61,
+	0x2b, 0x55, 0x47, 0x18, 0xe9, 0xf2, 0x0e, 0x7f, 0x8e, 0x2c, 0xf7, 0x9a, 0x1c, 0x04, 0x87, 0x23,
+	0x22, 0x20, 0xa4, 0x1f, 0xc7, 0x7a, 0x77, 0xc0, 0x32, 0xde, 0xc7, 0x16, 0x80, 0x7f, 0x2c, 0xfa,
+	0x1c, 0x11, 0x9e, 0x0b, 0xff, 0xa0, 0x04, 0x4b, 0x26, 0x9a, 0xd3, 0x0f, 0x09, 0x81, 0x1e, 0x17,
+	0xe0, 0x41, 0x8d, 0x0e, 0x3c, 0x8b, 0x98, 0xa9, 0x02, 0x6b, 0xb0, 0x68, 0xda, 0xb4, 0x87, 0x6c,
+	0x4e, 0xbe, 0xa4, 0x85, 0x4f, 0xc9, 0xa2, 0xe7, 0x93, 0x45, 0x87, 0xf2, 0x51, 0x0d, 0x21, 0x6f,
+	0xc0, 0x1d, 0xdf, 0x19, 0xe9, 0x5f, 0xab, 0x81, 0x0d, 0x58, 0x4f, 0xa8, 0x08, 0x0b, 0x18, 0x4a,
+	0xb1, 0x03, 0xf3, 0xe8, 0x0c, 0x11, 0x82, 0xed, 0x54, 0x17, 0x65, 0xb8, 0xa9, 0x07, 0xe9, 0x70,
+	0x93, 0xc7, 0x8f, 0x72, 0x15, 0x96, 0xf5, 0x33, 0xff, 0x40, 0xd8, 0xa8, 0x87, 0x6d, 0xee, 0xa2,
+	0xa0, 0x01, 0x0f, 0x3d, 0xf3, 0x23, 0x6a, 0x05, 0x36, 0xd3, 0x64, 0x84, 0x8d, 0xef, 0xe1, 0xde,
+	0xe4, 0xd9, 0xf8, 0x20, 0x27, 0xea, 0x0e, 0x54, 0x33, 0x88, 0x84, 0xd6, 0x9f, 0x12, 0x6c, 0x04,
+	0x5f, 0xca, 0xe8, 0xf1, 0xd3, 0x90, 0x87, 0x9f, 0x59, 0x8e, 0xe5, 0x7d, 0x78, 0x7b, 0xd7, 0x60,
+	0x65, 0x68, 0x11, 0x83, 0x0e, 0xbb, 0x3d, 0x9b, 0xea, 0xaf, 0x58, 0xd8, 0xe6, 0xc5, 0x20, 0x78,
+	0xc4, 0x63, 0x72, 0x07, 0xc0, 0x41, 0xa3, 0x2e, 0x72, 0xe8, 0x80, 0x78, 0xe5, 0x05, 0x5f, 0xe3,
+	0xa8, 0xe5, 0x7f, 0x4c, 0xff, 0x79, 0x57, 0xbd, 0x6f, 0x5a, 0xde, 0xd9, 0xa0, 0xd7, 0xd2, 0xa9,
+	0xd3, 0xd6, 0x29, 0x73, 0x28, 0x0b, 0xff, 0x34, 0x99, 0xf1, 0xaa, 0xed, 0xbd, 0xee, 0x63, 0xd6,
+	0x3a, 0x26, 0x9e, 0x56, 0x70, 0xd0, 0xe8, 0x90, 0x13, 0xa8, 0x0d, 0xa8, 0xe5, 0xd4, 0x22, 0x6a,
+	0xfe, 0x19, 0x2a, 0x62, 0x5b, 0xfe, 0xdf, 0xaa, 0xd5, 0x3d, 0xb8, 0x9f, 0x4f, 0x2d, 0x4c, 0xfc,
+	0x21, 0xf1, 0x93, 0x7e, 0x82, 0xbd, 0xf1, 0x2b, 0xc9, 0x55, 0xcf, 0x6e, 0xb7, 0x4f, 0xb1, 0xd1,
+	0xdb, 0x50, 0x49, 0xf7, 0x2e, 0xca, 0x3b, 0x86, 0x75, 0xb1, 0x11, 0x1f, 0x57, 0xa0, 0x5a, 0x83,
+	0x9d, 0x4c, 0xaa, 0xb1, 0xde, 0xc1, 0x5f, 0x2b, 0x30, 0xdf, 0x61, 0xa6, 0xfc, 0x23, 0x2c, 0x47,
+	0x87, 0x9b, 0xcd, 0xf8, 0x7d, 0x1d, 0x9f, 0x5b, 0x94, 0x7a, 0x5e, 0x76, 0x4c, 0xed, 0x53, 0x46,
+	0x47, 0x9a, 0x24, 0x65, 0x24, 0xab, 0xd4, 0xf3, 0xb2, 0x82, 0xf2, 0x29, 0x2c, 0x89, 0x29, 0x67,
+	0x3d, 0xb1, 0x62, 0x9c, 0x52, 0x76, 0x32, 0x53, 0x82, 0xe9, 0x27, 0x28, 0xc6, 0xc6, 0x99, 0xad,
+	0x8c, 0x92, 0x82, 0xb4, 0xd2, 0xc8, 0x4d, 0x0b, 0xd6, 0x5f, 0x25, 0xd8, 0xcc, 0x9d, 0x52, 0x9a,
+	0xc9, 0x32, 0x73, 0xe0, 0xca, 0x37, 0x57, 0x82, 0x0b, 0x1b, 0xbf, 0x49, 0x50, 0x9d, 0x36, 0x93,
+	0x7c, 0x91, 0xa0, 0x9e, 0xb2, 0x42, 0xf9, 0xf6, 0xaa, 0x2b, 0x84, 0x9f, 0x53, 0x90, 0x53, 0x66,
+	0x90, 0x5a, 0x82, 0x2f, 0x09, 0x52, 0x3e, 0x9f, 0x01, 0x14, 0xed, 0xb8, 0xe8, 0x88, 0x91, 0xec,
+	0xb8, 0x48, 0x56, 0xa9, 0xe7, 0x65, 0xd3, 0xfa, 0x84, 0x8f, 0x0e, 0xd9, 0x7d, 0xe2, 0xa7, 0x95,
+	0x46, 0x6e, 0x3a, 0xca, 0x1a, 0x9b, 0x17, 0xb6, 0x52, 0xbc, 0xbc, 0x4f, 0x2b, 0x8d, 0xdc, 0xb4,
+	0x60, 0x7d, 0x09, 0xb7, 0x26, 0xc6, 0x80, 0x6a, 0xd2, 0x4e, 0x0c, 0xa0, 0xec, 0x4e, 0x01, 0x08,
+	0x6e, 0x1d, 0xee, 0x24, 0xef, 0x77, 0x35, 0xa7, 0x3d, 0x43, 0x8c, 0xf2, 0x70, 0x3a, 0x46, 0x88,
+	0xd8, 0x50, 0x4a, 0xbd, 0xbd, 0x1b, 0xf9, 0x9d, 0x37, 0x96, 0x6a, 0xce, 0x04, 0x13, 0x6a, 0x23,
+	0x28, 0x67, 0x5e, 0xdf, 0x0f, 0xd2, 0xbe, 0x20, 0xa9, 0x50, 0xe5, 0xcb, 0x99, 0xa1, 0x42, 0xf9,
+	0x17, 0x09, 0x36, 0xf2, 0xae, 0xd1, 0xfd, 0x8c, 0x42, 0xd2, 0x0d, 0x7c, 0x7d, 0x15, 0xb4, 0xf0,
+	0x60, 0xc1, 0x6a, 0xda, 0x1d, 0x5a, 0x4f, 0xab, 0x66, 0x12, 0xa5, 0xec, 0xcf, 0x82, 0x12, 0x52,
+	0x2e, 0xac, 0x65, 0x5c, 0x68, 0xbb, 0x19, 0xd6, 0x13, 0x82, 0xed, 0x19, 0x81, 0x63, 0xcd, 0xa3,
+	0x17, 0x6f, 0x2e, 0x2a, 0xd2, 0xdb, 0x8b, 0x8a, 0xf4, 0xef, 0x45, 0x45, 0xfa, 0xfd, 0xb2, 0x32,
+	0xf7, 0xf6, 0xb2, 0x32, 0xf7, 0xf7, 0x65, 0x65, 0xee, 0xe5, 0x77, 0x91, 0x6b, 0x9b, 0x79, 0x2e,
+	0x22, 0x26, 0xb6, 0xe9, 0x39, 0x6e, 0x9e, 0x63, 0xe2, 0x0d, 0x5c, 0xcc, 0xda, 0x5c, 0xa9, 0x19,
+	0xfe, 0x13, 0x60, 0xd4, 0x0e, 0x7f, 0xf0, 0xfb, 0xbc, 0xb7, 0xc8, 0xff, 0x1b, 0xf0, 0xd5, 0x7f,
+	0x03, 0x00, 0x99, 0x03, 0x2f, 0xe3, 0x59, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	UpdateOwner(ctx context.Context, in *MsgUpdateOwner, opts ...grpc.CallOption) (*MsgUpdateOwnerResponse, error)
+	AcceptOwner(ctx context.Context, in *MsgAcceptOwner, opts ...grpc.CallOption) (*MsgAcceptOwnerResponse, error)
+	SetOwner(ctx context.Context, in *MsgSetOwner, opts ...grpc.CallOption) (*MsgSetOwnerResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	AddAllowedSourceDomainSender(ctx context.Context, in *MsgAddAllowedSourceDomainSender, opts ...grpc.CallOption) (*MsgAddAllowedSourceDomainSenderResponse, error)
+	RemoveAllowedSourceDomainSender(ctx context.Context, in *MsgRemoveAllowedSourceDomainSender, opts ...grpc.CallOption) (*MsgRemoveAllowedSourceDomainSenderResponse, error)
+	ClaimFailedForward(ctx context.Context, in *MsgClaimFailedForward, opts ...grpc.CallOption) (*MsgClaimFailedForwardResponse, error)
+	PairForward(ctx context.Context, in *MsgPairForward, opts ...grpc.CallOption) (*MsgPairForwardResponse, error)
+	UpdatePauser(ctx context.Context, in *MsgUpdatePauser, opts ...grpc.CallOption) (*MsgUpdatePauserResponse, error)
+	PauseRouting(ctx context.Context, in *MsgPauseRouting, opts ...grpc.CallOption) (*MsgPauseRoutingResponse, error)
+	UnpauseRouting(ctx context.Context, in *MsgUnpauseRouting, opts ...grpc.CallOption) (*MsgUnpauseRoutingResponse, error)
+	AddAllowedChannel(ctx context.Context, in *MsgAddAllowedChannel, opts ...grpc.CallOption) (*MsgAddAllowedChannelResponse, error)
+	RemoveAllowedChannel(ctx context.Context, in *MsgRemoveAllowedChannel, opts ...grpc.CallOption) (*MsgRemoveAllowedChannelResponse, error)
+	SetSourceDomainRateLimit(ctx context.Context, in *MsgSetSourceDomainRateLimit, opts ...grpc.CallOption) (*MsgSetSourceDomainRateLimitResponse, error)
+	RemoveSourceDomainRateLimit(ctx context.Context, in *MsgRemoveSourceDomainRateLimit, opts ...grpc.CallOption) (*MsgRemoveSourceDomainRateLimitResponse, error)
+	SetChannelRateLimit(ctx context.Context, in *MsgSetChannelRateLimit, opts ...grpc.CallOption) (*MsgSetChannelRateLimitResponse, error)
+	RemoveChannelRateLimit(ctx context.Context, in *MsgRemoveChannelRateLimit, opts ...grpc.CallOption) (*MsgRemoveChannelRateLimitResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateOwner(ctx context.Context, in *MsgUpdateOwner, opts ...grpc.CallOption) (*MsgUpdateOwnerResponse, error) {
+	out := new(MsgUpdateOwnerResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/UpdateOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptOwner(ctx context.Context, in *MsgAcceptOwner, opts ...grpc.CallOption) (*MsgAcceptOwnerResponse, error) {
+	out := new(MsgAcceptOwnerResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/AcceptOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetOwner(ctx context.Context, in *MsgSetOwner, opts ...grpc.CallOption) (*MsgSetOwnerResponse, error) {
+	out := new(MsgSetOwnerResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/SetOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAllowedSourceDomainSender(ctx context.Context, in *MsgAddAllowedSourceDomainSender, opts ...grpc.CallOption) (*MsgAddAllowedSourceDomainSenderResponse, error) {
+	out := new(MsgAddAllowedSourceDomainSenderResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/AddAllowedSourceDomainSender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAllowedSourceDomainSender(ctx context.Context, in *MsgRemoveAllowedSourceDomainSender, opts ...grpc.CallOption) (*MsgRemoveAllowedSourceDomainSenderResponse, error) {
+	out := new(MsgRemoveAllowedSourceDomainSenderResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/RemoveAllowedSourceDomainSender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimFailedForward(ctx context.Context, in *MsgClaimFailedForward, opts ...grpc.CallOption) (*MsgClaimFailedForwardResponse, error) {
+	out := new(MsgClaimFailedForwardResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/ClaimFailedForward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PairForward(ctx context.Context, in *MsgPairForward, opts ...grpc.CallOption) (*MsgPairForwardResponse, error) {
+	out := new(MsgPairForwardResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/PairForward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePauser(ctx context.Context, in *MsgUpdatePauser, opts ...grpc.CallOption) (*MsgUpdatePauserResponse, error) {
+	out := new(MsgUpdatePauserResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/UpdatePauser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseRouting(ctx context.Context, in *MsgPauseRouting, opts ...grpc.CallOption) (*MsgPauseRoutingResponse, error) {
+	out := new(MsgPauseRoutingResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/PauseRouting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpauseRouting(ctx context.Context, in *MsgUnpauseRouting, opts ...grpc.CallOption) (*MsgUnpauseRoutingResponse, error) {
+	out := new(MsgUnpauseRoutingResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/UnpauseRouting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAllowedChannel(ctx context.Context, in *MsgAddAllowedChannel, opts ...grpc.CallOption) (*MsgAddAllowedChannelResponse, error) {
+	out := new(MsgAddAllowedChannelResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/AddAllowedChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAllowedChannel(ctx context.Context, in *MsgRemoveAllowedChannel, opts ...grpc.CallOption) (*MsgRemoveAllowedChannelResponse, error) {
+	out := new(MsgRemoveAllowedChannelResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/RemoveAllowedChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSourceDomainRateLimit(ctx context.Context, in *MsgSetSourceDomainRateLimit, opts ...grpc.CallOption) (*MsgSetSourceDomainRateLimitResponse, error) {
+	out := new(MsgSetSourceDomainRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/SetSourceDomainRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveSourceDomainRateLimit(ctx context.Context, in *MsgRemoveSourceDomainRateLimit, opts ...grpc.CallOption) (*MsgRemoveSourceDomainRateLimitResponse, error) {
+	out := new(MsgRemoveSourceDomainRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/RemoveSourceDomainRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetChannelRateLimit(ctx context.Context, in *MsgSetChannelRateLimit, opts ...grpc.CallOption) (*MsgSetChannelRateLimitResponse, error) {
+	out := new(MsgSetChannelRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/SetChannelRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveChannelRateLimit(ctx context.Context, in *MsgRemoveChannelRateLimit, opts ...grpc.CallOption) (*MsgRemoveChannelRateLimitResponse, error) {
+	out := new(MsgRemoveChannelRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/noble.router.Msg/RemoveChannelRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	UpdateOwner(context.Context, *MsgUpdateOwner) (*MsgUpdateOwnerResponse, error)
+	AcceptOwner(context.Context, *MsgAcceptOwner) (*MsgAcceptOwnerResponse, error)
+	SetOwner(context.Context, *MsgSetOwner) (*MsgSetOwnerResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	AddAllowedSourceDomainSender(context.Context, *MsgAddAllowedSourceDomainSender) (*MsgAddAllowedSourceDomainSenderResponse, error)
+	RemoveAllowedSourceDomainSender(context.Context, *MsgRemoveAllowedSourceDomainSender) (*MsgRemoveAllowedSourceDomainSenderResponse, error)
+	ClaimFailedForward(context.Context, *MsgClaimFailedForward) (*MsgClaimFailedForwardResponse, error)
+	PairForward(context.Context, *MsgPairForward) (*MsgPairForwardResponse, error)
+	UpdatePauser(context.Context, *MsgUpdatePauser) (*MsgUpdatePauserResponse, error)
+	PauseRouting(context.Context, *MsgPauseRouting) (*MsgPauseRoutingResponse, error)
+	UnpauseRouting(context.Context, *MsgUnpauseRouting) (*MsgUnpauseRoutingResponse, error)
+	AddAllowedChannel(context.Context, *MsgAddAllowedChannel) (*MsgAddAllowedChannelResponse, error)
+	RemoveAllowedChannel(context.Context, *MsgRemoveAllowedChannel) (*MsgRemoveAllowedChannelResponse, error)
+	SetSourceDomainRateLimit(context.Context, *MsgSetSourceDomainRateLimit) (*MsgSetSourceDomainRateLimitResponse, error)
+	RemoveSourceDomainRateLimit(context.Context, *MsgRemoveSourceDomainRateLimit) (*MsgRemoveSourceDomainRateLimitResponse, error)
+	SetChannelRateLimit(context.Context, *MsgSetChannelRateLimit) (*MsgSetChannelRateLimitResponse, error)
+	RemoveChannelRateLimit(context.Context, *MsgRemoveChannelRateLimit) (*MsgRemoveChannelRateLimitResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateOwner(ctx context.Context, req *MsgUpdateOwner) (*MsgUpdateOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOwner not implemented")
+}
+func (*UnimplementedMsgServer) AcceptOwner(ctx context.Context, req *MsgAcceptOwner) (*MsgAcceptOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwner not implemented")
+}
+func (*UnimplementedMsgServer) SetOwner(ctx context.Context, req *MsgSetOwner) (*MsgSetOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOwner not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) AddAllowedSourceDomainSender(ctx context.Context, req *MsgAddAllowedSourceDomainSender) (*MsgAddAllowedSourceDomainSenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedSourceDomainSender not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAllowedSourceDomainSender(ctx context.Context, req *MsgRemoveAllowedSourceDomainSender) (*MsgRemoveAllowedSourceDomainSenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedSourceDomainSender not implemented")
+}
+func (*UnimplementedMsgServer) ClaimFailedForward(ctx context.Context, req *MsgClaimFailedForward) (*MsgClaimFailedForwardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimFailedForward not implemented")
+}
+func (*UnimplementedMsgServer) PairForward(ctx context.Context, req *MsgPairForward) (*MsgPairForwardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PairForward not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePauser(ctx context.Context, req *MsgUpdatePauser) (*MsgUpdatePauserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePauser not implemented")
+}
+func (*UnimplementedMsgServer) PauseRouting(ctx context.Context, req *MsgPauseRouting) (*MsgPauseRoutingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseRouting not implemented")
+}
+func (*UnimplementedMsgServer) UnpauseRouting(ctx context.Context, req *MsgUnpauseRouting) (*MsgUnpauseRoutingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseRouting not implemented")
+}
+func (*UnimplementedMsgServer) AddAllowedChannel(ctx context.Context, req *MsgAddAllowedChannel) (*MsgAddAllowedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedChannel not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAllowedChannel(ctx context.Context, req *MsgRemoveAllowedChannel) (*MsgRemoveAllowedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedChannel not implemented")
+}
+func (*UnimplementedMsgServer) SetSourceDomainRateLimit(ctx context.Context, req *MsgSetSourceDomainRateLimit) (*MsgSetSourceDomainRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSourceDomainRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) RemoveSourceDomainRateLimit(ctx context.Context, req *MsgRemoveSourceDomainRateLimit) (*MsgRemoveSourceDomainRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSourceDomainRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) SetChannelRateLimit(ctx context.Context, req *MsgSetChannelRateLimit) (*MsgSetChannelRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) RemoveChannelRateLimit(ctx context.Context, req *MsgRemoveChannelRateLimit) (*MsgRemoveChannelRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChannelRateLimit not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOwner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/UpdateOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOwner(ctx, req.(*MsgUpdateOwner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptOwner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/AcceptOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptOwner(ctx, req.(*MsgAcceptOwner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOwner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/SetOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOwner(ctx, req.(*MsgSetOwner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAllowedSourceDomainSender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAllowedSourceDomainSender)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAllowedSourceDomainSender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/AddAllowedSourceDomainSender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAllowedSourceDomainSender(ctx, req.(*MsgAddAllowedSourceDomainSender))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAllowedSourceDomainSender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowedSourceDomainSender)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAllowedSourceDomainSender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/RemoveAllowedSourceDomainSender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAllowedSourceDomainSender(ctx, req.(*MsgRemoveAllowedSourceDomainSender))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimFailedForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimFailedForward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimFailedForward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/ClaimFailedForward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimFailedForward(ctx, req.(*MsgClaimFailedForward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PairForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPairForward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PairForward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/PairForward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PairForward(ctx, req.(*MsgPairForward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePauser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePauser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/UpdatePauser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePauser(ctx, req.(*MsgUpdatePauser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseRouting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseRouting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseRouting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/PauseRouting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseRouting(ctx, req.(*MsgPauseRouting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpauseRouting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseRouting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseRouting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/UnpauseRouting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseRouting(ctx, req.(*MsgUnpauseRouting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAllowedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAllowedChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAllowedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/AddAllowedChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAllowedChannel(ctx, req.(*MsgAddAllowedChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAllowedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowedChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAllowedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/RemoveAllowedChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAllowedChannel(ctx, req.(*MsgRemoveAllowedChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSourceDomainRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSourceDomainRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSourceDomainRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/SetSourceDomainRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSourceDomainRateLimit(ctx, req.(*MsgSetSourceDomainRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveSourceDomainRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveSourceDomainRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveSourceDomainRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/RemoveSourceDomainRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveSourceDomainRateLimit(ctx, req.(*MsgRemoveSourceDomainRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetChannelRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChannelRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChannelRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/SetChannelRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChannelRateLimit(ctx, req.(*MsgSetChannelRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveChannelRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveChannelRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveChannelRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.router.Msg/RemoveChannelRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveChannelRateLimit(ctx, req.(*MsgRemoveChannelRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.router.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateOwner",
+			Handler:    _Msg_UpdateOwner_Handler,
+		},
+		{
+			MethodName: "AcceptOwner",
+			Handler:    _Msg_AcceptOwner_Handler,
+		},
+		{
+			MethodName: "SetOwner",
+			Handler:    _Msg_SetOwner_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "AddAllowedSourceDomainSender",
+			Handler:    _Msg_AddAllowedSourceDomainSender_Handler,
+		},
+		{
+			MethodName: "RemoveAllowedSourceDomainSender",
+			Handler:    _Msg_RemoveAllowedSourceDomainSender_Handler,
+		},
+		{
+			MethodName: "ClaimFailedForward",
+			Handler:    _Msg_ClaimFailedForward_Handler,
+		},
+		{
+			MethodName: "PairForward",
+			Handler:    _Msg_PairForward_Handler,
+		},
+		{
+			MethodName: "UpdatePauser",
+			Handler:    _Msg_UpdatePauser_Handler,
+		},
+		{
+			MethodName: "PauseRouting",
+			Handler:    _Msg_PauseRouting_Handler,
+		},
+		{
+			MethodName: "UnpauseRouting",
+			Handler:    _Msg_UnpauseRouting_Handler,
+		},
+		{
+			MethodName: "AddAllowedChannel",
+			Handler:    _Msg_AddAllowedChannel_Handler,
+		},
+		{
+			MethodName: "RemoveAllowedChannel",
+			Handler:    _Msg_RemoveAllowedChannel_Handler,
+		},
+		{
+			MethodName: "SetSourceDomainRateLimit",
+			Handler:    _Msg_SetSourceDomainRateLimit_Handler,
+		},
+		{
+			MethodName: "RemoveSourceDomainRateLimit",
+			Handler:    _Msg_RemoveSourceDomainRateLimit_Handler,
+		},
+		{
+			MethodName: "SetChannelRateLimit",
+			Handler:    _Msg_SetChannelRateLimit_Handler,
+		},
+		{
+			MethodName: "RemoveChannelRateLimit",
+			Handler:    _Msg_RemoveChannelRateLimit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "router/tx.proto",
+}
+
+func (m *MsgUpdateOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAllowedSourceDomainSender) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAllowedSourceDomainSender) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAllowedSourceDomainSender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DomainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DomainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAllowedSourceDomainSenderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAllowedSourceDomainSenderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAllowedSourceDomainSenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAllowedSourceDomainSender) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAllowedSourceDomainSender) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAllowedSourceDomainSender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DomainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DomainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAllowedSourceDomainSenderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAllowedSourceDomainSenderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAllowedSourceDomainSenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimFailedForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimFailedForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimFailedForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SourceDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimFailedForwardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimFailedForwardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimFailedForwardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPairForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPairForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPairForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SourceDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPairForwardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPairForwardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPairForwardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePauser) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePauser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePauser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewPauser) > 0 {
+		i -= len(m.NewPauser)
+		copy(dAtA[i:], m.NewPauser)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewPauser)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePauserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePauserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePauserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SourceDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Global {
+		i--
+		if m.Global {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseRoutingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseRoutingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseRoutingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SourceDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Global {
+		i--
+		if m.Global {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseRoutingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseRoutingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseRoutingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAllowedChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAllowedChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAllowedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainLabel) > 0 {
+		i -= len(m.ChainLabel)
+		copy(dAtA[i:], m.ChainLabel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainLabel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAllowedChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAllowedChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAllowedChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAllowedChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAllowedChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAllowedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAllowedChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAllowedChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAllowedChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSourceDomainRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSourceDomainRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSourceDomainRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.WindowBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SourceDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSourceDomainRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSourceDomainRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSourceDomainRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSourceDomainRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSourceDomainRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSourceDomainRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SourceDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSourceDomainRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSourceDomainRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSourceDomainRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetChannelRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChannelRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChannelRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.WindowBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetChannelRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChannelRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChannelRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveChannelRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveChannelRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveChannelRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveChannelRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveChannelRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveChannelRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAllowedSourceDomainSender) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DomainId != 0 {
+		n += 1 + sovTx(uint64(m.DomainId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAllowedSourceDomainSenderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAllowedSourceDomainSender) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DomainId != 0 {
+		n += 1 + sovTx(uint64(m.DomainId))
+	}
+	return n
+}
+
+func (m *MsgRemoveAllowedSourceDomainSenderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimFailedForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SourceDomain != 0 {
+		n += 1 + sovTx(uint64(m.SourceDomain))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgClaimFailedForwardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPairForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SourceDomain != 0 {
+		n += 1 + sovTx(uint64(m.SourceDomain))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgPairForwardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePauser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewPauser)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdatePauserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Global {
+		n += 2
+	}
+	if m.SourceDomain != 0 {
+		n += 1 + sovTx(uint64(m.SourceDomain))
+	}
+	return n
+}
+
+func (m *MsgPauseRoutingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpauseRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Global {
+		n += 2
+	}
+	if m.SourceDomain != 0 {
+		n += 1 + sovTx(uint64(m.SourceDomain))
+	}
+	return n
+}
+
+func (m *MsgUnpauseRoutingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAllowedChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainLabel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAllowedChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAllowedChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAllowedChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSourceDomainRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SourceDomain != 0 {
+		n += 1 + sovTx(uint64(m.SourceDomain))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovTx(uint64(m.WindowBlocks))
+	}
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetSourceDomainRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveSourceDomainRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SourceDomain != 0 {
+		n += 1 + sovTx(uint64(m.SourceDomain))
+	}
+	return n
+}
+
+func (m *MsgRemoveSourceDomainRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetChannelRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovTx(uint64(m.WindowBlocks))
+	}
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetChannelRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveChannelRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveChannelRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAllowedSourceDomainSender) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAllowedSourceDomainSender: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAllowedSourceDomainSender: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			m.DomainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DomainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAllowedSourceDomainSenderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAllowedSourceDomainSenderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAllowedSourceDomainSenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAllowedSourceDomainSender) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAllowedSourceDomainSender: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAllowedSourceDomainSender: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			m.DomainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DomainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAllowedSourceDomainSenderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAllowedSourceDomainSenderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAllowedSourceDomainSenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimFailedForward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimFailedForward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimFailedForward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimFailedForwardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimFailedForwardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimFailedForwardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPairForward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPairForward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPairForward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPairForwardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPairForwardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPairForwardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePauser) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePauser: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePauser: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPauser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPauser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePauserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePauserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePauserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Global", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Global = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseRoutingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseRoutingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseRoutingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Global", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Global = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseRoutingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseRoutingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseRoutingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAllowedChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAllowedChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAllowedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAllowedChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAllowedChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAllowedChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAllowedChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAllowedChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAllowedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgAddAllowedSourceDomainSenderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRemoveAllowedSourceDomainSender) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DomainId != 0 {
-		n += 1 + sovTx(uint64(m.DomainId))
+func (m *MsgRemoveAllowedChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAllowedChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAllowedChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRemoveAllowedSourceDomainSenderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateOwner) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSourceDomainRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -976,10 +6162,10 @@ func (m *MsgUpdateOwner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateOwner: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSourceDomainRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSourceDomainRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1015,8 +6201,46 @@ func (m *MsgUpdateOwner) Unmarshal(dAtA []byte) error {
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1044,7 +6268,9 @@ func (m *MsgUpdateOwner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1067,7 +6293,7 @@ func (m *MsgUpdateOwner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSourceDomainRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1090,10 +6316,10 @@ func (m *MsgUpdateOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSourceDomainRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSourceDomainRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1117,7 +6343,7 @@ func (m *MsgUpdateOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcceptOwner) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveSourceDomainRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1140,10 +6366,10 @@ func (m *MsgAcceptOwner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcceptOwner: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveSourceDomainRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcceptOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveSourceDomainRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1178,6 +6404,25 @@ func (m *MsgAcceptOwner) Unmarshal(dAtA []byte) error {
 			}
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1199,7 +6444,7 @@ func (m *MsgAcceptOwner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcceptOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveSourceDomainRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1222,10 +6467,10 @@ func (m *MsgAcceptOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcceptOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveSourceDomainRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcceptOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveSourceDomainRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1249,7 +6494,7 @@ func (m *MsgAcceptOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAllowedSourceDomainSender) Unmarshal(dAtA []byte) error {
+func (m *MsgSetChannelRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1272,10 +6517,10 @@ func (m *MsgAddAllowedSourceDomainSender) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAllowedSourceDomainSender: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetChannelRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAllowedSourceDomainSender: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetChannelRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1311,10 +6556,10 @@ func (m *MsgAddAllowedSourceDomainSender) Unmarshal(dAtA []byte) error {
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			m.DomainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1324,16 +6569,48 @@ func (m *MsgAddAllowedSourceDomainSender) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DomainId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1343,24 +6620,24 @@ func (m *MsgAddAllowedSourceDomainSender) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1384,7 +6661,7 @@ func (m *MsgAddAllowedSourceDomainSender) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAllowedSourceDomainSenderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetChannelRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1407,10 +6684,10 @@ func (m *MsgAddAllowedSourceDomainSenderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAllowedSourceDomainSenderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetChannelRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAllowedSourceDomainSenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetChannelRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1434,7 +6711,7 @@ func (m *MsgAddAllowedSourceDomainSenderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAllowedSourceDomainSender) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveChannelRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1457,10 +6734,10 @@ func (m *MsgRemoveAllowedSourceDomainSender) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAllowedSourceDomainSender: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveChannelRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAllowedSourceDomainSender: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveChannelRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1497,9 +6774,9 @@ func (m *MsgRemoveAllowedSourceDomainSender) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1509,45 +6786,24 @@ func (m *MsgRemoveAllowedSourceDomainSender) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
-			}
-			m.DomainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DomainId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1569,7 +6825,7 @@ func (m *MsgRemoveAllowedSourceDomainSender) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAllowedSourceDomainSenderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveChannelRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1592,10 +6848,10 @@ func (m *MsgRemoveAllowedSourceDomainSenderResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAllowedSourceDomainSenderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveChannelRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAllowedSourceDomainSenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveChannelRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
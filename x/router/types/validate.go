@@ -71,5 +71,17 @@ func (i *IBCForwardMetadata) Validate() error {
 		return fmt.Errorf("invalid port identifier in IBC forward metadata: %w", err)
 	}
 
-	return nil
+	if i.FallbackRecipient != "" {
+		if _, err := sdk.AccAddressFromBech32(i.FallbackRecipient); err != nil {
+			return fmt.Errorf("fallback recipient %s is not a valid Noble address: %w", i.FallbackRecipient, err)
+		}
+	}
+
+	if i.RequiredSubmitter != "" {
+		if _, err := sdk.AccAddressFromBech32(i.RequiredSubmitter); err != nil {
+			return fmt.Errorf("required submitter %s is not a valid Noble address: %w", i.RequiredSubmitter, err)
+		}
+	}
+
+	return i.validateHops()
 }